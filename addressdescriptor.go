@@ -91,6 +91,25 @@ func (lt LocalizedText) String() string {
     return fmt.Sprintf("(text=%s, languageCode=%s)", lt.Text, lt.LanguageCode)
 }
 
+// UnmarshalJSON implements json.Unmarshaler for LocalizedText, accepting both
+// the language_code key name and the languageCode key name of the Places API
+// (New).
+func (lt *LocalizedText) UnmarshalJSON(data []byte) error {
+	var x struct {
+		Text              string `json:"text"`
+		LanguageCode      string `json:"language_code"`
+		CamelLanguageCode string `json:"languageCode"`
+	}
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	lt.Text, lt.LanguageCode = x.Text, x.LanguageCode
+	if lt.LanguageCode == "" {
+		lt.LanguageCode = x.CamelLanguageCode
+	}
+	return nil
+}
+
 // Landmarks that are useful at describing a location.
 type Landmark struct {
 	// The Place ID of the underlying establishment serving as the landmark.
@@ -0,0 +1,520 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// More information about Google Address Validation API is available on
+// https://developers.google.com/maps/documentation/address-validation
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var addressValidationAPI = &apiConfig{
+	host:             "https://addressvalidation.googleapis.com",
+	path:             "/v1:validateAddress",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// ValidateAddress makes an Address Validation API request.
+func (c *Client) ValidateAddress(ctx context.Context, r *AddressValidationRequest) (*AddressValidationResponse, error) {
+	if len(r.Address.AddressLines) == 0 {
+		return nil, errors.New("maps: Address.AddressLines empty")
+	}
+
+	var response struct {
+		AddressValidationResponse
+		googleAPIResponse
+	}
+	if err := c.postJSON(ctx, addressValidationAPI, r, &response); err != nil {
+		return nil, err
+	}
+	if err := response.StatusError(); err != nil {
+		return nil, err
+	}
+	return &response.AddressValidationResponse, nil
+}
+
+var provideValidationFeedbackAPI = &apiConfig{
+	host:             "https://addressvalidation.googleapis.com",
+	path:             "/v1:provideValidationFeedback",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// ValidationConclusion is the final outcome of a sequence of address
+// validations, reported with ProvideValidationFeedback.
+type ValidationConclusion string
+
+const (
+	// ValidationConclusionValidatedVersionUsed means the validated version of
+	// the address was used.
+	ValidationConclusionValidatedVersionUsed = ValidationConclusion("VALIDATED_VERSION_USED")
+	// ValidationConclusionUserVersionUsed means the address as entered by the
+	// user was used.
+	ValidationConclusionUserVersionUsed = ValidationConclusion("USER_VERSION_USED")
+	// ValidationConclusionUnvalidatedVersionUsed means an address entered by
+	// the user after the last validation, which was not revalidated, was used.
+	ValidationConclusionUnvalidatedVersionUsed = ValidationConclusion("UNVALIDATED_VERSION_USED")
+	// ValidationConclusionUnused means the transaction was abandoned and the
+	// address was not used.
+	ValidationConclusionUnused = ValidationConclusion("UNUSED")
+)
+
+// ValidationFeedbackRequest is the request structure for the Address
+// Validation API provideValidationFeedback method.
+type ValidationFeedbackRequest struct {
+	// Conclusion is the outcome of the sequence of validations. Required.
+	Conclusion ValidationConclusion `json:"conclusion"`
+	// ResponseID is the ResponseID of the first response in the sequence of
+	// validations. Required.
+	ResponseID string `json:"responseId"`
+}
+
+// ProvideValidationFeedback reports the outcome of a sequence of address
+// validations. It should be called once the sequence is complete, as the
+// Address Validation API's terms require.
+func (c *Client) ProvideValidationFeedback(ctx context.Context, r *ValidationFeedbackRequest) error {
+	if r.Conclusion == "" {
+		return errors.New("maps: Conclusion missing")
+	}
+	if r.ResponseID == "" {
+		return errors.New("maps: ResponseID missing")
+	}
+
+	var response googleAPIResponse
+	if err := c.postJSON(ctx, provideValidationFeedbackAPI, r, &response); err != nil {
+		return err
+	}
+	return response.StatusError()
+}
+
+// AddressValidationRequest is the request structure for the Address Validation
+// API.
+type AddressValidationRequest struct {
+	// Address is the address being validated. Required.
+	Address PostalAddress `json:"address"`
+	// PreviousResponseID must be set to the ResponseID of the first response
+	// in the sequence when revalidating an address, for example after the user
+	// has corrected it. Optional.
+	PreviousResponseID string `json:"previousResponseId,omitempty"`
+	// EnableUSPSCASS enables USPS CASS compatible mode. Optional.
+	EnableUSPSCASS bool `json:"enableUspsCass,omitempty"`
+	// SessionToken identifies an Autocomplete session for billing purposes.
+	// Optional.
+	SessionToken string `json:"sessionToken,omitempty"`
+}
+
+// PostalAddress is a postal address, as used by the Address Validation API.
+type PostalAddress struct {
+	// RegionCode is the CLDR region code of the country or region of the
+	// address, for example "US".
+	RegionCode string `json:"regionCode,omitempty"`
+	// LanguageCode is the BCP-47 language code of the contents of this address.
+	LanguageCode string `json:"languageCode,omitempty"`
+	// PostalCode is the postal code of the address.
+	PostalCode string `json:"postalCode,omitempty"`
+	// SortingCode is the additional, country-specific, sorting code.
+	SortingCode string `json:"sortingCode,omitempty"`
+	// AdministrativeArea is the highest administrative subdivision, for
+	// example a state or province.
+	AdministrativeArea string `json:"administrativeArea,omitempty"`
+	// Locality generally refers to the city or town portion of the address.
+	Locality string `json:"locality,omitempty"`
+	// Sublocality is the sublocality of the address.
+	Sublocality string `json:"sublocality,omitempty"`
+	// AddressLines are the unstructured lines of the address.
+	AddressLines []string `json:"addressLines,omitempty"`
+	// Recipients are the recipients at the address.
+	Recipients []string `json:"recipients,omitempty"`
+	// Organization is the name of the organization at the address.
+	Organization string `json:"organization,omitempty"`
+}
+
+// AddressValidationResponse is the response of the Address Validation API.
+type AddressValidationResponse struct {
+	// Result is the result of the address validation.
+	Result ValidationResult `json:"result"`
+	// ResponseID identifies this response. The ResponseID of the first response
+	// in a sequence must be passed as PreviousResponseID when the address is
+	// revalidated, and in a ValidationFeedbackRequest.
+	ResponseID string `json:"responseId"`
+}
+
+// ValidationResult is the result of validating an address.
+type ValidationResult struct {
+	// Verdict contains the overall verdict flags.
+	Verdict Verdict `json:"verdict"`
+	// Address contains information about the address itself, as opposed to the
+	// geocode.
+	Address ValidatedAddress `json:"address"`
+	// Geocode contains information about the location and place that the
+	// address geocoded to.
+	Geocode *AddressValidationGeocode `json:"geocode,omitempty"`
+	// Metadata contains other information relevant to deliverability.
+	Metadata *AddressMetadata `json:"metadata,omitempty"`
+}
+
+// Verdict is the high level overview of the address validation result.
+type Verdict struct {
+	// InputGranularity is the granularity of the input address.
+	InputGranularity AddressValidationGranularity `json:"inputGranularity,omitempty"`
+	// ValidationGranularity is the granularity to which the address could be
+	// fully validated.
+	ValidationGranularity AddressValidationGranularity `json:"validationGranularity,omitempty"`
+	// GeocodeGranularity is the granularity of the geocode.
+	GeocodeGranularity AddressValidationGranularity `json:"geocodeGranularity,omitempty"`
+	// AddressComplete is true if there are no unresolved tokens and no missing
+	// or unexpected address components.
+	AddressComplete bool `json:"addressComplete,omitempty"`
+	// HasUnconfirmedComponents is true if at least one address component could
+	// not be confirmed.
+	HasUnconfirmedComponents bool `json:"hasUnconfirmedComponents,omitempty"`
+	// HasInferredComponents is true if at least one address component was
+	// inferred rather than present in the input.
+	HasInferredComponents bool `json:"hasInferredComponents,omitempty"`
+	// HasReplacedComponents is true if at least one address component was
+	// replaced.
+	HasReplacedComponents bool `json:"hasReplacedComponents,omitempty"`
+	// PossibleNextAction is the next action the API suggests based on the rest
+	// of the response.
+	PossibleNextAction PossibleNextAction `json:"possibleNextAction,omitempty"`
+}
+
+// ValidatedAddress is the address after validation.
+type ValidatedAddress struct {
+	// FormattedAddress is the post-processed address, formatted as a single
+	// line.
+	FormattedAddress string `json:"formattedAddress"`
+	// PostalAddress is the post-processed address.
+	PostalAddress PostalAddress `json:"postalAddress"`
+	// AddressComponents are the individual components of the address.
+	AddressComponents []ValidationAddressComponent `json:"addressComponents,omitempty"`
+	// MissingComponentTypes are the types of components which were expected in
+	// a correctly formatted address but were not found in the input.
+	MissingComponentTypes []string `json:"missingComponentTypes,omitempty"`
+	// UnconfirmedComponentTypes are the types of components which are present
+	// but could not be confirmed.
+	UnconfirmedComponentTypes []string `json:"unconfirmedComponentTypes,omitempty"`
+	// UnresolvedTokens are any tokens in the input that could not be resolved.
+	UnresolvedTokens []string `json:"unresolvedTokens,omitempty"`
+}
+
+// ValidationAddressComponent is a single component of a validated address.
+type ValidationAddressComponent struct {
+	// ComponentName is the name of this component.
+	ComponentName ComponentName `json:"componentName"`
+	// ComponentType is the type of this component, for example "route".
+	ComponentType string `json:"componentType"`
+	// ConfirmationLevel indicates how confident the API is that this component
+	// is correct.
+	ConfirmationLevel ConfirmationLevel `json:"confirmationLevel"`
+	// Inferred indicates the component was not part of the input.
+	Inferred bool `json:"inferred,omitempty"`
+	// SpellCorrected indicates a correction of a misspelling.
+	SpellCorrected bool `json:"spellCorrected,omitempty"`
+	// Replaced indicates the name of the component was replaced.
+	Replaced bool `json:"replaced,omitempty"`
+	// UnexpectedType indicates a component that is not expected to be present
+	// in a postal address for the given region.
+	UnexpectedType bool `json:"unexpectedType,omitempty"`
+}
+
+// ComponentName is the name of an address component.
+type ComponentName struct {
+	// Text is the name text.
+	Text string `json:"text"`
+	// LanguageCode is the BCP-47 language code of the text.
+	LanguageCode string `json:"languageCode,omitempty"`
+}
+
+// AddressValidationGeocode contains the location and place that an address
+// geocoded to.
+type AddressValidationGeocode struct {
+	// Location is the geocoded location of the input.
+	Location AddressValidationLatLng `json:"location"`
+	// FeatureSizeMeters is the size of the geocoded place, in meters.
+	FeatureSizeMeters float64 `json:"featureSizeMeters,omitempty"`
+	// PlaceID is the place ID of the place this input geocodes to.
+	PlaceID string `json:"placeId,omitempty"`
+	// PlaceTypes are the types of the place that the input geocoded to.
+	PlaceTypes []string `json:"placeTypes,omitempty"`
+}
+
+// AddressMetadata contains metadata about an address. Fields are nil when the
+// API cannot determine them.
+type AddressMetadata struct {
+	// Business indicates the address is a business.
+	Business *bool `json:"business,omitempty"`
+	// POBox indicates the address is a PO box.
+	POBox *bool `json:"poBox,omitempty"`
+	// Residential indicates the address is a residence.
+	Residential *bool `json:"residential,omitempty"`
+}
+
+// AddressValidationGranularity is the granularity of an address or geocode.
+type AddressValidationGranularity string
+
+// Granularities of an address or geocode.
+const (
+	GranularityUnspecified      = AddressValidationGranularity("GRANULARITY_UNSPECIFIED")
+	GranularitySubPremise       = AddressValidationGranularity("SUB_PREMISE")
+	GranularityPremise          = AddressValidationGranularity("PREMISE")
+	GranularityPremiseProximity = AddressValidationGranularity("PREMISE_PROXIMITY")
+	GranularityBlock            = AddressValidationGranularity("BLOCK")
+	GranularityRoute            = AddressValidationGranularity("ROUTE")
+	GranularityOther            = AddressValidationGranularity("OTHER")
+)
+
+// ConfirmationLevel is how confident the API is that an address component is
+// correct.
+type ConfirmationLevel string
+
+// Confirmation levels of an address component.
+const (
+	ConfirmationLevelUnspecified              = ConfirmationLevel("CONFIRMATION_LEVEL_UNSPECIFIED")
+	ConfirmationLevelConfirmed                = ConfirmationLevel("CONFIRMED")
+	ConfirmationLevelUnconfirmedButPlausible  = ConfirmationLevel("UNCONFIRMED_BUT_PLAUSIBLE")
+	ConfirmationLevelUnconfirmedAndSuspicious = ConfirmationLevel("UNCONFIRMED_AND_SUSPICIOUS")
+)
+
+// PossibleNextAction is the next action suggested by the Address Validation
+// API.
+type PossibleNextAction string
+
+// Possible next actions for a validated address.
+const (
+	PossibleNextActionUnspecified           = PossibleNextAction("POSSIBLE_NEXT_ACTION_UNSPECIFIED")
+	PossibleNextActionFix                   = PossibleNextAction("FIX")
+	PossibleNextActionConfirmAddSubpremises = PossibleNextAction("CONFIRM_ADD_SUBPREMISES")
+	PossibleNextActionConfirm               = PossibleNextAction("CONFIRM")
+	PossibleNextActionAccept                = PossibleNextAction("ACCEPT")
+)
+
+// AddressValidationOutcome classifies a validated address for checkout
+// pipelines.
+type AddressValidationOutcome string
+
+// Outcomes of validating an address.
+const (
+	// AddressValidationAccepted addresses can be used as they are.
+	AddressValidationAccepted = AddressValidationOutcome("ACCEPTED")
+	// AddressValidationConfirmationRequired addresses were validated, possibly
+	// with inferred or replaced components, and should be confirmed by the user
+	// before they are used.
+	AddressValidationConfirmationRequired = AddressValidationOutcome("CONFIRMATION_REQUIRED")
+	// AddressValidationFixRequired addresses should be corrected by the user.
+	AddressValidationFixRequired = AddressValidationOutcome("FIX_REQUIRED")
+	// AddressValidationRejected addresses are not deliverable.
+	AddressValidationRejected = AddressValidationOutcome("REJECTED")
+)
+
+// outcome classifies v, following PossibleNextAction when the API provides it.
+// Without it, addresses which are incomplete or not validated to a useful
+// granularity are rejected.
+func (v *Verdict) outcome() AddressValidationOutcome {
+	switch v.PossibleNextAction {
+	case PossibleNextActionAccept:
+		return AddressValidationAccepted
+	case PossibleNextActionConfirm, PossibleNextActionConfirmAddSubpremises:
+		return AddressValidationConfirmationRequired
+	case PossibleNextActionFix:
+		return AddressValidationFixRequired
+	}
+	switch v.ValidationGranularity {
+	case "", GranularityUnspecified, GranularityOther:
+		return AddressValidationRejected
+	}
+	if !v.AddressComplete {
+		return AddressValidationRejected
+	}
+	if v.HasUnconfirmedComponents {
+		return AddressValidationFixRequired
+	}
+	if v.HasInferredComponents || v.HasReplacedComponents {
+		return AddressValidationConfirmationRequired
+	}
+	return AddressValidationAccepted
+}
+
+// IsValid reports whether the address can be accepted as it is.
+func (v *Verdict) IsValid() bool {
+	return v.outcome() == AddressValidationAccepted
+}
+
+// RequiresConfirmation reports whether the user should confirm or correct the
+// address before it is accepted.
+func (v *Verdict) RequiresConfirmation() bool {
+	o := v.outcome()
+	return o == AddressValidationConfirmationRequired || o == AddressValidationFixRequired
+}
+
+// Issues returns human-readable reasons why the address is not fully valid,
+// compiled from its missing, unconfirmed, and corrected components. It returns
+// nil if there are none.
+func (r *ValidationResult) Issues() []string {
+	var issues []string
+	switch r.Verdict.ValidationGranularity {
+	case GranularityBlock, GranularityRoute, GranularityOther:
+		issues = append(issues, "address could only be validated to "+componentTypeText(string(r.Verdict.ValidationGranularity))+" level")
+	}
+	for _, t := range r.Address.MissingComponentTypes {
+		issues = append(issues, "missing "+componentTypeText(t))
+	}
+	for _, t := range r.Address.UnconfirmedComponentTypes {
+		issues = append(issues, "unconfirmed "+componentTypeText(t))
+	}
+	for _, token := range r.Address.UnresolvedTokens {
+		issues = append(issues, fmt.Sprintf("unresolved %q", token))
+	}
+	for _, c := range r.Address.AddressComponents {
+		t := componentTypeText(c.ComponentType)
+		if c.UnexpectedType {
+			issues = append(issues, "unexpected "+t)
+		}
+		if c.Replaced {
+			issues = append(issues, fmt.Sprintf("replaced %s with %q", t, c.ComponentName.Text))
+		} else if c.SpellCorrected {
+			issues = append(issues, fmt.Sprintf("corrected spelling of %s to %q", t, c.ComponentName.Text))
+		}
+		if c.Inferred {
+			issues = append(issues, fmt.Sprintf("inferred %s %q", t, c.ComponentName.Text))
+		}
+	}
+	return issues
+}
+
+// componentTypeText returns an address component type or granularity as text,
+// for example "street number" for "street_number".
+func componentTypeText(t string) string {
+	return strings.ToLower(strings.Replace(t, "_", " ", -1))
+}
+
+// AddressValidationBatchResult is the result of validating one address of a
+// batch.
+type AddressValidationBatchResult struct {
+	// Request is the request which was validated.
+	Request *AddressValidationRequest
+	// Response is the response of the Address Validation API, or nil if Err is
+	// set.
+	Response *AddressValidationResponse
+	// Err is the error validating the address, if any.
+	Err error
+	// Outcome is the classification of Response. It is empty if Err is set.
+	Outcome AddressValidationOutcome
+}
+
+// Revalidate returns a request revalidating address, for example after the
+// user has corrected it, as a follow-up to this result.
+func (r *AddressValidationBatchResult) Revalidate(address PostalAddress) *AddressValidationRequest {
+	req := *r.Request
+	req.Address = address
+	req.PreviousResponseID = r.firstResponseID()
+	return &req
+}
+
+// Feedback returns a request reporting conclusion as the outcome of the
+// sequence of validations this result belongs to.
+func (r *AddressValidationBatchResult) Feedback(conclusion ValidationConclusion) *ValidationFeedbackRequest {
+	return &ValidationFeedbackRequest{Conclusion: conclusion, ResponseID: r.firstResponseID()}
+}
+
+// firstResponseID returns the ResponseID of the first response in the sequence
+// of validations of this result.
+func (r *AddressValidationBatchResult) firstResponseID() string {
+	if r.Request.PreviousResponseID != "" {
+		return r.Request.PreviousResponseID
+	}
+	if r.Response != nil {
+		return r.Response.ResponseID
+	}
+	return ""
+}
+
+// AddressValidationBatch is the result of ValidateAddressBatch.
+type AddressValidationBatch struct {
+	// Results holds a result for each request, in the order of the requests.
+	Results []*AddressValidationBatchResult
+	// Accepted, ConfirmationRequired, FixRequired, and Rejected hold the
+	// results which were classified with each outcome. Results with an error
+	// are in none of them.
+	Accepted, ConfirmationRequired, FixRequired, Rejected []*AddressValidationBatchResult
+}
+
+// ValidateAddressBatch validates rs, making at most concurrency Address
+// Validation API requests at a time. Fewer requests are made at a time after
+// the API reports that it is over its query limit, ramping back up as requests
+// succeed, and throttled requests are retried after a backoff. Each request is also subject to the client's rate limit. Results
+// are returned in the order of rs; a failed request does not stop the rest of
+// the batch. Requests returned by AddressValidationBatchResult.Revalidate may be
+// validated in a later batch to chain their response IDs. A nil request fails
+// the batch before any request is made.
+func (c *Client) ValidateAddressBatch(ctx context.Context, rs []*AddressValidationRequest, concurrency int) (*AddressValidationBatch, error) {
+	if concurrency < 1 {
+		return nil, errors.New("maps: concurrency must be at least 1")
+	}
+	for i, r := range rs {
+		if r == nil {
+			return nil, fmt.Errorf("maps: request %d is nil", i)
+		}
+	}
+
+	results := make([]*AddressValidationBatchResult, len(rs))
+	indexes := make(chan int)
+	limit := newAdaptiveConcurrency(concurrency)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(rs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := &AddressValidationBatchResult{Request: rs[i]}
+				result.Err = limit.do(ctx, c, func() error {
+					var err error
+					result.Response, err = c.ValidateAddress(ctx, rs[i])
+					return err
+				})
+				if result.Err == nil {
+					result.Outcome = result.Response.Result.Verdict.outcome()
+				}
+				results[i] = result
+			}
+		}()
+	}
+	for i := range rs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	batch := &AddressValidationBatch{Results: results}
+	for _, result := range results {
+		switch result.Outcome {
+		case AddressValidationAccepted:
+			batch.Accepted = append(batch.Accepted, result)
+		case AddressValidationConfirmationRequired:
+			batch.ConfirmationRequired = append(batch.ConfirmationRequired, result)
+		case AddressValidationFixRequired:
+			batch.FixRequired = append(batch.FixRequired, result)
+		case AddressValidationRejected:
+			batch.Rejected = append(batch.Rejected, result)
+		}
+	}
+	return batch, nil
+}
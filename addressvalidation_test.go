@@ -0,0 +1,380 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestValidateAddress(t *testing.T) {
+	response := `{
+  "result": {
+    "verdict": {
+      "inputGranularity": "PREMISE",
+      "validationGranularity": "PREMISE",
+      "geocodeGranularity": "PREMISE",
+      "addressComplete": true,
+      "hasInferredComponents": true,
+      "possibleNextAction": "ACCEPT"
+    },
+    "address": {
+      "formattedAddress": "1600 Amphitheatre Parkway, Mountain View, CA 94043-1351, USA",
+      "postalAddress": {
+        "regionCode": "US",
+        "languageCode": "en",
+        "postalCode": "94043-1351",
+        "administrativeArea": "CA",
+        "locality": "Mountain View",
+        "addressLines": ["1600 Amphitheatre Pkwy"]
+      },
+      "addressComponents": [
+        {
+          "componentName": {"text": "1600"},
+          "componentType": "street_number",
+          "confirmationLevel": "CONFIRMED"
+        },
+        {
+          "componentName": {"text": "USA", "languageCode": "en"},
+          "componentType": "country",
+          "confirmationLevel": "CONFIRMED",
+          "inferred": true
+        }
+      ]
+    },
+    "geocode": {
+      "location": {"latitude": 37.4225, "longitude": -122.0847},
+      "placeId": "ChIJF4Yf2Ry7j4AR__1AkytDyAE",
+      "placeTypes": ["premise"]
+    },
+    "metadata": {"business": true, "residential": false}
+  },
+  "responseId": "de9ee3a7-0e4b-4cd4-8dd4-5d4a0c4c4a9b"
+}`
+
+	var body AddressValidationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != addressValidationAPI.path || r.URL.Query().Get("key") != apiKey {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		fmt.Fprintln(w, response)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &AddressValidationRequest{
+		Address: PostalAddress{
+			RegionCode:   "US",
+			AddressLines: []string{"1600 Amphitheatre Pkwy", "Mountain View, CA"},
+		},
+		EnableUSPSCASS: true,
+	}
+
+	resp, err := c.ValidateAddress(context.Background(), r)
+	if err != nil {
+		t.Fatalf("ValidateAddress returned error: %v", err)
+	}
+	if !reflect.DeepEqual(body, *r) {
+		t.Errorf("expected request %+v, was %+v", *r, body)
+	}
+
+	business, residential := true, false
+	correctResponse := &AddressValidationResponse{
+		Result: ValidationResult{
+			Verdict: Verdict{
+				InputGranularity:      GranularityPremise,
+				ValidationGranularity: GranularityPremise,
+				GeocodeGranularity:    GranularityPremise,
+				AddressComplete:       true,
+				HasInferredComponents: true,
+				PossibleNextAction:    PossibleNextActionAccept,
+			},
+			Address: ValidatedAddress{
+				FormattedAddress: "1600 Amphitheatre Parkway, Mountain View, CA 94043-1351, USA",
+				PostalAddress: PostalAddress{
+					RegionCode:         "US",
+					LanguageCode:       "en",
+					PostalCode:         "94043-1351",
+					AdministrativeArea: "CA",
+					Locality:           "Mountain View",
+					AddressLines:       []string{"1600 Amphitheatre Pkwy"},
+				},
+				AddressComponents: []ValidationAddressComponent{
+					{
+						ComponentName:     ComponentName{Text: "1600"},
+						ComponentType:     "street_number",
+						ConfirmationLevel: ConfirmationLevelConfirmed,
+					},
+					{
+						ComponentName:     ComponentName{Text: "USA", LanguageCode: "en"},
+						ComponentType:     "country",
+						ConfirmationLevel: ConfirmationLevelConfirmed,
+						Inferred:          true,
+					},
+				},
+			},
+			Geocode: &AddressValidationGeocode{
+				Location:   AddressValidationLatLng{Latitude: 37.4225, Longitude: -122.0847},
+				PlaceID:    "ChIJF4Yf2Ry7j4AR__1AkytDyAE",
+				PlaceTypes: []string{"premise"},
+			},
+			Metadata: &AddressMetadata{Business: &business, Residential: &residential},
+		},
+		ResponseID: "de9ee3a7-0e4b-4cd4-8dd4-5d4a0c4c4a9b",
+	}
+	if !reflect.DeepEqual(resp, correctResponse) {
+		t.Errorf("expected %+v, was %+v", correctResponse, resp)
+	}
+}
+
+func TestValidateAddressError(t *testing.T) {
+	server := mockServer(400, `{"error": {"code": 400, "message": "Unsupported region code.", "status": "INVALID_ARGUMENT"}}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1 Main St"}}}
+
+	_, err := c.ValidateAddress(context.Background(), r)
+	se, ok := err.(*StatusError)
+	if !ok {
+		t.Fatalf("expected *StatusError, was %v", err)
+	}
+	if se.Status() != "INVALID_ARGUMENT" || se.Message() != "Unsupported region code." {
+		t.Errorf("unexpected error %v", se)
+	}
+}
+
+func TestValidateAddressMissingAddressLines(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+
+	if _, err := c.ValidateAddress(context.Background(), &AddressValidationRequest{}); err == nil {
+		t.Errorf("expected error for empty address lines")
+	}
+}
+
+func TestValidateAddressBatch(t *testing.T) {
+	verdicts := map[string]string{
+		"accept":  `{"validationGranularity": "PREMISE", "addressComplete": true}`,
+		"confirm": `{"validationGranularity": "PREMISE", "addressComplete": true, "possibleNextAction": "CONFIRM"}`,
+		"reject":  `{"validationGranularity": "OTHER"}`,
+		"fix":     `{"validationGranularity": "ROUTE", "possibleNextAction": "FIX"}`,
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		var req AddressValidationRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		line := req.Address.AddressLines[0]
+		verdict, ok := verdicts[line]
+		if !ok {
+			w.WriteHeader(400)
+			fmt.Fprintln(w, `{"error": {"code": 400, "message": "bad", "status": "INVALID_ARGUMENT"}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"result": {"verdict": %s}, "responseId": "id-%s"}`, verdict, line)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	lines := []string{"reject", "accept", "error", "confirm", "accept", "fix"}
+	var rs []*AddressValidationRequest
+	for _, line := range lines {
+		rs = append(rs, &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{line}}})
+	}
+
+	batch, err := c.ValidateAddressBatch(context.Background(), rs, 2)
+	if err != nil {
+		t.Fatalf("ValidateAddressBatch returned error: %v", err)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, was %d", maxInFlight)
+	}
+
+	wantOutcomes := []AddressValidationOutcome{
+		AddressValidationRejected,
+		AddressValidationAccepted,
+		"",
+		AddressValidationConfirmationRequired,
+		AddressValidationAccepted,
+		AddressValidationFixRequired,
+	}
+	for i, result := range batch.Results {
+		if result.Request != rs[i] {
+			t.Errorf("result %d: expected request %v, was %v", i, rs[i], result.Request)
+		}
+		if result.Outcome != wantOutcomes[i] {
+			t.Errorf("result %d: expected outcome %q, was %q", i, wantOutcomes[i], result.Outcome)
+		}
+		if (result.Err != nil) != (lines[i] == "error") {
+			t.Errorf("result %d: unexpected error %v", i, result.Err)
+		}
+	}
+	if len(batch.Accepted) != 2 || len(batch.ConfirmationRequired) != 1 || len(batch.FixRequired) != 1 || len(batch.Rejected) != 1 {
+		t.Errorf("unexpected buckets %+v", batch)
+	}
+
+	revalidation := batch.ConfirmationRequired[0].Revalidate(PostalAddress{AddressLines: []string{"accept"}})
+	if revalidation.PreviousResponseID != "id-confirm" {
+		t.Errorf("expected previous response ID id-confirm, was %q", revalidation.PreviousResponseID)
+	}
+	next, err := c.ValidateAddressBatch(context.Background(), []*AddressValidationRequest{revalidation}, 1)
+	if err != nil {
+		t.Fatalf("ValidateAddressBatch returned error: %v", err)
+	}
+	if len(next.Accepted) != 1 {
+		t.Errorf("expected revalidated address to be accepted, was %+v", next.Results[0])
+	}
+	if id := next.Results[0].Revalidate(PostalAddress{}).PreviousResponseID; id != "id-confirm" {
+		t.Errorf("expected previous response ID of the first response id-confirm, was %q", id)
+	}
+	if feedback := next.Results[0].Feedback(ValidationConclusionValidatedVersionUsed); feedback.ResponseID != "id-confirm" {
+		t.Errorf("expected feedback for response ID id-confirm, was %q", feedback.ResponseID)
+	}
+}
+
+func TestProvideValidationFeedback(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1:provideValidationFeedback" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		if body["responseId"] == "unknown" {
+			w.WriteHeader(400)
+			fmt.Fprintln(w, `{"error": {"code": 400, "message": "Invalid response ID", "status": "INVALID_ARGUMENT"}}`)
+			return
+		}
+		fmt.Fprintln(w, `{}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	err := c.ProvideValidationFeedback(context.Background(), &ValidationFeedbackRequest{
+		Conclusion: ValidationConclusionUserVersionUsed,
+		ResponseID: "id-first",
+	})
+	if err != nil {
+		t.Fatalf("ProvideValidationFeedback returned error: %v", err)
+	}
+	want := map[string]string{"conclusion": "USER_VERSION_USED", "responseId": "id-first"}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("expected body %v, was %v", want, body)
+	}
+
+	err = c.ProvideValidationFeedback(context.Background(), &ValidationFeedbackRequest{Conclusion: ValidationConclusionUnused, ResponseID: "unknown"})
+	if err == nil {
+		t.Errorf("expected error for unknown response ID")
+	}
+	if err := c.ProvideValidationFeedback(context.Background(), &ValidationFeedbackRequest{ResponseID: "id-first"}); err == nil {
+		t.Errorf("expected error for missing conclusion")
+	}
+}
+
+func TestValidateAddressBatchConcurrency(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+
+	if _, err := c.ValidateAddressBatch(context.Background(), nil, 0); err == nil {
+		t.Errorf("expected error for zero concurrency")
+	}
+	rs := []*AddressValidationRequest{{Address: PostalAddress{AddressLines: []string{"accept"}}}, nil}
+	if _, err := c.ValidateAddressBatch(context.Background(), rs, 2); err == nil {
+		t.Errorf("expected error for nil request")
+	}
+}
+
+func TestVerdict(t *testing.T) {
+	tests := []struct {
+		verdict                  Verdict
+		outcome                  AddressValidationOutcome
+		valid, needsConfirmation bool
+	}{
+		{Verdict{ValidationGranularity: GranularityPremise, AddressComplete: true}, AddressValidationAccepted, true, false},
+		{Verdict{ValidationGranularity: GranularitySubPremise, AddressComplete: true, HasUnconfirmedComponents: true}, AddressValidationFixRequired, false, true},
+		{Verdict{ValidationGranularity: GranularityPremise, AddressComplete: true, HasInferredComponents: true}, AddressValidationConfirmationRequired, false, true},
+		{Verdict{ValidationGranularity: GranularityRoute, AddressComplete: false}, AddressValidationRejected, false, false},
+		{Verdict{ValidationGranularity: GranularityOther}, AddressValidationRejected, false, false},
+		{Verdict{ValidationGranularity: GranularityOther, PossibleNextAction: PossibleNextActionConfirmAddSubpremises}, AddressValidationConfirmationRequired, false, true},
+		{Verdict{ValidationGranularity: GranularityPremise, PossibleNextAction: PossibleNextActionConfirm}, AddressValidationConfirmationRequired, false, true},
+		{Verdict{ValidationGranularity: GranularityRoute, PossibleNextAction: PossibleNextActionFix}, AddressValidationFixRequired, false, true},
+		{Verdict{PossibleNextAction: PossibleNextActionAccept}, AddressValidationAccepted, true, false},
+	}
+	for _, test := range tests {
+		if o := test.verdict.outcome(); o != test.outcome {
+			t.Errorf("%+v: expected outcome %q, was %q", test.verdict, test.outcome, o)
+		}
+		if test.verdict.IsValid() != test.valid {
+			t.Errorf("%+v: expected IsValid %v", test.verdict, test.valid)
+		}
+		if test.verdict.RequiresConfirmation() != test.needsConfirmation {
+			t.Errorf("%+v: expected RequiresConfirmation %v", test.verdict, test.needsConfirmation)
+		}
+	}
+}
+
+func TestValidationResultIssues(t *testing.T) {
+	r := &ValidationResult{
+		Verdict: Verdict{ValidationGranularity: GranularityRoute},
+		Address: ValidatedAddress{
+			MissingComponentTypes:     []string{"subpremise"},
+			UnconfirmedComponentTypes: []string{"street_number"},
+			UnresolvedTokens:          []string{"Flr"},
+			AddressComponents: []ValidationAddressComponent{
+				{ComponentName: ComponentName{Text: "Amphitheatre Parkway"}, ComponentType: "route", SpellCorrected: true},
+				{ComponentName: ComponentName{Text: "Mountain View"}, ComponentType: "locality", Replaced: true},
+				{ComponentName: ComponentName{Text: "USA"}, ComponentType: "country", Inferred: true},
+				{ComponentName: ComponentName{Text: "1600"}, ComponentType: "street_number"},
+			},
+		},
+	}
+	expected := []string{
+		"address could only be validated to route level",
+		"missing subpremise",
+		"unconfirmed street number",
+		`unresolved "Flr"`,
+		`corrected spelling of route to "Amphitheatre Parkway"`,
+		`replaced locality with "Mountain View"`,
+		`inferred country "USA"`,
+	}
+	if issues := r.Issues(); !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %q, was %q", expected, issues)
+	}
+	if issues := (&ValidationResult{}).Issues(); issues != nil {
+		t.Errorf("expected no issues, was %q", issues)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// More information about Google Air Quality API is available on
+// https://developers.google.com/maps/documentation/air-quality
+
+package maps
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var airQualityHeatmapAPI = &apiConfig{
+	host:             "https://airquality.googleapis.com",
+	path:             "/v1/mapTypes/",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+	cacheable:        true,
+}
+
+// AirQualityHeatmapType is the index or pollutant shown by Air Quality API
+// heatmap tiles, and their color palette.
+type AirQualityHeatmapType string
+
+// The types of heatmap.
+const (
+	AirQualityUAQIRedGreen      = AirQualityHeatmapType("UAQI_RED_GREEN")
+	AirQualityUAQIIndigoPersian = AirQualityHeatmapType("UAQI_INDIGO_PERSIAN")
+	AirQualityPM25IndigoPersian = AirQualityHeatmapType("PM25_INDIGO_PERSIAN")
+	AirQualityGBRDefra          = AirQualityHeatmapType("GBR_DEFRA")
+	AirQualityDEUUBA            = AirQualityHeatmapType("DEU_UBA")
+	AirQualityCANEC             = AirQualityHeatmapType("CAN_EC")
+	AirQualityFRAAtmo           = AirQualityHeatmapType("FRA_ATMO")
+	AirQualityUSAQI             = AirQualityHeatmapType("US_AQI")
+)
+
+// AirQualityHeatmapTileRequest is the request structure for the Air Quality
+// API heatmap tile request.
+type AirQualityHeatmapTileRequest struct {
+	// MapType is the type of heatmap. Required.
+	MapType AirQualityHeatmapType
+	// Zoom is the zoom level of the tile.
+	Zoom int
+	// X is the column of the tile, counting east from the antimeridian.
+	X int
+	// Y is the row of the tile, counting south from the north edge of the map.
+	Y int
+}
+
+func (r *AirQualityHeatmapTileRequest) resourcePath() string {
+	return fmt.Sprintf("%s/heatmapTiles/%d/%d/%d", r.MapType, r.Zoom, r.X, r.Y)
+}
+
+func (r *AirQualityHeatmapTileRequest) params() url.Values {
+	return make(url.Values)
+}
+
+// AirQualityHeatmapTile is a PNG heatmap tile returned by the Air Quality API.
+type AirQualityHeatmapTile struct {
+	// Data is the PNG image data of the tile.
+	Data []byte
+	// MaxAge is how long the tile may be cached for, from the Cache-Control
+	// header of the response, or zero if it was not given or the tile was
+	// served from the client's Cache.
+	MaxAge time.Duration
+	// Cached is whether the tile was served from the client's Cache.
+	Cached bool
+}
+
+// Image decodes the tile's PNG image.
+func (t *AirQualityHeatmapTile) Image() (image.Image, error) {
+	return png.Decode(bytes.NewReader(t.Data))
+}
+
+// AirQualityHeatmapTile makes an Air Quality API heatmap tile request. If the
+// client has a Cache, tiles are kept in it for the max-age of the response, or
+// the client's cache TTL if the response does not give one.
+func (c *Client) AirQualityHeatmapTile(ctx context.Context, r *AirQualityHeatmapTileRequest) (*AirQualityHeatmapTile, error) {
+	if r.MapType == "" {
+		return nil, errors.New("maps: MapType missing")
+	}
+	if r.Zoom < 0 || r.X < 0 || r.Y < 0 || r.X >= 1<<uint(r.Zoom) || r.Y >= 1<<uint(r.Zoom) {
+		return nil, fmt.Errorf("maps: no tile %d/%d/%d", r.Zoom, r.X, r.Y)
+	}
+
+	key := c.cacheKey(ctx, airQualityHeatmapAPI, r)
+	if key != "" {
+		if data, ok := c.cache.Get(key); ok {
+			return &AirQualityHeatmapTile{Data: data, Cached: true}, nil
+		}
+	}
+
+	resp, err := c.getBinary(ctx, airQualityHeatmapAPI, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.data.Close()
+	b, err := ioutil.ReadAll(resp.data)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	if resp.statusCode != http.StatusOK {
+		return nil, httpStatusError("Air Quality API", resp, b)
+	}
+
+	maxAge, store := cacheControlMaxAge(resp.header)
+	if key != "" && store {
+		ttl := maxAge
+		if ttl <= 0 {
+			ttl = c.cacheDuration()
+		}
+		c.cache.Set(key, b, ttl)
+	}
+	return &AirQualityHeatmapTile{Data: b, MaxAge: maxAge}, nil
+}
+
+// cacheControlMaxAge returns the max-age of the Cache-Control header, and
+// whether the response may be stored.
+func cacheControlMaxAge(h http.Header) (time.Duration, bool) {
+	var maxAge time.Duration
+	for _, directive := range strings.Split(h.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store" || directive == "no-cache":
+			return 0, false
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err != nil {
+				continue
+			}
+			if seconds <= 0 {
+				return 0, false
+			}
+			maxAge = time.Duration(seconds) * time.Second
+		}
+	}
+	return maxAge, true
+}
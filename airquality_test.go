@@ -0,0 +1,118 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ttlCache is a Cache which records the TTL of the entries set in it.
+type ttlCache struct {
+	entries map[string][]byte
+	ttls    map[string]time.Duration
+}
+
+func (c *ttlCache) Get(key string) ([]byte, bool) {
+	v, ok := c.entries[key]
+	return v, ok
+}
+
+func (c *ttlCache) Set(key string, value []byte, ttl time.Duration) {
+	c.entries[key] = value
+	c.ttls[key] = ttl
+}
+
+func TestAirQualityHeatmapTile(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/v1/mapTypes/UAQI_RED_GREEN/heatmapTiles/2/1/3":
+			w.Header().Set("Cache-Control", "public, max-age=600")
+		case "/v1/mapTypes/US_AQI/heatmapTiles/2/1/3":
+		case "/v1/mapTypes/GBR_DEFRA/heatmapTiles/2/1/3":
+			w.Header().Set("Cache-Control", "no-store")
+		default:
+			http.Error(w, "Tile not found", 404)
+			return
+		}
+		assert.Equal(t, "key=AIzaNotReallyAnAPIKey", r.URL.RawQuery)
+		w.Header().Set("Content-Type", "image/png")
+		png.Encode(w, image.NewRGBA(image.Rect(0, 0, 256, 256)))
+	}))
+	defer server.Close()
+	cache := &ttlCache{entries: make(map[string][]byte), ttls: make(map[string]time.Duration)}
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithCache(cache))
+	ctx := context.Background()
+
+	tile, err := c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityUAQIRedGreen, Zoom: 2, X: 1, Y: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, tile.MaxAge)
+	assert.False(t, tile.Cached)
+	img, err := tile.Image()
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 256, 256), img.Bounds())
+	assert.Equal(t, 10*time.Minute, cache.ttls[server.URL+"/v1/mapTypes/UAQI_RED_GREEN/heatmapTiles/2/1/3?"])
+
+	cached, err := c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityUAQIRedGreen, Zoom: 2, X: 1, Y: 3})
+	require.NoError(t, err)
+	assert.True(t, cached.Cached)
+	assert.Equal(t, tile.Data, cached.Data)
+	assert.Equal(t, 1, requests)
+
+	_, err = c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityUSAQI, Zoom: 2, X: 1, Y: 3})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultCacheTTL, cache.ttls[server.URL+"/v1/mapTypes/US_AQI/heatmapTiles/2/1/3?"])
+
+	_, err = c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityGBRDefra, Zoom: 2, X: 1, Y: 3})
+	require.NoError(t, err)
+	assert.Len(t, cache.entries, 2)
+
+	_, err = c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityCANEC, Zoom: 2, X: 1, Y: 3})
+	assert.Equal(t, http.StatusNotFound, err.(*APIError).HTTPStatus())
+	_, err = c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{MapType: AirQualityUSAQI, Zoom: 2, X: 4, Y: 0})
+	assert.Error(t, err)
+	_, err = c.AirQualityHeatmapTile(ctx, &AirQualityHeatmapTileRequest{Zoom: 2})
+	assert.Error(t, err)
+}
+
+func TestCacheControlMaxAge(t *testing.T) {
+	for header, want := range map[string]struct {
+		maxAge time.Duration
+		store  bool
+	}{
+		"":                       {0, true},
+		"public, max-age=3600":   {time.Hour, true},
+		"Max-Age=60":             {time.Minute, true},
+		"max-age=0":              {0, false},
+		"private, no-cache":      {0, false},
+		"no-store, max-age=3600": {0, false},
+	} {
+		h := make(http.Header)
+		h.Set("Cache-Control", header)
+		maxAge, store := cacheControlMaxAge(h)
+		assert.Equal(t, want.maxAge, maxAge, header)
+		assert.Equal(t, want.store, store, header)
+	}
+}
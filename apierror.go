@@ -0,0 +1,98 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"errors"
+	"net"
+	"net/http"
+)
+
+// APIError is the error returned when a Google Maps API reports an error,
+// such as OVER_QUERY_LIMIT or REQUEST_DENIED. Use errors.As to inspect it:
+//
+//	var apiErr *maps.APIError
+//	if errors.As(err, &apiErr) && apiErr.Status() == "REQUEST_DENIED" {
+//		...
+//	}
+type APIError = StatusError
+
+// statusCodeResponse is implemented by responses that record the HTTP status
+// code of the response.
+type statusCodeResponse interface {
+	setStatusCode(int)
+}
+
+func (c *commonResponse) setStatusCode(code int) {
+	c.httpStatus = code
+}
+
+func (r *googleAPIResponse) setStatusCode(code int) {
+	r.httpStatus = code
+}
+
+// HTTPStatus returns the HTTP status code of the response which reported the
+// error.
+func (e *StatusError) HTTPStatus() int {
+	return e.httpStatus
+}
+
+// httpStatuses are the statuses of image API errors, by HTTP status code.
+var httpStatuses = map[int]string{
+	http.StatusBadRequest:      "INVALID_REQUEST",
+	http.StatusForbidden:       "REQUEST_DENIED",
+	http.StatusNotFound:        "NOT_FOUND",
+	http.StatusTooManyRequests: "OVER_QUERY_LIMIT",
+}
+
+// httpStatusError returns the error of an image API response resp, which
+// reports errors with an HTTP status code and a plain text body rather than a
+// status.
+func httpStatusError(api string, resp binaryResponse, body []byte) *StatusError {
+	status, ok := httpStatuses[resp.statusCode]
+	if !ok {
+		status = "UNKNOWN_ERROR"
+	}
+	return &StatusError{status: status, message: string(body), httpStatus: resp.statusCode, api: api, retryAfter: resp.quota.retryAfter, rateLimit: resp.quota.rateLimit}
+}
+
+// ErrorStatus returns the status of the APIError in err's chain, for example
+// OVER_QUERY_LIMIT, or "" if there is none.
+func ErrorStatus(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.status
+	}
+	return ""
+}
+
+// IsQuotaError reports whether err is an APIError reporting that the request
+// was over the project's quota or rate limit.
+func IsQuotaError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.overQueryLimit()
+}
+
+// IsRetryable reports whether the request which failed with err may succeed if
+// retried later without modification: it was over its query limit, failed
+// with a server error, or timed out.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary() || apiErr.httpStatus >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
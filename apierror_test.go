@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	server := mockServer(200, `{"status": "OVER_QUERY_LIMIT", "error_message": "You have exceeded your daily request quota."}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+	err = fmt.Errorf("geocoding Sydney: %w", err)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "unexpected error %v", err)
+	assert.Equal(t, "OVER_QUERY_LIMIT", apiErr.Status())
+	assert.Equal(t, "You have exceeded your daily request quota.", apiErr.Message())
+	assert.Equal(t, 200, apiErr.HTTPStatus())
+	assert.Equal(t, "OVER_QUERY_LIMIT", ErrorStatus(err))
+	assert.True(t, IsQuotaError(err))
+	assert.True(t, IsRetryable(err))
+}
+
+func TestAPIErrorImage(t *testing.T) {
+	server := mockServer(403, "The Google Maps Platform server rejected your request.")
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	_, err := c.StaticMap(context.Background(), &StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "100x100"})
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr), "unexpected error %v", err)
+	assert.Equal(t, "REQUEST_DENIED", apiErr.Status())
+	assert.Equal(t, 403, apiErr.HTTPStatus())
+	assert.Equal(t, "Maps Static API: 403 - The Google Maps Platform server rejected your request.\n", err.Error())
+	assert.False(t, IsQuotaError(err))
+	assert.False(t, IsRetryable(err))
+
+	server503 := mockServer(503, "Service unavailable")
+	defer server503.Close()
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(server503.URL))
+	_, err = c.StaticMap(context.Background(), &StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "100x100"})
+	assert.Equal(t, "UNKNOWN_ERROR", ErrorStatus(err))
+	assert.True(t, IsRetryable(err))
+}
+
+func TestErrorHelpersOtherErrors(t *testing.T) {
+	err := errors.New("maps: Size empty")
+	assert.Equal(t, "", ErrorStatus(err))
+	assert.False(t, IsQuotaError(err))
+	assert.False(t, IsRetryable(err))
+	assert.False(t, IsRetryable(context.Canceled))
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultAutocompleteSessionMaxAge is the default MaxAge of an
+// AutocompleteSession.
+const DefaultAutocompleteSessionMaxAge = 3 * time.Minute
+
+// AutocompleteSession makes Place Autocomplete requests, and the Place Details
+// request for the chosen prediction, with the same session token, so that they
+// are billed as a single session. A new token is used once details have been
+// fetched, or once the session is older than MaxAge. It is safe for
+// concurrent use, although a session normally belongs to a single user.
+type AutocompleteSession struct {
+	// MaxAge is how long after its first prediction a session's token is
+	// replaced if no details have been fetched. Default is
+	// DefaultAutocompleteSessionMaxAge.
+	MaxAge time.Duration
+
+	client  *Client
+	request PlaceAutocompleteRequest
+	mu      sync.Mutex
+	token   PlaceAutocompleteSessionToken
+	started time.Time
+}
+
+// NewAutocompleteSession returns a session whose predictions are requested
+// with r, which may be nil, with its Input and SessionToken replaced.
+func (c *Client) NewAutocompleteSession(r *PlaceAutocompleteRequest) *AutocompleteSession {
+	s := &AutocompleteSession{client: c, token: NewPlaceAutocompleteSessionToken()}
+	if r != nil {
+		s.request = *r
+	}
+	return s
+}
+
+// Token returns the session token of the current session.
+func (s *AutocompleteSession) Token() PlaceAutocompleteSessionToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// Predict returns the predictions for input, starting a new session if the
+// current one is older than MaxAge.
+func (s *AutocompleteSession) Predict(ctx context.Context, input string) (AutocompleteResponse, error) {
+	s.mu.Lock()
+	now := s.client.clock.Now()
+	if !s.started.IsZero() && now.Sub(s.started) >= s.maxAge() {
+		s.rotate()
+	}
+	if s.started.IsZero() {
+		s.started = now
+	}
+	r := s.request
+	r.Input = input
+	r.SessionToken = s.token
+	s.mu.Unlock()
+	return s.client.PlaceAutocomplete(ctx, &r)
+}
+
+// ResolveDetails returns the fields of the details of placeID, usually that of
+// one of the predictions, which ends the session. Every field is returned if
+// fields is empty, which is billed at the highest rate. If the request fails
+// the session continues, so that it can be retried.
+func (s *AutocompleteSession) ResolveDetails(ctx context.Context, placeID string, fields []PlaceDetailsFieldMask) (PlaceDetailsResult, error) {
+	token := s.Token()
+	result, err := s.client.PlaceDetails(ctx, &PlaceDetailsRequest{
+		PlaceID:      placeID,
+		Language:     s.request.Language,
+		Fields:       fields,
+		SessionToken: token,
+	})
+	if err != nil {
+		return result, err
+	}
+	s.mu.Lock()
+	if s.token == token {
+		s.rotate()
+	}
+	s.mu.Unlock()
+	return result, nil
+}
+
+// rotate starts a new session. s.mu must be held.
+func (s *AutocompleteSession) rotate() {
+	s.token = NewPlaceAutocompleteSessionToken()
+	s.started = time.Time{}
+}
+
+func (s *AutocompleteSession) maxAge() time.Duration {
+	if s.MaxAge <= 0 {
+		return DefaultAutocompleteSessionMaxAge
+	}
+	return s.MaxAge
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutocompleteSession(t *testing.T) {
+	var tokens []string
+	status := "OK"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.URL.Query().Get("sessiontoken"))
+		if strings.HasSuffix(r.URL.Path, "/details/json") {
+			assert.Equal(t, "name,place_id", r.URL.Query().Get("fields"))
+			fmt.Fprintf(w, `{"result": {"place_id": %q, "name": "Opera House"}, "status": %q}`, r.URL.Query().Get("placeid"), status)
+			return
+		}
+		assert.Equal(t, "en-AU", r.URL.Query().Get("language"))
+		fmt.Fprint(w, `{"predictions": [{"place_id": "a", "description": "Sydney Opera House"}], "status": "OK"}`)
+	}))
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock))
+	s := c.NewAutocompleteSession(&PlaceAutocompleteRequest{Input: "ignored", Language: "en-AU"})
+	ctx := context.Background()
+	fields := []PlaceDetailsFieldMask{PlaceDetailsFieldMaskName, PlaceDetailsFieldMaskPlaceID}
+
+	for _, input := range []string{"syd", "sydney op"} {
+		resp, err := s.Predict(ctx, input)
+		require.NoError(t, err)
+		assert.Len(t, resp.Predictions, 1)
+	}
+	status = "UNKNOWN_ERROR"
+	_, err := s.ResolveDetails(ctx, "a", fields)
+	assert.Error(t, err)
+	status = "OK"
+	result, err := s.ResolveDetails(ctx, "a", fields)
+	require.NoError(t, err)
+	assert.Equal(t, "Opera House", result.Name)
+	require.Len(t, tokens, 4)
+	for _, token := range tokens[1:] {
+		assert.Equal(t, tokens[0], token, "failed details continue the session")
+	}
+
+	// Fetching details ends the session.
+	_, err = s.Predict(ctx, "syd")
+	require.NoError(t, err)
+	assert.NotEqual(t, tokens[0], tokens[4])
+
+	// As does its age.
+	<-clock.After(DefaultAutocompleteSessionMaxAge)
+	_, err = s.Predict(ctx, "syd")
+	require.NoError(t, err)
+	assert.NotEqual(t, tokens[4], tokens[5])
+	<-clock.After(time.Minute)
+	_, err = s.Predict(ctx, "syd")
+	require.NoError(t, err)
+	assert.Equal(t, tokens[5], tokens[6])
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const benchPolyline = "}~kvHmzrr@ba\\hnc@jiu@r{Zqx~@hjp@pwEhnc@zhu@zflAbxn@fhjBvqHroaAgcnAp}gAeahAtqGkngAinc@_h|@r{Zad\\y|_D}_y@swg@ysg@}llBpoZqa{@xrw@~eBaaX}{uAero@uqGadY}nr@`dYs_NquNgbjAf{l@|yh@bfc@}nr@z}q@i|i@zgz@r{ZhjFr}gApob@ff}@laIsen@dgYhdPvbIren@"
+
+const benchLeg = `{
+   "distance" : { "text" : "23.8 km", "value" : 23846 },
+   "duration" : { "text" : "37 mins", "value" : 2215 },
+   "duration_in_traffic" : { "text" : "41 mins", "value" : 2460 },
+   "arrival_time" : { "text" : "6:27pm", "time_zone" : "Australia/Sydney", "value" : 1425022047 },
+   "departure_time" : { "text" : "5:50pm", "time_zone" : "Australia/Sydney", "value" : 1425019832 },
+   "end_address" : "Parramatta NSW, Australia",
+   "end_location" : { "lat" : -33.8150, "lng" : 151.0011 },
+   "start_address" : "Sydney NSW, Australia",
+   "start_location" : { "lat" : -33.8688, "lng" : 151.2093 },
+   "steps" : [
+      {
+         "distance" : { "text" : "0.4 km", "value" : 366 },
+         "duration" : { "text" : "1 min", "value" : 70 },
+         "end_location" : { "lat" : -33.8703, "lng" : 151.2059 },
+         "html_instructions" : "Head <b>west</b> on <b>Market St</b>",
+         "polyline" : { "points" : "nyfmEkw|y[" },
+         "start_location" : { "lat" : -33.8688, "lng" : 151.2093 },
+         "travel_mode" : "DRIVING"
+      },
+      {
+         "distance" : { "text" : "23.4 km", "value" : 23480 },
+         "duration" : { "text" : "36 mins", "value" : 2145 },
+         "end_location" : { "lat" : -33.8150, "lng" : 151.0011 },
+         "html_instructions" : "Continue onto <b>M4</b>",
+         "polyline" : { "points" : "rcgmEwa|y[" },
+         "start_location" : { "lat" : -33.8703, "lng" : 151.2059 },
+         "travel_mode" : "DRIVING"
+      }
+   ],
+   "via_waypoint" : []
+}`
+
+var benchDirectionsRequest = &DirectionsRequest{
+	Origin:       "Sydney Town Hall",
+	Destination:  "Parramatta, NSW",
+	Mode:         TravelModeTransit,
+	Waypoints:    []string{"Strathfield, NSW", "Burwood, NSW"},
+	Alternatives: true,
+	Language:     "en-AU",
+	Units:        UnitsMetric,
+	Region:       "au",
+	TransitMode:  []TransitMode{TransitModeBus, TransitModeTrain},
+}
+
+// The benchmarks below cover the hot paths of decoding responses and encoding
+// requests. Baseline numbers, measured with go1.27 on linux/amd64:
+//
+//	BenchmarkDecodePolyline           2.6 µs/op     3 allocs/op
+//	BenchmarkEncodePolyline           1.9 µs/op     4 allocs/op
+//	BenchmarkLegUnmarshalJSON        21.3 µs/op    17 allocs/op
+//	BenchmarkStepUnmarshalJSON        5.7 µs/op     3 allocs/op
+//	BenchmarkDirectionsRequestParams  6.8 µs/op    28 allocs/op
+//
+// TestHotPathAllocs guards the allocation counts of these paths so that
+// performance regressions are caught by go test.
+
+func BenchmarkDecodePolyline(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodePolyline(benchPolyline); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodePolyline(b *testing.B) {
+	path, _ := DecodePolyline(benchPolyline)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Encode(path)
+	}
+}
+
+func BenchmarkLegUnmarshalJSON(b *testing.B) {
+	data := []byte(benchLeg)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var leg Leg
+		if err := json.Unmarshal(data, &leg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStepUnmarshalJSON(b *testing.B) {
+	var raw struct {
+		Steps []json.RawMessage `json:"steps"`
+	}
+	if err := json.Unmarshal([]byte(benchLeg), &raw); err != nil {
+		b.Fatal(err)
+	}
+	data := []byte(raw.Steps[0])
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var step Step
+		if err := json.Unmarshal(data, &step); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDirectionsRequestParams(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchDirectionsRequest.params().Encode()
+	}
+}
+
+func TestHotPathAllocs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation checks in short mode")
+	}
+	if raceEnabled {
+		t.Skip("skipping allocation checks with the race detector")
+	}
+	path, _ := DecodePolyline(benchPolyline)
+	legData := []byte(benchLeg)
+
+	tests := []struct {
+		name string
+		max  float64
+		f    func()
+	}{
+		{"DecodePolyline", 3, func() { DecodePolyline(benchPolyline) }},
+		{"Encode", 4, func() { Encode(path) }},
+		{"Leg.UnmarshalJSON", 22, func() {
+			var leg Leg
+			json.Unmarshal(legData, &leg)
+		}},
+		{"DirectionsRequest.params", 32, func() { benchDirectionsRequest.params().Encode() }},
+	}
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(100, test.f); allocs > test.max {
+			t.Errorf("%s: expected at most %v allocations, was %v", test.name, test.max, allocs)
+		}
+	}
+}
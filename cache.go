@@ -0,0 +1,246 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long responses are cached by a client configured
+// with WithCache, unless set with WithCacheTTL.
+const DefaultCacheTTL = time.Hour
+
+// Cache stores API response bodies for a client configured with WithCache.
+// Its methods may be called concurrently.
+type Cache interface {
+	// Get returns the value stored for key, if it has not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key for ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// WithCache configures a Maps API client to cache the successful responses of
+// the Geocoding, Time Zone, Elevation and Place Details APIs in cache, keyed by
+// their URL without credentials and any headers and call options that apply to
+// them. Requests found in the cache are not sent. Conditional requests, such as those with PlaceDetailsRequest.IfChanged set,
+// bypass the cache.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+// WithCacheTTL sets how long responses are kept in the cache set with
+// WithCache, instead of DefaultCacheTTL.
+func WithCacheTTL(ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return errors.New("maps: cache TTL must be positive")
+		}
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// cacheKey returns the key of the response to apiReq in the client's cache, or
+// "" if it is not cached. Conditional requests are not cached, as their
+// response depends on their headers. The key includes the client's headers and
+// the call options in ctx, which may also change the response.
+func (c *Client) cacheKey(ctx context.Context, config *apiConfig, apiReq apiRequest) string {
+	if c.cache == nil || !config.cacheable || dryRunFromContext(ctx) != nil {
+		return ""
+	}
+	if h, ok := apiReq.(headerRequest); ok && len(h.header()) > 0 {
+		return ""
+	}
+	host := c.hostFor(config)
+	path := config.path
+	if r, ok := apiReq.(resourceRequest); ok {
+		path += r.resourcePath()
+	}
+	key := host + path + "?" + c.defaultParams(config, apiReq.params()).Encode()
+	if opts := c.callCacheKey(ctx); opts != "" {
+		key += "#" + opts
+	}
+	return key
+}
+
+// callCacheKey returns a digest of the client's headers and the call options in
+// ctx, or "" if there are none. A digest is used so that credentials in them
+// are not stored in the cache.
+func (c *Client) callCacheKey(ctx context.Context) string {
+	v := url.Values{}
+	for k, h := range c.header {
+		v["header:"+k] = h
+	}
+	if o := callOptionsFromContext(ctx); o != nil {
+		for k, h := range o.header {
+			v["header:"+k] = h
+		}
+		if o.apiKey != "" {
+			v.Set("key", o.apiKey)
+		}
+		if len(o.fieldMask) > 0 {
+			v["fields"] = o.fieldMask
+		}
+	}
+	if len(v) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(v.Encode()))
+	return hex.EncodeToString(sum[:])
+}
+
+// cacheDuration returns how long the client caches responses.
+func (c *Client) cacheDuration() time.Duration {
+	if c.cacheTTL > 0 {
+		return c.cacheTTL
+	}
+	return DefaultCacheTTL
+}
+
+// cachedHeaders are the response headers stored in the cache with the body,
+// so that the cache validators of cached results are kept.
+var cachedHeaders = []string{"ETag", "Last-Modified"}
+
+// cacheEntry is a response stored in the cache.
+type cacheEntry struct {
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// newCacheEntry returns the encoded cache entry of a response with header and
+// body.
+func newCacheEntry(header http.Header, body []byte) ([]byte, error) {
+	e := cacheEntry{Body: body}
+	for _, k := range cachedHeaders {
+		if v := header.Get(k); v != "" {
+			if e.Header == nil {
+				e.Header = http.Header{}
+			}
+			e.Header.Set(k, v)
+		}
+	}
+	return json.Marshal(e)
+}
+
+// decodeCacheEntry decodes the response stored in the cache entry data into
+// resp, at now by the client's clock.
+func decodeCacheEntry(data []byte, resp interface{}, now time.Time) error {
+	var e cacheEntry
+	if err := unmarshalJSON(data, &e); err != nil {
+		return err
+	}
+	if err := decodeJSON(bytes.NewReader(e.Body), resp); err != nil {
+		return err
+	}
+	if h, ok := resp.(headerResponse); ok {
+		h.setHeader(e.Header, now)
+	}
+	return nil
+}
+
+// teeBody is a response body which copies what is read from it.
+type teeBody struct {
+	io.Reader
+	io.Closer
+}
+
+// LRUCache is an in-memory Cache which holds a limited number of entries,
+// evicting the least recently used when full.
+type LRUCache struct {
+	size    int
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache returns an LRUCache holding at most size entries.
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the value stored for key, if it has not expired.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*lruEntry)
+	if !l.now().Before(entry.expires) {
+		l.order.Remove(e)
+		delete(l.entries, key)
+		return nil, false
+	}
+	l.order.MoveToFront(e)
+	return entry.value, true
+}
+
+// Set stores value for key for ttl, evicting the least recently used entry if
+// the cache is full.
+func (l *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	if l.size <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	expires := l.now().Add(ttl)
+	if e, ok := l.entries[key]; ok {
+		entry := e.Value.(*lruEntry)
+		entry.value, entry.expires = value, expires
+		l.order.MoveToFront(e)
+		return
+	}
+	if l.order.Len() >= l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// which have not been evicted yet.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
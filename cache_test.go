@@ -0,0 +1,152 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := NewLRUCache(2)
+	l.now = func() time.Time { return now }
+
+	l.Set("a", []byte("1"), time.Minute)
+	l.Set("b", []byte("2"), time.Minute)
+	_, ok := l.Get("a")
+	assert.True(t, ok)
+	l.Set("c", []byte("3"), time.Minute)
+	_, ok = l.Get("b")
+	assert.False(t, ok, "least recently used entry should have been evicted")
+	v, ok := l.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", string(v))
+
+	now = now.Add(time.Minute)
+	_, ok = l.Get("a")
+	assert.False(t, ok, "entry should have expired")
+	assert.Equal(t, 1, l.Len())
+}
+
+func TestWithCache(t *testing.T) {
+	response := `{"results": [{"place_id": "ChIJP3Sa8ziYEmsRUKgyFmh9AQM"}], "status": "OK"}`
+	server := mockServerForQuery("address=Sydney&key=AIzaNotReallyAnAPIKey", 200, response)
+	defer server.s.Close()
+	cache := NewLRUCache(10)
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithCache(cache))
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, "ChIJP3Sa8ziYEmsRUKgyFmh9AQM", resp.Results[0].PlaceID)
+	}
+	assert.Equal(t, 1, server.successful)
+	assert.Equal(t, 1, cache.Len())
+
+	// Requests are not found in the cache of a different client.
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.successful)
+}
+
+func TestWithCacheCallOptions(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintln(w, `{"status": "OK", "results": []}`)
+	}))
+	defer server.Close()
+	cache := NewLRUCache(10)
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithCache(cache), WithHeader("X-Test", "client"))
+	r := &GeocodingRequest{Address: "Sydney"}
+
+	// Calls with different call options are cached separately.
+	for _, ctx := range []context.Context{
+		context.Background(),
+		WithCallOptions(context.Background(), CallHeader("X-Test", "call")),
+		WithCallOptions(context.Background(), CallAPIKey("AIzaAnotherKey")),
+		WithCallOptions(context.Background(), CallFieldMask("place_id")),
+		WithCallOptions(context.Background(), CallFieldMask("place_id")),
+	} {
+		_, err := c.Geocode(ctx, r)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, requests)
+	assert.Equal(t, 4, cache.Len())
+
+	// Keys do not contain credentials.
+	key := c.cacheKey(WithCallOptions(context.Background(), CallAPIKey("AIzaAnotherKey")), geocodingAPI, r)
+	assert.NotContains(t, key, "AIzaAnotherKey")
+}
+
+func TestWithCacheSkipsErrors(t *testing.T) {
+	server := mockServerForQuery("address=Sydney&key=AIzaNotReallyAnAPIKey", 200, `{"status": "OVER_QUERY_LIMIT"}`)
+	defer server.s.Close()
+	cache := NewLRUCache(10)
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithCache(cache))
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 2, server.successful)
+	assert.Equal(t, 0, cache.Len())
+
+	_, err := NewClient(WithAPIKey(apiKey), WithCacheTTL(0))
+	assert.Error(t, err)
+}
+
+func TestWithCacheIfChanged(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "ChIJ02qnq0KuEmsRHUJF4zo1x4I"}}`)
+	}))
+	defer server.Close()
+	cache := NewLRUCache(10)
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithCache(cache))
+	r := &PlaceDetailsRequest{PlaceID: "ChIJ02qnq0KuEmsRHUJF4zo1x4I"}
+
+	// Cached results keep their cache validators.
+	for i := 0; i < 2; i++ {
+		resp, err := c.PlaceDetails(context.Background(), r)
+		require.NoError(t, err)
+		require.NotNil(t, resp.CacheValidators)
+		assert.Equal(t, `"v1"`, resp.CacheValidators.ETag)
+	}
+	assert.Equal(t, 1, requests)
+
+	// Conditional requests bypass the cache.
+	r.IfChanged = &CacheValidators{ETag: `"v1"`}
+	_, err := c.PlaceDetails(context.Background(), r)
+	assert.Equal(t, ErrNotModified, err)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, cache.Len())
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const contextCallOptions = contextKey("CALL-OPTIONS")
+
+// CallOption configures the API calls made with a context returned by
+// WithCallOptions, overriding the client and request configuration.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	apiKey    string
+	fieldMask []string
+	header    http.Header
+	metadata  *ResponseMetadata
+}
+
+// WithCallOptions returns a context which applies opts to the API calls made
+// with it, after any call options already set in ctx.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	o := &callOptions{header: http.Header{}}
+	if parent := callOptionsFromContext(ctx); parent != nil {
+		o.apiKey = parent.apiKey
+		o.fieldMask = parent.fieldMask
+		o.metadata = parent.metadata
+		for k, v := range parent.header {
+			o.header[k] = append([]string(nil), v...)
+		}
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return context.WithValue(ctx, contextCallOptions, o)
+}
+
+// callOptionsFromContext returns the call options set by WithCallOptions, or
+// nil.
+func callOptionsFromContext(ctx context.Context) *callOptions {
+	if o, ok := ctx.Value(contextCallOptions).(*callOptions); ok {
+		return o
+	}
+	return nil
+}
+
+// CallAPIKey makes calls use key instead of the client's credentials. Such
+// calls are not signed.
+func CallAPIKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.apiKey = key
+	}
+}
+
+// CallFieldMask sets the response fields returned by APIs that accept a field
+// mask, such as the Routes API, replacing the field mask of the request.
+func CallFieldMask(fields ...string) CallOption {
+	return func(o *callOptions) {
+		o.fieldMask = fields
+	}
+}
+
+// CallHeader adds an HTTP header to calls, replacing any header with the same
+// key set with WithHeader. Like those, it is added by the client's transport
+// after the request is signed.
+func CallHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.header.Add(key, value)
+	}
+}
+
+// applyCallOptions sets the field mask of the call options in ctx on req.
+// Headers set with CallHeader are added by the client's transport.
+func applyCallOptions(ctx context.Context, req *http.Request) {
+	if o := callOptionsFromContext(ctx); o != nil && len(o.fieldMask) > 0 {
+		req.Header.Set("X-Goog-FieldMask", strings.Join(o.fieldMask, ","))
+	}
+}
+
+// authQuery returns the encoded query q with the credentials for the call,
+// taken from the call options in ctx or the client.
+func (c *Client) authQuery(ctx context.Context, config *apiConfig, q url.Values) (string, error) {
+	if o := callOptionsFromContext(ctx); o != nil && o.apiKey != "" {
+		if c.channel != "" {
+			q.Set("channel", c.channel)
+		}
+		q.Set("key", o.apiKey)
+		return q.Encode(), nil
+	}
+	return c.generateAuthQuery(config.path, q, config.acceptsClientID, config.acceptsSignature)
+}
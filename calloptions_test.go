@@ -0,0 +1,76 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientWithCallOptions(t *testing.T) {
+	var req *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"results": [], "status": "OK"}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	ctx := WithCallOptions(context.Background(), CallHeader("X-Proxy", "a"), CallAPIKey("override"))
+	ctx = WithCallOptions(ctx, CallHeader("X-Proxy", "b"), CallHeader("X-Tenant", "maps"))
+	_, err := c.Elevation(ctx, r)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, req.Header["X-Proxy"])
+	assert.Equal(t, "maps", req.Header.Get("X-Tenant"))
+	assert.Equal(t, "override", req.URL.Query().Get("key"))
+
+	// Options do not leak into calls made with the parent context.
+	_, err = c.Elevation(context.Background(), r)
+	assert.NoError(t, err)
+	assert.Empty(t, req.Header.Get("X-Proxy"))
+	assert.Equal(t, apiKey, req.URL.Query().Get("key"))
+}
+
+func TestComputeRoutesCallFieldMask(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"routes": [{"duration": "10s"}]}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &ComputeRoutesRequest{
+		Origin:      RoutesWaypoint{Address: "Sydney"},
+		Destination: RoutesWaypoint{Address: "Parramatta"},
+	}
+
+	ctx := WithCallOptions(context.Background(), CallFieldMask("routes.duration", "routes.distanceMeters"))
+	_, err := c.ComputeRoutes(ctx, r)
+	assert.NoError(t, err)
+	assert.Equal(t, "routes.duration,routes.distanceMeters", header.Get("X-Goog-FieldMask"))
+
+	r.FieldMask = []string{"*"}
+	_, err = c.ComputeRoutes(ctx, r)
+	assert.NoError(t, err)
+	assert.Equal(t, "routes.duration,routes.distanceMeters", header.Get("X-Goog-FieldMask"))
+}
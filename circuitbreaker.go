@@ -0,0 +1,213 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without making a request when the circuit breaker
+// of the request's endpoint is open.
+var ErrCircuitOpen = errors.New("maps: circuit breaker open")
+
+// CircuitBreaker configures the circuit breakers of a client, which stop
+// requests to an endpoint that is failing with server or network errors. Once
+// a circuit opens, requests to its endpoint fail with ErrCircuitOpen until
+// OpenDuration has passed, after which a single probe request is let through:
+// if it succeeds the circuit closes, otherwise it opens again.
+type CircuitBreaker struct {
+	// ConsecutiveFailures is the number of consecutive failures which opens
+	// the circuit. Default is 5.
+	ConsecutiveFailures int
+	// FailureRate is the fraction of failures among the last Window requests
+	// which opens the circuit. Zero means the failure rate is not considered.
+	FailureRate float64
+	// Window is the number of most recent requests FailureRate is measured
+	// over. Default is 20.
+	Window int
+	// OpenDuration is how long the circuit stays open before a probe request
+	// is allowed. Default is 30s.
+	OpenDuration time.Duration
+}
+
+// WithCircuitBreaker configures a Maps API client with a circuit breaker for
+// each endpoint. Requests fail when they get a 5xx response or no response,
+// other than because their context is done. Default is no circuit breaker.
+func WithCircuitBreaker(cb CircuitBreaker) ClientOption {
+	return func(c *Client) error {
+		if cb.ConsecutiveFailures < 0 || cb.Window < 0 || cb.OpenDuration < 0 {
+			return errors.New("maps: negative value in CircuitBreaker")
+		}
+		if cb.FailureRate < 0 || cb.FailureRate > 1 {
+			return errors.New("maps: CircuitBreaker FailureRate must be between 0 and 1")
+		}
+		if cb.ConsecutiveFailures == 0 {
+			cb.ConsecutiveFailures = 5
+		}
+		if cb.Window == 0 {
+			cb.Window = 20
+		}
+		if cb.OpenDuration == 0 {
+			cb.OpenDuration = 30 * time.Second
+		}
+		c.circuitBreaker = &cb
+		c.circuits = make(map[Endpoint]*circuit)
+		return nil
+	}
+}
+
+// circuitState is the state of a circuit.
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuit is the circuit breaker of one endpoint.
+type circuit struct {
+	mu          sync.Mutex
+	config      *CircuitBreaker
+	state       circuitState
+	openedAt    time.Time
+	probing     bool
+	consecutive int
+	// outcomes records whether each of the last config.Window requests
+	// failed, as a ring buffer starting at next.
+	outcomes []bool
+	next     int
+	failures int
+}
+
+// circuitFor returns the circuit of the endpoint of the request made with ctx,
+// or nil if the client has no circuit breaker.
+func (c *Client) circuitFor(ctx context.Context) *circuit {
+	if c.circuitBreaker == nil {
+		return nil
+	}
+	endpoint, ok := EndpointFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	c.circuitsMu.Lock()
+	defer c.circuitsMu.Unlock()
+	cb, ok := c.circuits[endpoint]
+	if !ok {
+		cb = &circuit{config: c.circuitBreaker}
+		c.circuits[endpoint] = cb
+	}
+	return cb
+}
+
+// allow reports whether a request may be made at now. A request allowed while
+// the circuit is half open is its probe, whose result must be recorded.
+func (cb *circuit) allow(now time.Time) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if now.Sub(cb.openedAt) < cb.config.OpenDuration {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		fallthrough
+	case circuitHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+	}
+	return true
+}
+
+// record records the result of an allowed request which ended at now.
+func (cb *circuit) record(failed bool, now time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.state == circuitHalfOpen {
+		cb.probing = false
+		if failed {
+			cb.open(now)
+		} else {
+			cb.reset()
+		}
+		return
+	}
+
+	if failed {
+		cb.consecutive++
+	} else {
+		cb.consecutive = 0
+	}
+	if len(cb.outcomes) < cb.config.Window {
+		cb.outcomes = append(cb.outcomes, failed)
+	} else {
+		if cb.outcomes[cb.next] {
+			cb.failures--
+		}
+		cb.outcomes[cb.next] = failed
+		cb.next = (cb.next + 1) % cb.config.Window
+	}
+	if failed {
+		cb.failures++
+	}
+
+	if cb.consecutive >= cb.config.ConsecutiveFailures {
+		cb.open(now)
+		return
+	}
+	rate := cb.config.FailureRate
+	if rate > 0 && len(cb.outcomes) == cb.config.Window && float64(cb.failures) >= rate*float64(cb.config.Window) {
+		cb.open(now)
+	}
+}
+
+// release gives up the probe of a half open circuit whose request ended
+// without a result, such as when its context was canceled.
+func (cb *circuit) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.probing = false
+}
+
+func (cb *circuit) open(now time.Time) {
+	cb.state = circuitOpen
+	cb.openedAt = now
+}
+
+func (cb *circuit) reset() {
+	cb.state = circuitClosed
+	cb.consecutive = 0
+	cb.outcomes = cb.outcomes[:0]
+	cb.next = 0
+	cb.failures = 0
+}
+
+// recordResponse records the result of a request made with ctx in cb.
+func (c *Client) recordResponse(ctx context.Context, cb *circuit, resp *http.Response, err error) {
+	if cb == nil {
+		return
+	}
+	if err != nil && ctx.Err() != nil {
+		cb.release()
+		return
+	}
+	cb.record(err != nil || resp.StatusCode >= 500, c.clock.Now())
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	status, requests := http.StatusInternalServerError, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			fmt.Fprintln(w, `{"results" : [], "status" : "OK"}`)
+		}
+	}))
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock),
+		WithCircuitBreaker(CircuitBreaker{ConsecutiveFailures: 3, OpenDuration: time.Minute}))
+	ctx := context.Background()
+	elevation := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	for i := 0; i < 3; i++ {
+		_, err := c.Elevation(ctx, elevation)
+		assert.Error(t, err)
+		assert.NotEqual(t, ErrCircuitOpen, err)
+	}
+	_, err := c.Elevation(ctx, elevation)
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Equal(t, 3, requests)
+
+	// Other endpoints have their own circuit.
+	_, err = c.Timezone(ctx, &TimezoneRequest{Location: &LatLng{Lat: 1, Lng: 2}})
+	assert.NotEqual(t, ErrCircuitOpen, err)
+	assert.Equal(t, 4, requests)
+
+	// A failed probe opens the circuit again.
+	<-clock.After(time.Minute)
+	_, err = c.Elevation(ctx, elevation)
+	assert.NotEqual(t, ErrCircuitOpen, err)
+	_, err = c.Elevation(ctx, elevation)
+	assert.Equal(t, ErrCircuitOpen, err)
+	assert.Equal(t, 5, requests)
+
+	// A successful probe closes it.
+	status = http.StatusOK
+	<-clock.After(time.Minute)
+	for i := 0; i < 3; i++ {
+		_, err = c.Elevation(ctx, elevation)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 8, requests)
+}
+
+func TestCircuitBreakerFailureRate(t *testing.T) {
+	cb := &circuit{config: &CircuitBreaker{ConsecutiveFailures: 5, FailureRate: 0.5, Window: 4, OpenDuration: time.Second}}
+	now := time.Unix(1500000000, 0)
+	for _, failed := range []bool{true, false, true} {
+		assert.True(t, cb.allow(now))
+		cb.record(failed, now)
+	}
+	assert.Equal(t, circuitClosed, cb.state)
+	assert.True(t, cb.allow(now))
+	cb.record(false, now)
+	assert.Equal(t, circuitOpen, cb.state, "2 failures of the last 4 requests")
+	assert.False(t, cb.allow(now))
+
+	now = now.Add(time.Second)
+	assert.True(t, cb.allow(now))
+	assert.False(t, cb.allow(now), "only one probe at a time")
+	cb.release()
+	assert.True(t, cb.allow(now))
+	cb.record(false, now)
+	assert.Equal(t, circuitClosed, cb.state)
+}
+
+func TestWithCircuitBreakerInvalid(t *testing.T) {
+	_, err := NewClient(WithAPIKey(apiKey), WithCircuitBreaker(CircuitBreaker{FailureRate: 2}))
+	assert.Error(t, err)
+	_, err = NewClient(WithAPIKey(apiKey), WithCircuitBreaker(CircuitBreaker{OpenDuration: -time.Second}))
+	assert.Error(t, err)
+}
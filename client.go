@@ -22,9 +22,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/time/rate"
 	"googlemaps.github.io/maps/internal"
@@ -36,13 +39,33 @@ type Client struct {
 	httpClient        *http.Client
 	apiKey            string
 	baseURL           string
+	baseURLs          map[Endpoint]string
 	clientID          string
 	signature         []byte
 	requestsPerSecond int
 	rateLimiter       *rate.Limiter
+	apiRateLimits     map[Endpoint]int
+	apiRateLimiters   map[Endpoint]*rate.Limiter
 	channel           string
 	experienceId      []string
 	metricReporter    metrics.Reporter
+	clock             Clock
+	defaultLanguage   string
+	defaultRegion     string
+	retryPolicy       *RetryPolicy
+	header            http.Header
+	endpointTimeouts  map[Endpoint]time.Duration
+	throttleMu        sync.Mutex
+	throttledUntil    time.Time
+	quotaBackoff      QuotaBackoff
+	strictValidation  bool
+	circuitBreaker    *CircuitBreaker
+	circuitsMu        sync.Mutex
+	circuits          map[Endpoint]*circuit
+	cache             Cache
+	cacheTTL          time.Duration
+	middlewares       []Middleware
+	logger            Logger
 }
 
 // ClientOption is the type of constructor options for NewClient(...).
@@ -58,6 +81,9 @@ func (c contextKey) String() string {
 const (
 	ExperienceIdHeaderName = "X-GOOG-MAPS-EXPERIENCE-ID"
 	contextExperienceId    = contextKey("EXP-IDS")
+	contextExperienceIDs   = contextKey("EXP-IDS-OVERRIDE")
+	contextRoundTripper    = contextKey("ROUND-TRIPPER")
+	contextHeader          = contextKey("HEADER")
 )
 
 // NewClient constructs a new Client which can make requests to the Google Maps
@@ -66,6 +92,7 @@ func NewClient(options ...ClientOption) (*Client, error) {
 	c := &Client{
 		requestsPerSecond: defaultRequestsPerSecond,
 		metricReporter:    metrics.NoOpReporter{},
+		clock:             realClock{},
 	}
 	WithHTTPClient(&http.Client{})(c)
 	for _, option := range options {
@@ -81,6 +108,14 @@ func NewClient(options ...ClientOption) (*Client, error) {
 	if c.requestsPerSecond > 0 {
 		c.rateLimiter = rate.NewLimiter(rate.Limit(c.requestsPerSecond), c.requestsPerSecond)
 	}
+	c.apiRateLimiters = make(map[Endpoint]*rate.Limiter)
+	for endpoint, requestsPerSecond := range c.apiRateLimits {
+		if requestsPerSecond > 0 {
+			c.apiRateLimiters[endpoint] = rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond)
+		} else {
+			c.apiRateLimiters[endpoint] = nil
+		}
+	}
 
 	return c, nil
 }
@@ -102,6 +137,20 @@ func WithHTTPClient(c *http.Client) ClientOption {
 	}
 }
 
+// WithHeader configures a Maps API client to add an HTTP header to every
+// request, for example for an authorizing proxy. Headers are added by the
+// client's transport after requests are signed. Headers set with CallHeader
+// replace those with the same key.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.header == nil {
+			c.header = http.Header{}
+		}
+		c.header.Add(key, value)
+		return nil
+	}
+}
+
 // WithAPIKey configures a Maps API client with an API Key
 func WithAPIKey(apiKey string) ClientOption {
 	return func(c *Client) error {
@@ -141,6 +190,30 @@ func ExperienceIdFromContext(ctx context.Context) []string {
 	return nil
 }
 
+// WithExperienceIDs returns a context which makes requests issued with it send
+// ids as their experience IDs, in place of those of the client and of
+// ExperienceIdContext. With no ids, requests are sent without experience IDs.
+func WithExperienceIDs(ctx context.Context, ids ...string) context.Context {
+	return context.WithValue(ctx, contextExperienceIDs, append([]string{}, ids...))
+}
+
+// WithRoundTripper returns a context which makes requests issued with it use rt
+// instead of the transport of the client's http.Client. This is useful to
+// simulate timeouts, errors and malformed responses for a specific call in
+// tests without constructing a new client.
+func WithRoundTripper(ctx context.Context, rt http.RoundTripper) context.Context {
+	return context.WithValue(ctx, contextRoundTripper, rt)
+}
+
+// roundTripperFromContext returns the http.RoundTripper set by WithRoundTripper,
+// if any.
+func roundTripperFromContext(ctx context.Context) http.RoundTripper {
+	if rt, ok := ctx.Value(contextRoundTripper).(http.RoundTripper); ok {
+		return rt
+	}
+	return nil
+}
+
 // WithBaseURL configures a Maps API client with a custom base url
 func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) error {
@@ -149,6 +222,30 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// WithBaseURLFor configures a Maps API client to send requests to endpoint to
+// baseURL instead of the API's host, for example to route a single API
+// through a proxy. It takes precedence over WithBaseURL for endpoint.
+func WithBaseURLFor(endpoint Endpoint, baseURL string) ClientOption {
+	return func(c *Client) error {
+		if c.baseURLs == nil {
+			c.baseURLs = make(map[Endpoint]string)
+		}
+		c.baseURLs[endpoint] = baseURL
+		return nil
+	}
+}
+
+// hostFor returns the scheme and host requests to config are sent to.
+func (c *Client) hostFor(config *apiConfig) string {
+	if baseURL, ok := c.baseURLs[Endpoint(config.path)]; ok {
+		return baseURL
+	}
+	if c.baseURL != "" {
+		return c.baseURL
+	}
+	return config.host
+}
+
 // WithChannel configures a Maps API client with a Channel
 func WithChannel(channel string) ClientOption {
 	return func(c *Client) error {
@@ -180,6 +277,26 @@ func WithRateLimit(requestsPerSecond int) ClientOption {
 	}
 }
 
+// WithRateLimitPerAPI configures the rate limits for back end requests to
+// particular endpoints, such as EndpointGeocoding, in requests per second.
+// Each endpoint has its own limit instead of sharing the one configured with
+// WithRateLimit, which still applies to the other endpoints. A value of zero
+// disables rate limiting for the endpoint.
+func WithRateLimitPerAPI(limits map[Endpoint]int) ClientOption {
+	return func(c *Client) error {
+		if c.apiRateLimits == nil {
+			c.apiRateLimits = make(map[Endpoint]int)
+		}
+		for endpoint, requestsPerSecond := range limits {
+			if requestsPerSecond < 0 {
+				return fmt.Errorf("maps: negative rate limit for %s", endpoint)
+			}
+			c.apiRateLimits[endpoint] = requestsPerSecond
+		}
+		return nil
+	}
+}
+
 // WithExperienceId configures the client with an initial experience id that
 // can be changed with the `setExperienceId` method.
 func WithExperienceId(ids ...string) ClientOption {
@@ -189,6 +306,31 @@ func WithExperienceId(ids ...string) ClientOption {
 	}
 }
 
+// WithExperienceID configures the client to send ids in the
+// X-Goog-Maps-Experience-ID header of each request. They can be overridden
+// for a request with WithExperienceIDs.
+func WithExperienceID(ids ...string) ClientOption {
+	return WithExperienceId(ids...)
+}
+
+// WithDefaultLanguage configures the language of results for requests which
+// accept a language but leave it empty.
+func WithDefaultLanguage(language string) ClientOption {
+	return func(c *Client) error {
+		c.defaultLanguage = language
+		return nil
+	}
+}
+
+// WithDefaultRegion configures the region bias for requests which accept a
+// region but leave it empty.
+func WithDefaultRegion(region string) ClientOption {
+	return func(c *Client) error {
+		c.defaultRegion = region
+		return nil
+	}
+}
+
 func WithMetricReporter(reporter metrics.Reporter) ClientOption {
 	return func(c *Client) error {
 		c.metricReporter = reporter
@@ -201,52 +343,112 @@ type apiConfig struct {
 	path             string
 	acceptsClientID  bool
 	acceptsSignature bool
+	acceptsLanguage  bool
+	acceptsRegion    bool
+	// cacheable marks idempotent GET APIs whose responses may be cached.
+	cacheable bool
 }
 
 type apiRequest interface {
 	params() url.Values
 }
 
-func (c *Client) awaitRateLimiter(ctx context.Context) error {
-	if c.rateLimiter == nil {
+// resourceRequest is implemented by GET requests for a resource, whose path
+// is appended to the API path.
+type resourceRequest interface {
+	resourcePath() string
+}
+
+// headerRequest is implemented by requests that set HTTP headers.
+type headerRequest interface {
+	header() http.Header
+}
+
+// defaultParams sets the client's default language and region in q, if the API
+// accepts them and the request left them empty.
+func (c *Client) defaultParams(config *apiConfig, q url.Values) url.Values {
+	if config.acceptsLanguage && c.defaultLanguage != "" && q.Get("language") == "" {
+		q.Set("language", c.defaultLanguage)
+	}
+	if config.acceptsRegion && c.defaultRegion != "" && q.Get("region") == "" {
+		q.Set("region", c.defaultRegion)
+	}
+	return q
+}
+
+// rateLimiterFor returns the rate limiter of requests to config, or nil if
+// they are not rate limited.
+func (c *Client) rateLimiterFor(config *apiConfig) *rate.Limiter {
+	if limiter, ok := c.apiRateLimiters[Endpoint(config.path)]; ok {
+		return limiter
+	}
+	return c.rateLimiter
+}
+
+func (c *Client) awaitRateLimiter(ctx context.Context, config *apiConfig) error {
+	if dryRunFromContext(ctx) != nil {
 		return nil
 	}
-	return c.rateLimiter.Wait(ctx)
+	if err := c.awaitThrottle(ctx); err != nil {
+		return err
+	}
+	limiter := c.rateLimiterFor(config)
+	if limiter == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	now := c.clock.Now()
+	r := limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return errors.New("maps: rate limiter burst exceeded")
+	}
+	if err := c.sleep(ctx, r.DelayFrom(now)); err != nil {
+		r.CancelAt(c.clock.Now())
+		return err
+	}
+	return nil
 }
 
 func (c *Client) get(ctx context.Context, config *apiConfig, apiReq apiRequest) (*http.Response, error) {
-	if err := c.awaitRateLimiter(ctx); err != nil {
+	if err := c.awaitRateLimiter(ctx, config); err != nil {
 		return nil, err
 	}
 
-	host := config.host
-	if c.baseURL != "" {
-		host = c.baseURL
+	host := c.hostFor(config)
+	path := config.path
+	if r, ok := apiReq.(resourceRequest); ok {
+		path += r.resourcePath()
 	}
-	req, err := http.NewRequest("GET", host+config.path, nil)
+	req, err := http.NewRequest("GET", host+path, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if h, ok := apiReq.(headerRequest); ok {
+		for k, v := range h.header() {
+			req.Header[k] = v
+		}
+	}
+
 	c.setExperienceIdHeader(ctx, req)
+	applyCallOptions(ctx, req)
 
-	q, err := c.generateAuthQuery(config.path, apiReq.params(), config.acceptsClientID, config.acceptsSignature)
+	q, err := c.authQuery(ctx, config, c.defaultParams(config, apiReq.params()))
 	if err != nil {
 		return nil, err
 	}
 	req.URL.RawQuery = q
-	return c.do(ctx, req)
+	return c.do(context.WithValue(ctx, contextEndpoint, Endpoint(config.path)), req)
 }
 
 func (c *Client) post(ctx context.Context, config *apiConfig, apiReq interface{}) (*http.Response, error) {
-	if err := c.awaitRateLimiter(ctx); err != nil {
+	if err := c.awaitRateLimiter(ctx, config); err != nil {
 		return nil, err
 	}
 
-	host := config.host
-	if c.baseURL != "" {
-		host = c.baseURL
-	}
+	host := c.hostFor(config)
 
 	body, err := json.Marshal(apiReq)
 	if err != nil {
@@ -257,16 +459,22 @@ func (c *Client) post(ctx context.Context, config *apiConfig, apiReq interface{}
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if h, ok := apiReq.(headerRequest); ok {
+		for k, v := range h.header() {
+			req.Header[k] = v
+		}
+	}
 
 	c.setExperienceIdHeader(ctx, req)
+	applyCallOptions(ctx, req)
 
-	q, err := c.generateAuthQuery(config.path, url.Values{}, config.acceptsClientID, config.acceptsSignature)
+	q, err := c.authQuery(ctx, config, url.Values{})
 	if err != nil {
 		return nil, err
 	}
 
 	req.URL.RawQuery = q
-	return c.do(ctx, req)
+	return c.do(context.WithValue(ctx, contextEndpoint, Endpoint(config.path)), req)
 }
 
 func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
@@ -274,35 +482,137 @@ func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, err
 	if client == nil {
 		client = http.DefaultClient
 	}
-	return client.Do(req.WithContext(ctx))
+	if len(c.header) > 0 {
+		ctx = context.WithValue(ctx, contextHeader, c.header)
+	}
+	if d := dryRunFromContext(ctx); d != nil {
+		_, err := (&transport{Base: d}).RoundTrip(req.WithContext(ctx))
+		return nil, err
+	}
+	rt := roundTripperFromContext(ctx)
+	if rt == nil && len(c.middlewares) > 0 {
+		rt = client.Transport
+		if t, ok := rt.(*transport); ok {
+			rt = t.Base
+		}
+		if rt == nil {
+			rt = http.DefaultTransport
+		}
+	}
+	if rt != nil {
+		override := *client
+		override.Transport = &transport{Base: c.withMiddlewares(rt)}
+		client = &override
+	}
+	cb := c.circuitFor(ctx)
+	if cb != nil && !cb.allow(c.clock.Now()) {
+		return nil, ErrCircuitOpen
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	c.recordResponse(ctx, cb, resp, err)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+	recordResponseMetadata(ctx, resp)
+	resp.Body = newContextBody(ctx, resp.Body)
+	return resp, nil
 }
 
 func (c *Client) getJSON(ctx context.Context, config *apiConfig, apiReq apiRequest, resp interface{}) error {
-	requestMetrics := c.metricReporter.NewRequest(config.path)
-	httpResp, err := c.get(ctx, config, apiReq)
-	if err != nil {
-		requestMetrics.EndRequest(ctx, err, httpResp, "")
-		return err
+	key := c.cacheKey(ctx, config, apiReq)
+	if key != "" {
+		if data, ok := c.cache.Get(key); ok {
+			return decodeCacheEntry(data, resp, c.clock.Now())
+		}
 	}
-	defer httpResp.Body.Close()
 
-	err = json.NewDecoder(httpResp.Body).Decode(resp)
-	requestMetrics.EndRequest(ctx, err, httpResp, httpResp.Header.Get("x-goog-maps-metro-area"))
+	ctx, cancel := c.withEndpointTimeout(ctx, config)
+	defer cancel()
+	var body bytes.Buffer
+	var header http.Header
+	err := c.withRetry(ctx, resp, func() (int, error) {
+		body.Reset()
+		return c.doJSON(ctx, config, resp, func() (*http.Response, error) {
+			httpResp, err := c.get(ctx, config, apiReq)
+			if err == nil && key != "" && httpResp.StatusCode == http.StatusOK {
+				header = httpResp.Header
+				httpResp.Body = teeBody{io.TeeReader(httpResp.Body, &body), httpResp.Body}
+			}
+			return httpResp, err
+		})
+	})
+	if r, ok := resp.(interface{ StatusError() error }); ok && err == nil && key != "" && body.Len() > 0 && r.StatusError() == nil {
+		if data, err := newCacheEntry(header, body.Bytes()); err == nil {
+			c.cache.Set(key, data, c.cacheDuration())
+		}
+	}
 	return err
 }
 
 func (c *Client) postJSON(ctx context.Context, config *apiConfig, apiReq interface{}, resp interface{}) error {
+	ctx, cancel := c.withEndpointTimeout(ctx, config)
+	defer cancel()
+	return c.withRetry(ctx, resp, func() (int, error) {
+		return c.doJSON(ctx, config, resp, func() (*http.Response, error) {
+			return c.post(ctx, config, apiReq)
+		})
+	})
+}
+
+// doJSON makes a single request with send, decoding the JSON response into
+// resp. It returns the HTTP status code of the response, or 0 if there was no
+// response.
+func (c *Client) doJSON(ctx context.Context, config *apiConfig, resp interface{}, send func() (*http.Response, error)) (int, error) {
 	requestMetrics := c.metricReporter.NewRequest(config.path)
-	httpResp, err := c.post(ctx, config, apiReq)
+	start := c.clock.Now()
+	httpResp, err := send()
 	if err != nil {
 		requestMetrics.EndRequest(ctx, err, httpResp, "")
-		return err
+		c.logRequest(ctx, config, start, httpResp, err, nil)
+		return 0, err
 	}
 	defer httpResp.Body.Close()
 
-	err = json.NewDecoder(httpResp.Body).Decode(resp)
+	if httpResp.StatusCode == http.StatusNotModified {
+		requestMetrics.EndRequest(ctx, ErrNotModified, httpResp, "")
+		c.logRequest(ctx, config, start, httpResp, nil, nil)
+		return httpResp.StatusCode, ErrNotModified
+	}
+	err = decodeResponse(ctx, requestMetrics, httpResp, resp)
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		err = ctxErr
+	}
+	if h, ok := resp.(headerResponse); ok && err == nil {
+		h.setHeader(httpResp.Header, c.clock.Now())
+	}
+	if s, ok := resp.(statusCodeResponse); ok && err == nil {
+		s.setStatusCode(httpResp.StatusCode)
+	}
+	if q, ok := resp.(quotaResponse); ok && err == nil {
+		q.setQuota(parseQuota(httpResp.Header, c.clock.Now()))
+	}
 	requestMetrics.EndRequest(ctx, err, httpResp, httpResp.Header.Get("x-goog-maps-metro-area"))
-	return err
+	c.logRequest(ctx, config, start, httpResp, err, resp)
+	return httpResp.StatusCode, err
+}
+
+// decodeResponse decodes the JSON body of httpResp into resp, first passing the
+// raw body to requestMetrics if it is a metrics.BodyInspector.
+func decodeResponse(ctx context.Context, requestMetrics metrics.Request, httpResp *http.Response, resp interface{}) error {
+	inspector, ok := requestMetrics.(metrics.BodyInspector)
+	if !ok {
+		return decodeJSON(httpResp.Body, resp)
+	}
+	body, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+	httpResp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	inspector.OnBeforeDecode(ctx, httpResp)
+	return decodeJSON(bytes.NewReader(body), resp)
 }
 
 func (c *Client) setExperienceId(ids ...string) {
@@ -318,6 +628,12 @@ func (c *Client) clearExperienceId() {
 }
 
 func (c *Client) setExperienceIdHeader(ctx context.Context, req *http.Request) {
+	if ids, ok := ctx.Value(contextExperienceIDs).([]string); ok {
+		if len(ids) != 0 {
+			req.Header.Set(ExperienceIdHeaderName, strings.Join(ids, ","))
+		}
+		return
+	}
 	var ids []string
 	if len(c.experienceId) > 0 {
 		ids = append(ids, c.experienceId...)
@@ -335,19 +651,33 @@ func (c *Client) setExperienceIdHeader(ctx context.Context, req *http.Request) {
 type binaryResponse struct {
 	statusCode  int
 	contentType string
+	header      http.Header
 	data        io.ReadCloser
+	quota       quota
 }
 
 func (c *Client) getBinary(ctx context.Context, config *apiConfig, apiReq apiRequest) (binaryResponse, error) {
+	ctx, cancel := c.withEndpointTimeout(ctx, config)
 	requestMetrics := c.metricReporter.NewRequest(config.path)
+	start := c.clock.Now()
 	httpResp, err := c.get(ctx, config, apiReq)
 	if err != nil {
+		cancel()
 		requestMetrics.EndRequest(ctx, err, httpResp, "")
+		c.logRequest(ctx, config, start, httpResp, err, nil)
 		return binaryResponse{}, err
 	}
 
+	stopTimeout(ctx)
 	requestMetrics.EndRequest(ctx, err, httpResp, httpResp.Header.Get("x-goog-maps-metro-area"))
-	return binaryResponse{httpResp.StatusCode, httpResp.Header.Get("Content-Type"), httpResp.Body}, nil
+	c.logRequest(ctx, config, start, httpResp, nil, nil)
+	body := cancelOnClose{httpResp.Body, cancel}
+	resp := binaryResponse{httpResp.StatusCode, httpResp.Header.Get("Content-Type"), httpResp.Header, body, quota{}}
+	if resp.statusCode != http.StatusOK {
+		resp.quota = parseQuota(httpResp.Header, c.clock.Now())
+		c.backoffStatus(resp.statusCode, resp.quota.retryAfter, false)
+	}
+	return resp, nil
 }
 
 func (c *Client) generateAuthQuery(path string, q url.Values, acceptClientID bool, acceptsSignature bool) (string, error) {
@@ -377,13 +707,84 @@ type commonResponse struct {
 
 	// ErrorMessage is the explanatory field added when Status is an error.
 	ErrorMessage string `json:"error_message"`
+
+	httpStatus int
+	quota      quota
 }
 
-// StatusError returns an error if this object has a Status different
+// StatusError returns a *StatusError if this object has a Status different
 // from OK or ZERO_RESULTS.
 func (c *commonResponse) StatusError() error {
 	if c.Status != "OK" && c.Status != "ZERO_RESULTS" {
-		return fmt.Errorf("maps: %s - %s", c.Status, c.ErrorMessage)
+		return &StatusError{status: c.Status, message: c.ErrorMessage, httpStatus: c.httpStatus, retryAfter: c.quota.retryAfter, rateLimit: c.quota.rateLimit}
 	}
 	return nil
 }
+
+// googleAPIResponse is the error of a response from the newer Google Maps APIs,
+// such as the Address Validation and Routes APIs, which report errors in the
+// standard Google APIs format rather than with a status.
+type googleAPIResponse struct {
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"error"`
+	quota      quota
+	httpStatus int
+}
+
+// StatusError returns a *StatusError if this object has an error.
+func (r *googleAPIResponse) StatusError() error {
+	if r.Error == nil {
+		return nil
+	}
+	return &StatusError{status: r.Error.Status, message: r.Error.Message, httpStatus: r.httpStatus, retryAfter: r.quota.retryAfter, rateLimit: r.quota.rateLimit}
+}
+
+// StatusError is returned when a Google Maps API responds with a status other
+// than OK or ZERO_RESULTS, or an image API responds with an HTTP error.
+type StatusError struct {
+	status     string
+	message    string
+	httpStatus int
+	// api is the name of the image API which responded with an HTTP error.
+	api        string
+	retryAfter time.Duration
+	rateLimit  *RateLimit
+}
+
+// Error implements the error interface, including both the status and the
+// explanatory error message returned by the API.
+func (e *StatusError) Error() string {
+	if e.api != "" {
+		return fmt.Sprintf("%s: %d - %s", e.api, e.httpStatus, e.message)
+	}
+	if e.message == "" {
+		return fmt.Sprintf("maps: %s", e.status)
+	}
+	return fmt.Sprintf("maps: %s - %s", e.status, e.message)
+}
+
+// Status returns the status returned by the API, for example
+// OVER_QUERY_LIMIT or INVALID_REQUEST.
+func (e *StatusError) Status() string {
+	return e.status
+}
+
+// Message returns the explanatory error message returned by the API, if any.
+func (e *StatusError) Message() string {
+	return e.message
+}
+
+// Temporary reports whether the request may succeed if retried later without
+// modification.
+func (e *StatusError) Temporary() bool {
+	return e.overQueryLimit() || e.status == "UNKNOWN_ERROR"
+}
+
+// overQueryLimit reports whether the request was throttled. The legacy APIs
+// report OVER_QUERY_LIMIT, and the newer APIs RESOURCE_EXHAUSTED.
+func (e *StatusError) overQueryLimit() bool {
+	return e.status == "OVER_QUERY_LIMIT" || e.status == "RESOURCE_EXHAUSTED"
+}
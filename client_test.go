@@ -16,6 +16,7 @@ package maps
 
 import (
 	"context"
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"testing"
@@ -63,6 +64,24 @@ func TestClientClearExperienceId(t *testing.T) {
 	assert.Nil(t, c.experienceId)
 }
 
+func TestClientWithExperienceIDs(t *testing.T) {
+	c, err := NewClient(WithAPIKey("AIza-Maps-API-Key"), WithExperienceID("foo", "bar"))
+	assert.Nil(t, err)
+
+	ctx := ExperienceIdContext(context.Background(), "baz")
+	req, _ := http.NewRequest("GET", "/", nil)
+	c.setExperienceIdHeader(WithExperienceIDs(ctx, "qux"), req)
+	assert.Equal(t, "qux", req.Header.Get(ExperienceIdHeaderName))
+
+	req, _ = http.NewRequest("GET", "/", nil)
+	c.setExperienceIdHeader(WithExperienceIDs(ctx), req)
+	assert.Equal(t, "", req.Header.Get(ExperienceIdHeaderName))
+
+	req, _ = http.NewRequest("GET", "/", nil)
+	c.setExperienceIdHeader(ctx, req)
+	assert.Equal(t, "foo,bar,baz", req.Header.Get(ExperienceIdHeaderName))
+}
+
 func TestClientSetExperienceIdHeader(t *testing.T) {
 	ids := []string{"foo", "bar"}
 	c, _ := NewClient(WithAPIKey("AIza-Maps-API-Key"))
@@ -135,3 +154,145 @@ func TestClientExperienceIdSample(t *testing.T) {
 
 	assert.Equal(t, ids, []string{experienceId, otherExperienceId})
 }
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		response  commonResponse
+		wantErr   string
+		temporary bool
+	}{
+		{commonResponse{Status: "OK"}, "", false},
+		{commonResponse{Status: "ZERO_RESULTS"}, "", false},
+		{commonResponse{Status: "INVALID_REQUEST", ErrorMessage: "Invalid request."}, "maps: INVALID_REQUEST - Invalid request.", false},
+		{commonResponse{Status: "REQUEST_DENIED"}, "maps: REQUEST_DENIED", false},
+		{commonResponse{Status: "OVER_QUERY_LIMIT", ErrorMessage: "Slow down."}, "maps: OVER_QUERY_LIMIT - Slow down.", true},
+		{commonResponse{Status: "UNKNOWN_ERROR"}, "maps: UNKNOWN_ERROR", true},
+		{commonResponse{Status: "RESOURCE_EXHAUSTED"}, "maps: RESOURCE_EXHAUSTED", true},
+	}
+	for _, test := range tests {
+		err := test.response.StatusError()
+		if test.wantErr == "" {
+			assert.Nil(t, err)
+			continue
+		}
+		se, ok := err.(*StatusError)
+		if !ok {
+			t.Fatalf("expected *StatusError, was %T", err)
+		}
+		assert.Equal(t, test.wantErr, se.Error())
+		assert.Equal(t, test.response.Status, se.Status())
+		assert.Equal(t, test.response.ErrorMessage, se.Message())
+		assert.Equal(t, test.temporary, se.Temporary())
+	}
+}
+
+func TestClientWithBaseURLFor(t *testing.T) {
+	geocoding := mockServer(200, `{"results" : [], "status" : "OK"}`)
+	defer geocoding.Close()
+	others := mockServer(200, `{"results" : [], "status" : "OK"}`)
+	defer others.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(others.URL), WithBaseURLFor(EndpointGeocoding, geocoding.URL))
+
+	var hosts []string
+	ctx := WithRoundTripper(context.Background(), roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, "http://"+req.URL.Host)
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+	_, err := c.Geocode(ctx, &GeocodingRequest{Address: "Sydney"})
+	assert.NoError(t, err)
+	_, err = c.Elevation(ctx, &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{geocoding.URL, others.URL}, hosts)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientWithRoundTripperContext(t *testing.T) {
+	server := mockServer(200, `{"results" : [], "status" : "OK"}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	var calls int
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		assert.Contains(t, req.Header.Get("User-Agent"), userAgent)
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(strings.NewReader(`{"status" : `)),
+			Request:    req,
+		}, nil
+	})
+
+	ctx := WithRoundTripper(context.Background(), rt)
+	if _, err := c.Elevation(ctx, r); err == nil {
+		t.Errorf("Malformed body should return error")
+	}
+	assert.Equal(t, 1, calls)
+
+	// Calls without the context use the client's transport.
+	if _, err := c.Elevation(context.Background(), r); err != nil {
+		t.Errorf("r.Get returned non nil error: %v", err)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientWithDefaultLanguageAndRegion(t *testing.T) {
+	tests := []struct {
+		query string
+		call  func(c *Client) error
+	}{
+		{
+			"address=Paris&key=AIzaNotReallyAnAPIKey&language=fr&region=fr",
+			func(c *Client) error {
+				_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Paris"})
+				return err
+			},
+		},
+		{
+			"destination=Lyon&key=AIzaNotReallyAnAPIKey&language=en&origin=Paris&region=fr",
+			func(c *Client) error {
+				_, _, err := c.Directions(context.Background(), &DirectionsRequest{Origin: "Paris", Destination: "Lyon", Language: "en"})
+				return err
+			},
+		},
+		{
+			"key=AIzaNotReallyAnAPIKey&language=fr&location=48.85%2C2.35&timestamp=-62135596800",
+			func(c *Client) error {
+				_, err := c.Timezone(context.Background(), &TimezoneRequest{Location: &LatLng{Lat: 48.85, Lng: 2.35}})
+				return err
+			},
+		},
+		{
+			"key=AIzaNotReallyAnAPIKey&keyword=cafe&language=fr&location=48.85%2C2.35&radius=500&region=fr",
+			func(c *Client) error {
+				_, err := c.NearbySearch(context.Background(), &NearbySearchRequest{Location: &LatLng{Lat: 48.85, Lng: 2.35}, Radius: 500, Keyword: "cafe"})
+				return err
+			},
+		},
+		{
+			"key=AIzaNotReallyAnAPIKey&locations=enc%3Ao_diHo~iM",
+			func(c *Client) error {
+				_, err := c.Elevation(context.Background(), &ElevationRequest{Locations: []LatLng{{Lat: 48.85, Lng: 2.35}}})
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		server := mockServerForQuery(test.query, 200, `{"status": "OK"}`)
+		c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithDefaultLanguage("fr"), WithDefaultRegion("fr"))
+		if err := test.call(c); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if server.successful != 1 {
+			t.Errorf("Got URL(s) %v, want %s", server.failed, test.query)
+		}
+		server.s.Close()
+	}
+}
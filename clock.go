@@ -0,0 +1,86 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Clock is the source of time used by a Client for rate limiting, retries and
+// other time-based behavior. Tests may provide their own Clock with WithClock
+// to make such behavior instantaneous and deterministic.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is the Clock backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// WithClock configures a Maps API client with the Clock to use for all time-based
+// behavior. Default is to use the system clock.
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) error {
+		c.clock = clock
+		return nil
+	}
+}
+
+// sleep waits for d to elapse on the client's clock, returning early with the
+// context's error if ctx is done first.
+func (c *Client) sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.clock.After(d):
+		return nil
+	}
+}
+
+// backoff returns the delay before retry number attempt, counting from zero,
+// using exponential backoff from base capped at maxDelay with full jitter. The
+// jitter is drawn from r, which callers can seed for deterministic delays.
+func backoff(base, maxDelay time.Duration, attempt int, r *rand.Rand) time.Duration {
+	d := base
+	for i := 0; i < attempt && d < maxDelay; i++ {
+		d *= 2
+	}
+	if d > maxDelay {
+		d = maxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(r.Int63n(int64(d) + 1))
+}
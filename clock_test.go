@@ -0,0 +1,150 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose After advances time immediately, recording each
+// requested wait.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1500000000, 0)}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.waits = append(f.waits, d)
+	f.now = f.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- f.now
+	return ch
+}
+
+func (f *fakeClock) Waits() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.waits...)
+}
+
+func TestClientRateLimitUsesClock(t *testing.T) {
+	server := mockServer(200, `{"results" : [], "status" : "OK"}`)
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithRateLimit(1), WithClock(clock))
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Elevation(context.Background(), r); err != nil {
+			t.Fatalf("r.Get returned non nil error: %v", err)
+		}
+	}
+
+	waits := clock.Waits()
+	if len(waits) != 2 || waits[0] != time.Second || waits[1] != time.Second {
+		t.Errorf("expected two waits of one second, was %v", waits)
+	}
+}
+
+func TestClientRateLimitPerAPI(t *testing.T) {
+	server := mockServer(200, `{"results" : [], "status" : "OK"}`)
+	defer server.Close()
+	clock := newFakeClock()
+	c, err := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithRateLimit(1), WithClock(clock),
+		WithRateLimitPerAPI(map[Endpoint]int{EndpointElevation: 2, EndpointGeocoding: 0}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Elevation(ctx, &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}); err != nil {
+			t.Fatalf("Elevation returned non nil error: %v", err)
+		}
+	}
+	waits := clock.Waits()
+	if len(waits) != 1 || waits[0] != 500*time.Millisecond {
+		t.Errorf("expected one wait of half a second, was %v", waits)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Geocode(ctx, &GeocodingRequest{Address: "Sydney"}); err != nil {
+			t.Fatalf("Geocode returned non nil error: %v", err)
+		}
+	}
+	if len(clock.Waits()) != 1 {
+		t.Errorf("expected Geocoding not to be rate limited, waits were %v", clock.Waits())
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Timezone(ctx, &TimezoneRequest{Location: &LatLng{Lat: 1, Lng: 2}}); err != nil {
+			t.Fatalf("Timezone returned non nil error: %v", err)
+		}
+	}
+	if waits := clock.Waits(); len(waits) != 2 || waits[1] != time.Second {
+		t.Errorf("expected Timezone to use the client rate limit, waits were %v", waits)
+	}
+
+	if _, err := NewClient(WithAPIKey(apiKey), WithRateLimitPerAPI(map[Endpoint]int{EndpointElevation: -1})); err == nil {
+		t.Errorf("expected negative rate limit to fail")
+	}
+}
+
+func TestClientSleepCancelledContext(t *testing.T) {
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithClock(clock))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := c.sleep(ctx, time.Minute); err != context.Canceled {
+		t.Errorf("expected %v, was %v", context.Canceled, err)
+	}
+	if len(clock.Waits()) != 0 {
+		t.Errorf("Cancelled context should not wait")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 100*time.Millisecond, time.Second
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(base, max, attempt, rand.New(rand.NewSource(1)))
+		if d < 0 || d > max {
+			t.Errorf("attempt %d: backoff %v out of range", attempt, d)
+		}
+		if again := backoff(base, max, attempt, rand.New(rand.NewSource(1))); again != d {
+			t.Errorf("attempt %d: expected deterministic backoff %v, was %v", attempt, d, again)
+		}
+	}
+	if d := backoff(0, max, 3, rand.New(rand.NewSource(1))); d != 0 {
+		t.Errorf("expected zero backoff from zero base, was %v", d)
+	}
+}
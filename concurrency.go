@@ -0,0 +1,133 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Throttled requests of batch helpers are attempted at most
+// maxThrottledAttempts times, waiting with exponential backoff from
+// throttledBaseDelay up to throttledMaxDelay between attempts.
+const (
+	maxThrottledAttempts = 5
+	throttledBaseDelay   = time.Second
+	throttledMaxDelay    = 30 * time.Second
+)
+
+// adaptiveConcurrency limits the number of requests a batch helper makes at a
+// time. The limit starts at its maximum, halves whenever a request is
+// throttled, and grows by one after each limit successful requests, so that a
+// large job settles just below the rate at which it is throttled.
+type adaptiveConcurrency struct {
+	mu        sync.Mutex
+	cond      *sync.Cond
+	max       int
+	limit     int
+	inFlight  int
+	successes int
+	// started counts acquired requests, and decreasedAt is the value of started
+	// when the limit was last decreased. Throttled requests which started
+	// before that do not decrease the limit again.
+	started     int
+	decreasedAt int
+}
+
+func newAdaptiveConcurrency(maxLimit int) *adaptiveConcurrency {
+	a := &adaptiveConcurrency{max: maxLimit, limit: maxLimit}
+	a.cond = sync.NewCond(&a.mu)
+	return a
+}
+
+// acquire blocks until a request may start, and returns a ticket to pass to
+// release when it is done.
+func (a *adaptiveConcurrency) acquire() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for a.inFlight >= a.limit {
+		a.cond.Wait()
+	}
+	a.inFlight++
+	a.started++
+	return a.started
+}
+
+// release records the end of the request with ticket, and whether it was
+// throttled.
+func (a *adaptiveConcurrency) release(ticket int, throttled bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inFlight--
+	switch {
+	case throttled && ticket > a.decreasedAt:
+		a.limit /= 2
+		if a.limit < 1 {
+			a.limit = 1
+		}
+		a.successes = 0
+		a.decreasedAt = a.started
+	case !throttled && a.limit < a.max:
+		a.successes++
+		if a.successes >= a.limit {
+			a.limit++
+			a.successes = 0
+		}
+	}
+	a.cond.Broadcast()
+}
+
+// currentLimit returns the current concurrency limit.
+func (a *adaptiveConcurrency) currentLimit() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.limit
+}
+
+// do makes a request with send once the limit allows it. A throttled request
+// is requeued after a backoff, or the delay the API asked for, until it has
+// been attempted maxThrottledAttempts times. do returns the error of the last
+// attempt.
+func (a *adaptiveConcurrency) do(ctx context.Context, c *Client, send func() error) error {
+	var r *rand.Rand
+	for attempt := 0; ; attempt++ {
+		ticket := a.acquire()
+		err := send()
+		throttled := isOverQueryLimit(err)
+		a.release(ticket, throttled)
+		if !throttled || attempt+1 >= maxThrottledAttempts {
+			return err
+		}
+		if r == nil {
+			r = rand.New(rand.NewSource(c.clock.Now().UnixNano()))
+		}
+		delay := backoff(throttledBaseDelay, throttledMaxDelay, attempt, r)
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter() > delay {
+			delay = statusErr.RetryAfter()
+		}
+		if sleepErr := c.sleep(ctx, delay); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// isOverQueryLimit reports whether err is a throttled request.
+func isOverQueryLimit(err error) bool {
+	return IsQuotaError(err)
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	a := newAdaptiveConcurrency(8)
+
+	// Requests started before a decrease do not decrease the limit again.
+	var tickets []int
+	for i := 0; i < 8; i++ {
+		tickets = append(tickets, a.acquire())
+	}
+	a.release(tickets[0], true)
+	assert.Equal(t, 4, a.currentLimit())
+	a.release(tickets[1], true)
+	assert.Equal(t, 4, a.currentLimit())
+	for _, ticket := range tickets[2:] {
+		a.release(ticket, false)
+	}
+
+	// A request started after the decrease halves the limit again.
+	a.release(a.acquire(), true)
+	assert.Equal(t, 2, a.currentLimit())
+	a.release(a.acquire(), true)
+	assert.Equal(t, 1, a.currentLimit())
+	a.release(a.acquire(), true)
+	assert.Equal(t, 1, a.currentLimit())
+
+	// The limit grows by one after limit successes, up to the maximum.
+	for want := 2; want <= 8; want++ {
+		for i := 0; i < want-1; i++ {
+			a.release(a.acquire(), false)
+		}
+		assert.Equal(t, want, a.currentLimit())
+	}
+	for i := 0; i < 20; i++ {
+		a.release(a.acquire(), false)
+	}
+	assert.Equal(t, 8, a.currentLimit())
+}
+
+func TestIsOverQueryLimit(t *testing.T) {
+	assert.True(t, isOverQueryLimit(&StatusError{status: "OVER_QUERY_LIMIT"}))
+	assert.True(t, isOverQueryLimit(&StatusError{status: "RESOURCE_EXHAUSTED"}))
+	assert.False(t, isOverQueryLimit(&StatusError{status: "INVALID_REQUEST"}))
+	assert.True(t, isOverQueryLimit(fmt.Errorf("geocoding: %w", &StatusError{status: "OVER_QUERY_LIMIT"})))
+	assert.False(t, isOverQueryLimit(errors.New("maps: OVER_QUERY_LIMIT")))
+	assert.False(t, isOverQueryLimit(nil))
+}
+
+func TestValidateAddressBatchAdaptsConcurrency(t *testing.T) {
+	// The server throttles requests while more than 2 are in flight.
+	var mu sync.Mutex
+	inFlight, throttled := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		over := inFlight > 2
+		if over {
+			throttled++
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(5 * time.Millisecond)
+
+		if over {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"error": {"code": 429, "message": "Quota exceeded.", "status": "RESOURCE_EXHAUSTED"}}`)
+			return
+		}
+		fmt.Fprintln(w, `{"result": {"verdict": {"possibleNextAction": "ACCEPT"}}}`)
+	}))
+	defer server.Close()
+
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithRateLimit(0), WithClock(clock))
+	var rs []*AddressValidationRequest
+	for i := 0; i < 60; i++ {
+		rs = append(rs, &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1 Main St"}}})
+	}
+
+	batch, err := c.ValidateAddressBatch(context.Background(), rs, 8)
+	if err != nil {
+		t.Fatalf("ValidateAddressBatch returned error: %v", err)
+	}
+	// Throttled requests are retried after a backoff.
+	if len(batch.Accepted) != len(rs) {
+		t.Errorf("expected all %d results to be accepted, was %d", len(rs), len(batch.Accepted))
+	}
+	if waits := len(clock.Waits()); waits != throttled {
+		t.Errorf("expected a backoff for each of %d throttled requests, was %d", throttled, waits)
+	}
+	// Fixed concurrency of 8 would have 6 of every 8 requests throttled.
+	if throttled > len(rs)/2 {
+		t.Errorf("expected concurrency to adapt, %d of %d requests were throttled", throttled, len(rs))
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"reflect"
+)
+
+// FieldChange is a change to a field of a result between two requests.
+type FieldChange struct {
+	// Field is the JSON name of the field in the API response, for example
+	// "formatted_address".
+	Field string `json:"field"`
+	// Old is the previous value of the field.
+	Old interface{} `json:"old"`
+	// New is the current value of the field.
+	New interface{} `json:"new"`
+}
+
+// DiffPlaces returns the changes between two Place Details results of a place,
+// such as changes of its name, address, or business status. The deprecated
+// permanently_closed flag is compared as part of business_status. Ratings and
+// reviews are not compared.
+func DiffPlaces(old, cur PlaceDetailsResult) []FieldChange {
+	var d fieldDiff
+	d.add("place_id", old.PlaceID, cur.PlaceID)
+	d.add("name", old.Name, cur.Name)
+	d.add("formatted_address", old.FormattedAddress, cur.FormattedAddress)
+	d.add("business_status", old.Closure(), cur.Closure())
+	d.add("location", old.Geometry.Location, cur.Geometry.Location)
+	d.add("types", old.Types, cur.Types)
+	d.add("formatted_phone_number", old.FormattedPhoneNumber, cur.FormattedPhoneNumber)
+	d.add("international_phone_number", old.InternationalPhoneNumber, cur.InternationalPhoneNumber)
+	d.add("website", old.Website, cur.Website)
+	d.add("price_level", old.PriceLevel, cur.PriceLevel)
+	d.add("opening_hours", weekdayText(old.OpeningHours), weekdayText(cur.OpeningHours))
+	return d.changes
+}
+
+// DiffGeocodes returns the changes between two geocoding results of an
+// address.
+func DiffGeocodes(old, cur GeocodingResult) []FieldChange {
+	var d fieldDiff
+	d.add("place_id", old.PlaceID, cur.PlaceID)
+	d.add("formatted_address", old.FormattedAddress, cur.FormattedAddress)
+	d.add("location", old.Geometry.Location, cur.Geometry.Location)
+	d.add("location_type", old.Geometry.LocationType, cur.Geometry.LocationType)
+	d.add("types", old.Types, cur.Types)
+	d.add("partial_match", old.PartialMatch, cur.PartialMatch)
+	return d.changes
+}
+
+// fieldDiff accumulates the changes between fields.
+type fieldDiff struct {
+	changes []FieldChange
+}
+
+func (d *fieldDiff) add(field string, old, cur interface{}) {
+	if !reflect.DeepEqual(old, cur) {
+		d.changes = append(d.changes, FieldChange{Field: field, Old: old, New: cur})
+	}
+}
+
+// weekdayText returns the formatted opening hours of hours, which are compared
+// instead of the periods so that the change is readable.
+func weekdayText(hours *OpeningHours) []string {
+	if hours == nil {
+		return nil
+	}
+	return hours.WeekdayText
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffPlaces(t *testing.T) {
+	old := PlaceDetailsResult{
+		PlaceID:          "ChIJN1t_tDeuEmsRUsoyG83frY4",
+		Name:             "Google Sydney",
+		FormattedAddress: "48 Pirrama Rd, Pyrmont NSW 2009, Australia",
+		BusinessStatus:   BusinessStatusOperational,
+		OpeningHours:     &OpeningHours{WeekdayText: []string{"Monday: 9:00 AM – 5:00 PM"}},
+		Rating:           4.5,
+	}
+	new := old
+	new.Name = "Google Sydney - Pirrama"
+	new.BusinessStatus = ""
+	new.PermanentlyClosed = true
+	new.OpeningHours = nil
+	new.Rating = 4.6
+
+	expected := []FieldChange{
+		{Field: "name", Old: "Google Sydney", New: "Google Sydney - Pirrama"},
+		{Field: "business_status", Old: BusinessStatusOperational, New: BusinessStatusClosedPermanently},
+		{Field: "opening_hours", Old: []string{"Monday: 9:00 AM – 5:00 PM"}, New: []string(nil)},
+	}
+	if changes := DiffPlaces(old, new); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %+v, was %+v", expected, changes)
+	}
+	if changes := DiffPlaces(old, old); changes != nil {
+		t.Errorf("expected no changes, was %+v", changes)
+	}
+}
+
+func TestDiffGeocodes(t *testing.T) {
+	old := GeocodingResult{
+		PlaceID:          "ChIJ2eUgeAK6j4ARbn5u_wAGqWA",
+		FormattedAddress: "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+		Geometry:         AddressGeometry{Location: LatLng{Lat: 37.42, Lng: -122.08}, LocationType: "ROOFTOP"},
+		Types:            []string{"street_address"},
+	}
+	new := old
+	new.Geometry.Location = LatLng{Lat: 37.4221, Lng: -122.08}
+	new.Types = []string{"premise"}
+
+	expected := []FieldChange{
+		{Field: "location", Old: LatLng{Lat: 37.42, Lng: -122.08}, New: LatLng{Lat: 37.4221, Lng: -122.08}},
+		{Field: "types", Old: []string{"street_address"}, New: []string{"premise"}},
+	}
+	if changes := DiffGeocodes(old, new); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %+v, was %+v", expected, changes)
+	}
+}
@@ -17,9 +17,11 @@ package maps
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -29,10 +31,15 @@ var directionsAPI = &apiConfig{
 	path:             "/maps/api/directions/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	acceptsLanguage:  true,
+	acceptsRegion:    true,
 }
 
 // Directions issues the Directions request and retrieves the Response
 func (c *Client) Directions(ctx context.Context, r *DirectionsRequest) ([]Route, []GeocodedWaypoint, error) {
+	if err := c.validateRequest(r); err != nil {
+		return nil, nil, err
+	}
 	if r.Origin == "" {
 		return nil, nil, errors.New("maps: origin missing")
 	}
@@ -42,8 +49,13 @@ func (c *Client) Directions(ctx context.Context, r *DirectionsRequest) ([]Route,
 	if r.Mode != "" && TravelModeDriving != r.Mode && TravelModeWalking != r.Mode && TravelModeBicycling != r.Mode && TravelModeTransit != r.Mode {
 		return nil, nil, fmt.Errorf("maps: unknown Mode: '%s'", r.Mode)
 	}
-	if r.DepartureTime != "" && r.ArrivalTime != "" {
-		return nil, nil, errors.New("maps: DepartureTime and ArrivalTime both specified")
+	if err := checkTravelTimes(r.DepartureTime, r.DepartNow, r.DepartAt, r.ArrivalTime, r.ArriveAt); err != nil {
+		return nil, nil, err
+	}
+	if r.Mode != TravelModeTransit {
+		if err := validateDepartureTime(c.clock.Now(), r.departureTime()); err != nil {
+			return nil, nil, err
+		}
 	}
 	if len(r.TransitMode) != 0 && r.Mode != TravelModeTransit {
 		return nil, nil, errors.New("maps: TransitMode specified while Mode != TravelModeTransit")
@@ -51,7 +63,107 @@ func (c *Client) Directions(ctx context.Context, r *DirectionsRequest) ([]Route,
 	if r.TransitRoutingPreference != "" && r.Mode != TravelModeTransit {
 		return nil, nil, errors.New("maps: mode of transit '" + string(r.Mode) + "' invalid for TransitRoutingPreference")
 	}
+	if len(r.Waypoints) != 0 && len(r.TypedWaypoints) != 0 {
+		return nil, nil, errors.New("maps: Waypoints and TypedWaypoints both specified")
+	}
+	for i, w := range r.TypedWaypoints {
+		if err := w.validate(); err != nil {
+			return nil, nil, fmt.Errorf("maps: waypoint %d: %v", i, err)
+		}
+	}
+	if r.Optimize && len(r.Waypoints)+len(r.TypedWaypoints) < 2 {
+		return nil, nil, errors.New("maps: Optimize requires at least 2 waypoints")
+	}
+
+	var routes []Route
+	var waypoints []GeocodedWaypoint
+	var err error
+	if r.LazySteps {
+		routes, waypoints, err = c.lazyDirections(ctx, r)
+	} else {
+		routes, waypoints, err = c.directions(ctx, r)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r.ResolveWaypointLocations {
+		if err := c.resolveWaypointLocations(ctx, r, waypoints); err != nil {
+			return nil, nil, err
+		}
+	}
+	if r.ReportWaypointFailures {
+		if err := r.CheckWaypoints(waypoints); err != nil {
+			return routes, waypoints, err
+		}
+	}
+	return routes, waypoints, nil
+}
+
+// WaypointFailure is an origin, waypoint or destination of a Directions
+// request which could not be geocoded.
+type WaypointFailure struct {
+	// Index is the index of the point in the geocoded waypoints of the
+	// response, which are the origin, then the waypoints, then the destination.
+	Index int
+	// Role is "origin", "waypoint" or "destination".
+	Role string
+	// Input is the point as it was sent in the request.
+	Input string
+	// Status is the geocoder status of the point, such as ZERO_RESULTS.
+	Status string
+}
+
+// PartialFailureError reports the points of a Directions request which could
+// not be geocoded although the request succeeded.
+type PartialFailureError struct {
+	Failures []WaypointFailure
+}
+
+func (e *PartialFailureError) Error() string {
+	s := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		s[i] = fmt.Sprintf("%s %q: %s", f.Role, f.Input, f.Status)
+	}
+	return "maps: waypoints not geocoded: " + strings.Join(s, ", ")
+}
+
+// CheckWaypoints returns a *PartialFailureError listing the origin, waypoints
+// and destination of r whose geocoded waypoints in a response to r, waypoints,
+// have a status other than OK.
+func (r *DirectionsRequest) CheckWaypoints(waypoints []GeocodedWaypoint) error {
+	inputs := []string{r.Origin}
+	inputs = append(inputs, r.Waypoints...)
+	for i := range r.TypedWaypoints {
+		inputs = append(inputs, r.TypedWaypoints[i].String())
+	}
+	inputs = append(inputs, r.Destination)
+
+	var failures []WaypointFailure
+	for i, w := range waypoints {
+		if w.GeocoderStatus == "" || w.GeocoderStatus == "OK" {
+			continue
+		}
+		f := WaypointFailure{Index: i, Role: "waypoint", Status: w.GeocoderStatus}
+		switch {
+		case i == 0:
+			f.Role = "origin"
+		case i == len(waypoints)-1:
+			f.Role = "destination"
+		}
+		if len(waypoints) == len(inputs) {
+			f.Input = inputs[i]
+		}
+		failures = append(failures, f)
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return &PartialFailureError{Failures: failures}
+}
 
+// directions issues the Directions request, decoding the steps of each leg.
+func (c *Client) directions(ctx context.Context, r *DirectionsRequest) ([]Route, []GeocodedWaypoint, error) {
 	var response struct {
 		Routes            []Route            `json:"routes"`
 		GeocodedWaypoints []GeocodedWaypoint `json:"geocoded_waypoints"`
@@ -69,15 +181,208 @@ func (c *Client) Directions(ctx context.Context, r *DirectionsRequest) ([]Route,
 	return response.Routes, response.GeocodedWaypoints, nil
 }
 
+// lazyDirections issues the Directions request, leaving the steps of each leg
+// encoded until they are accessed with Leg.DecodeSteps.
+func (c *Client) lazyDirections(ctx context.Context, r *DirectionsRequest) ([]Route, []GeocodedWaypoint, error) {
+	var response struct {
+		Routes            []lazyRoute        `json:"routes"`
+		GeocodedWaypoints []GeocodedWaypoint `json:"geocoded_waypoints"`
+		commonResponse
+	}
+
+	if err := c.getJSON(ctx, directionsAPI, r, &response); err != nil {
+		return nil, nil, err
+	}
+
+	if err := response.StatusError(); err != nil {
+		return nil, nil, err
+	}
+
+	routes := make([]Route, len(response.Routes))
+	for i := range response.Routes {
+		routes[i] = response.Routes[i].route()
+	}
+	return routes, response.GeocodedWaypoints, nil
+}
+
+// resolveWaypointLocations sets the Location and FormattedAddress of each
+// successfully geocoded waypoint with a Geocoding API request for its place ID.
+func (c *Client) resolveWaypointLocations(ctx context.Context, r *DirectionsRequest, waypoints []GeocodedWaypoint) error {
+	for i := range waypoints {
+		w := &waypoints[i]
+		if w.GeocoderStatus != "OK" || w.PlaceID == "" {
+			continue
+		}
+		resp, err := c.ReverseGeocode(ctx, &GeocodingRequest{
+			PlaceID:  w.PlaceID,
+			Language: r.Language,
+			Region:   r.Region,
+		})
+		if err != nil {
+			return fmt.Errorf("maps: geocoding waypoint %d: %v", i, err)
+		}
+		if len(resp.Results) > 0 {
+			location := resp.Results[0].Geometry.Location
+			w.Location = &location
+			w.FormattedAddress = resp.Results[0].FormattedAddress
+		}
+	}
+	return nil
+}
+
 func getWaypointsQueryString(r *DirectionsRequest) string {
 	var b bytes.Buffer
 	if r.Optimize {
 		b.WriteString("optimize:true|")
 	}
 	b.WriteString(strings.Join(r.Waypoints, "|"))
+	for i, w := range r.TypedWaypoints {
+		if i > 0 {
+			b.WriteString("|")
+		}
+		b.WriteString(w.String())
+	}
 	return b.String()
 }
 
+// Waypoint is a point a route passes through, given by exactly one of Address,
+// Location, PlaceID and Path.
+type Waypoint struct {
+	// Address is the address of the waypoint.
+	Address string
+	// Location is the coordinates of the waypoint.
+	Location *LatLng
+	// PlaceID is the place ID of the waypoint.
+	PlaceID string
+	// Path is a series of waypoints, sent as an encoded polyline.
+	Path []LatLng
+	// Via makes the route pass through the waypoint without stopping, so that
+	// the route is not split into separate legs at it.
+	Via bool
+	// SideOfRoad makes the route pass the waypoint on the side of the road it
+	// is on. It may only be used with Location.
+	SideOfRoad bool
+}
+
+// validate returns an error if w is not a valid waypoint.
+func (w *Waypoint) validate() error {
+	n := 0
+	for _, set := range []bool{w.Address != "", w.Location != nil, w.PlaceID != "", len(w.Path) != 0} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of Address, Location, PlaceID and Path required")
+	}
+	if w.SideOfRoad && w.Location == nil {
+		return errors.New("SideOfRoad requires Location")
+	}
+	return nil
+}
+
+// String returns w in the format of the waypoints parameter, such as
+// "via:place_id:ChIJ3S-JXmauEmsRUcIaWtf4MzE".
+func (w *Waypoint) String() string {
+	var b strings.Builder
+	if w.Via {
+		b.WriteString("via:")
+	}
+	if w.SideOfRoad {
+		b.WriteString("side_of_road:")
+	}
+	switch {
+	case w.Location != nil:
+		b.WriteString(w.Location.String())
+	case w.PlaceID != "":
+		b.WriteString("place_id:" + w.PlaceID)
+	case len(w.Path) != 0:
+		b.WriteString("enc:" + Encode(w.Path) + ":")
+	default:
+		b.WriteString(w.Address)
+	}
+	return b.String()
+}
+
+// departureTime returns the departure_time parameter of r.
+func (r *DirectionsRequest) departureTime() string {
+	return departureTime(r.DepartureTime, r.DepartNow, r.DepartAt)
+}
+
+// arrivalTime returns the arrival_time parameter of r.
+func (r *DirectionsRequest) arrivalTime() string {
+	return arrivalTime(r.ArrivalTime, r.ArriveAt)
+}
+
+// departureTime returns the departure_time parameter of a request.
+func departureTime(departure string, departNow bool, departAt time.Time) string {
+	if departNow {
+		return "now"
+	}
+	if !departAt.IsZero() {
+		return strconv.FormatInt(departAt.Unix(), 10)
+	}
+	return departure
+}
+
+// arrivalTime returns the arrival_time parameter of a request.
+func arrivalTime(arrival string, arriveAt time.Time) string {
+	if !arriveAt.IsZero() {
+		return strconv.FormatInt(arriveAt.Unix(), 10)
+	}
+	return arrival
+}
+
+// checkTravelTimes returns an error if a request specifies more than one
+// departure time, more than one arrival time or both, or a departure or arrival
+// time string the API does not accept, such as an RFC 3339 timestamp.
+func checkTravelTimes(departure string, departNow bool, departAt time.Time, arrival string, arriveAt time.Time) error {
+	if violations := travelTimeViolations(departure, departNow, departAt, arrival, arriveAt); len(violations) > 0 {
+		return errors.New("maps: " + violations[0].Reason)
+	}
+	return nil
+}
+
+// travelTimeViolations returns every problem checkTravelTimes reports.
+func travelTimeViolations(departure string, departNow bool, departAt time.Time, arrival string, arriveAt time.Time) []Violation {
+	var v validator
+	departures := 0
+	for _, set := range []bool{departure != "", departNow, !departAt.IsZero()} {
+		if set {
+			departures++
+		}
+	}
+	v.check(departures <= 1, "DepartureTime", "only one of DepartureTime, DepartNow and DepartAt may be specified")
+	v.check(arrival == "" || arriveAt.IsZero(), "ArriveAt", "only one of ArrivalTime and ArriveAt may be specified")
+	v.check(departures == 0 || arrival == "" && arriveAt.IsZero(), "ArrivalTime", "DepartureTime and ArrivalTime both specified")
+	if _, err := strconv.ParseInt(departure, 10, 64); departure != "" && departure != "now" && err != nil {
+		v.check(false, "DepartureTime", "DepartureTime %q is not \"now\" or seconds since the epoch, use DepartAt to specify a time.Time", departure)
+	}
+	if _, err := strconv.ParseInt(arrival, 10, 64); arrival != "" && err != nil {
+		v.check(false, "ArrivalTime", "ArrivalTime %q is not seconds since the epoch, use ArriveAt to specify a time.Time", arrival)
+	}
+	return v.violations
+}
+
+// departureGrace is how far in the past a departure time may be, so that
+// departures computed from the current time are not rejected once a second
+// boundary has passed.
+const departureGrace = 30 * time.Second
+
+// validateDepartureTime returns an error if departure is a time in seconds
+// since the epoch more than departureGrace before now. The API rejects past
+// departures for all modes but transit, without saying why.
+func validateDepartureTime(now time.Time, departure string) error {
+	seconds, err := strconv.ParseInt(departure, 10, 64)
+	if err != nil {
+		return nil
+	}
+	if seconds < now.Add(-departureGrace).Unix() {
+		return fmt.Errorf("maps: departure time %s is in the past", time.Unix(seconds, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 func (r *DirectionsRequest) params() url.Values {
 	q := make(url.Values)
 	q.Set("origin", r.Origin)
@@ -85,13 +390,13 @@ func (r *DirectionsRequest) params() url.Values {
 	if r.Mode != "" {
 		q.Set("mode", string(r.Mode))
 	}
-	if r.DepartureTime != "" {
-		q.Set("departure_time", r.DepartureTime)
+	if departure := r.departureTime(); departure != "" {
+		q.Set("departure_time", departure)
 	}
-	if r.ArrivalTime != "" {
-		q.Set("arrival_time", r.ArrivalTime)
+	if arrival := r.arrivalTime(); arrival != "" {
+		q.Set("arrival_time", arrival)
 	}
-	if len(r.Waypoints) != 0 {
+	if len(r.Waypoints) != 0 || len(r.TypedWaypoints) != 0 {
 		q.Set("waypoints", getWaypointsQueryString(r))
 	}
 	if r.Alternatives {
@@ -148,13 +453,28 @@ type DirectionsRequest struct {
 	// seconds since midnight, January 1, 1970 UTC. Optional. You cannot specify both
 	// `DepartureTime` and `ArrivalTime`.
 	ArrivalTime string
+	// DepartNow requests directions departing now, like a DepartureTime of
+	// "now". Optional.
+	DepartNow bool
+	// DepartAt specifies the desired time of departure, as an alternative to
+	// DepartureTime. Except for transit directions, it must not be before the
+	// current time of the client's Clock. Optional.
+	DepartAt time.Time
+	// ArriveAt specifies the desired time of arrival for transit directions,
+	// as an alternative to ArrivalTime. Optional.
+	ArriveAt time.Time
 	// Waypoints specifies an array of points to add to a route. Optional.
 	Waypoints []string
+	// TypedWaypoints specifies the points to add to a route, as an alternative
+	// to Waypoints which formats each waypoint for the API. Optional.
+	TypedWaypoints []Waypoint
 	// Alternatives specifies if Directions service may provide more than one route
 	// alternative in the response. Optional.
 	Alternatives bool
 	// Optimize allow the Directions service to optimize the provided route by
-	// rearranging the waypoints in a more efficient order. Optional.
+	// rearranging the waypoints in a more efficient order, which is given by
+	// the WaypointOrder of each route. It requires at least 2 waypoints.
+	// Optional.
 	Optimize bool
 	// Avoid indicates that the calculated route(s) should avoid the indicated
 	// features. Optional.
@@ -174,6 +494,22 @@ type DirectionsRequest struct {
 	// TrafficModel specifies traffic prediction model when requesting future
 	// directions. Optional.
 	TrafficModel TrafficModel
+	// LazySteps defers decoding the steps of each leg until Leg.DecodeSteps is
+	// called, which reduces the cost of requesting many alternatives when only
+	// their summaries and durations are needed. This is not sent to the API.
+	// Optional.
+	LazySteps bool
+	// ResolveWaypointLocations sets the Location and FormattedAddress of each
+	// returned GeocodedWaypoint, with a supplemental Geocoding API request for
+	// each waypoint's place ID. This helps detect routes built from mismatched
+	// geocodes. This is not sent to the API. Optional.
+	ResolveWaypointLocations bool
+	// ReportWaypointFailures makes Directions return a *PartialFailureError,
+	// along with the routes, if any of the origin, waypoints and destination
+	// could not be geocoded. The API otherwise reports these only in the
+	// GeocoderStatus of each GeocodedWaypoint. This is not sent to the API.
+	// Optional.
+	ReportWaypointFailures bool
 }
 
 // GeocodedWaypoint represents the geocoded point for origin, supplied waypoints, or
@@ -190,6 +526,12 @@ type GeocodedWaypoint struct {
 	// Types indicates the address type of the geocoding result used for calculating
 	// directions.
 	Types []string `json:"types"`
+	// Location is the location of the geocoding result, when requested with
+	// DirectionsRequest.ResolveWaypointLocations.
+	Location *LatLng `json:"-"`
+	// FormattedAddress is the address of the geocoding result, when requested with
+	// DirectionsRequest.ResolveWaypointLocations.
+	FormattedAddress string `json:"-"`
 }
 
 // Route represents a single route between an origin and a destination.
@@ -229,6 +571,50 @@ type Route struct {
 	*Fare `json:"fare"`
 }
 
+// OptimizedWaypoints returns the waypoints of the request for r, original, in
+// the order they are visited by r, as given by its WaypointOrder. It returns nil
+// if WaypointOrder is not an ordering of original.
+func (r *Route) OptimizedWaypoints(original []Waypoint) []Waypoint {
+	if len(r.WaypointOrder) != len(original) {
+		return nil
+	}
+	seen := make([]bool, len(original))
+	waypoints := make([]Waypoint, len(original))
+	for i, j := range r.WaypointOrder {
+		if j < 0 || j >= len(original) || seen[j] {
+			return nil
+		}
+		seen[j] = true
+		waypoints[i] = original[j]
+	}
+	return waypoints
+}
+
+// TotalDistance returns the sum of the distances of the legs of r. Its
+// HumanReadable text is empty.
+func (r *Route) TotalDistance() Distance {
+	var d Distance
+	for _, leg := range r.Legs {
+		d.Meters += leg.Meters
+	}
+	return d
+}
+
+// TotalDuration returns the sum of the durations of the legs of r.
+func (r *Route) TotalDuration() time.Duration {
+	var d time.Duration
+	for _, leg := range r.Legs {
+		d += leg.Duration
+	}
+	return d
+}
+
+// Path returns the points of the overview polyline of r, which approximates
+// the route.
+func (r *Route) Path() ([]LatLng, error) {
+	return r.OverviewPolyline.Decode()
+}
+
 // Fare represents the total fare for a route.
 type Fare struct {
 	// Currency is an ISO 4217 currency code indicating the currency that the amount
@@ -245,7 +631,8 @@ type Fare struct {
 // Leg represents a single leg of a route.
 type Leg struct {
 	// Steps contains an array of steps denoting information about each separate step
-	// of the leg of the journey.
+	// of the leg of the journey. It is nil for a leg requested with
+	// DirectionsRequest.LazySteps until DecodeSteps is called.
 	Steps []*Step `json:"steps"`
 
 	// Distance indicates the total distance covered by this leg.
@@ -285,15 +672,94 @@ type Leg struct {
 
 	// ViaWaypoint contains info about points through which the route was laid.
 	ViaWaypoint []*ViaWaypoint `json:"via_waypoint"`
+
+	// TrafficSpeedEntry contains the traffic speeds along this leg, when the API
+	// returns them.
+	TrafficSpeedEntry []*TrafficSpeedEntry `json:"traffic_speed_entry"`
+
+	// encodedSteps holds the undecoded steps of a leg requested with
+	// DirectionsRequest.LazySteps.
+	encodedSteps json.RawMessage
+}
+
+// DecodeSteps returns the steps of this leg. If the leg was requested with
+// DirectionsRequest.LazySteps, the steps are decoded on the first call and
+// stored in Steps, modifying the leg. Methods derived from the steps, such as
+// Path and Pace, call it, so they must not be called concurrently on the same
+// lazy leg.
+func (leg *Leg) DecodeSteps() ([]*Step, error) {
+	if leg.encodedSteps != nil {
+		var steps []*Step
+		if err := unmarshalJSON(leg.encodedSteps, &steps); err != nil {
+			return nil, err
+		}
+		leg.Steps = steps
+		leg.encodedSteps = nil
+	}
+	return leg.Steps, nil
 }
 
-// ViaWaypoint handles waypoints.
+// Path returns the points of the polylines of the steps of leg, in order,
+// without repeating the point where one step ends and the next begins. It
+// decodes the steps of a leg requested with DirectionsRequest.LazySteps.
+func (leg *Leg) Path() ([]LatLng, error) {
+	steps, err := leg.DecodeSteps()
+	if err != nil {
+		return nil, err
+	}
+	var path []LatLng
+	for _, step := range steps {
+		points, err := step.Polyline.Decode()
+		if err != nil {
+			return nil, err
+		}
+		if len(path) > 0 && len(points) > 0 && path[len(path)-1] == points[0] {
+			points = points[1:]
+		}
+		path = append(path, points...)
+	}
+	return path, nil
+}
+
+// ViaWaypoint is a via waypoint the route of a leg passes through without
+// stopping.
 type ViaWaypoint struct {
-	Location          LatLng  `json:"location"`
-	StepIndex         int     `json:"step_index"`
+	// Location is the location of the waypoint on the route.
+	Location LatLng `json:"location"`
+	// StepIndex is the index in the leg's Steps of the step the waypoint is on.
+	StepIndex int `json:"step_index"`
+	// StepInterpolation is how far along that step the waypoint is, from 0 at
+	// the start of the step to 1 at its end.
 	StepInterpolation float64 `json:"step_interpolation"`
 }
 
+// TrafficSpeedEntry is the traffic speed along part of a leg. The format of
+// these entries is not documented by the API, so the entry is also kept as
+// returned.
+type TrafficSpeedEntry struct {
+	// OffsetMeters is the distance from the start of the leg at which the speed
+	// applies.
+	OffsetMeters int `json:"offset_meters"`
+	// SpeedCategory is the speed of traffic, such as NORMAL, SLOW or
+	// TRAFFIC_JAM.
+	SpeedCategory string `json:"speed_category"`
+	// Raw is the JSON of the entry as returned by the API.
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for TrafficSpeedEntry, keeping the
+// entry as returned in Raw.
+func (e *TrafficSpeedEntry) UnmarshalJSON(data []byte) error {
+	type entry TrafficSpeedEntry
+	var x entry
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	*e = TrafficSpeedEntry(x)
+	e.Raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
 // Step represents a single step of a leg.
 type Step struct {
 	// HTMLInstructions contains formatted instructions for this step, presented as an
@@ -331,6 +797,11 @@ type Step struct {
 
 	// TravelMode indicates the travel mode of this step.
 	TravelMode string `json:"travel_mode"`
+
+	// Maneuver contains the action to take for this step, such as
+	// ManeuverTurnLeft. It is only returned for some driving and walking
+	// steps.
+	Maneuver Maneuver `json:"maneuver"`
 }
 
 // TransitDetails contains additional information about the transit stop, transit
@@ -368,6 +839,12 @@ type TransitStop struct {
 	Location LatLng `json:"location"`
 	// Name of the transit station/stop. eg. "Union Square".
 	Name string `json:"name"`
+	// StopCode is the code of the transit station/stop displayed to riders, in
+	// regions where the API returns it.
+	StopCode string `json:"stop_code,omitempty"`
+	// StopID is the transit agency's ID of the transit station/stop, in regions
+	// where the API returns it.
+	StopID string `json:"stop_id,omitempty"`
 }
 
 // TransitLine contains information about the transit line used in this step
@@ -404,8 +881,28 @@ type TransitAgency struct {
 type TransitLineVehicle struct {
 	// Name contains the name of the vehicle on this line
 	Name string `json:"name"`
-	// Type contains the type of vehicle that runs on this line
+	// Type contains the type of vehicle that runs on this line, as returned by
+	// the API. See VehicleType for the type as one of the known VehicleTypes.
 	Type string `json:"type"`
 	// Icon contains the URL for an icon associated with this vehicle type
 	Icon *url.URL `json:"icon"`
+	// LocalIcon contains the URL for an icon of this vehicle type used by the
+	// local transport signage, in regions where the API returns it. It is nil
+	// otherwise.
+	LocalIcon *url.URL `json:"local_icon"`
+}
+
+// VehicleType returns the type of vehicle that runs on this line, or
+// VehicleTypeOther if Type is not one of the known VehicleTypes. The raw type
+// remains available in Type.
+func (v *TransitLineVehicle) VehicleType() VehicleType {
+	switch t := VehicleType(v.Type); t {
+	case VehicleTypeRail, VehicleTypeMetroRail, VehicleTypeSubway, VehicleTypeTram,
+		VehicleTypeMonorail, VehicleTypeHeavyRail, VehicleTypeCommuterTrain,
+		VehicleTypeHighSpeedTrain, VehicleTypeBus, VehicleTypeIntercityBus,
+		VehicleTypeTrolleybus, VehicleTypeShareTaxi, VehicleTypeFerry,
+		VehicleTypeCableCar, VehicleTypeGondolaLift, VehicleTypeFunicular:
+		return t
+	}
+	return VehicleTypeOther
 }
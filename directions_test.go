@@ -16,6 +16,7 @@ package maps
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -516,6 +517,101 @@ func TestTrafficModel(t *testing.T) {
 	}
 }
 
+func TestDirectionsDepartNow(t *testing.T) {
+	expectedQuery := "departure_time=now&destination=Parramatta&key=AIzaNotReallyAnAPIKey&origin=Sydney"
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	r := &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", DepartNow: true}
+	if _, _, err := c.Directions(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+
+	r.DepartAt = time.Unix(1600000000, 0)
+	if _, _, err := c.Directions(context.Background(), r); err == nil {
+		t.Errorf("Declaring both DepartNow and DepartAt should return error")
+	}
+}
+
+func TestDirectionsArriveAt(t *testing.T) {
+	expectedQuery := "arrival_time=1600000000&destination=Parramatta&key=AIzaNotReallyAnAPIKey&mode=transit&origin=Sydney"
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	r := &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", Mode: TravelModeTransit, ArriveAt: time.Unix(1600000000, 0)}
+	if _, _, err := c.Directions(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+
+	r.DepartNow = true
+	if _, _, err := c.Directions(context.Background(), r); err == nil {
+		t.Errorf("Declaring both DepartNow and ArriveAt should return error")
+	}
+}
+
+func TestDirectionsTimestampStrings(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	for _, r := range []*DirectionsRequest{
+		{Origin: "Sydney", Destination: "Parramatta", DepartureTime: "2030-01-02T15:04:05Z"},
+		{Origin: "Sydney", Destination: "Parramatta", Mode: TravelModeTransit, ArrivalTime: "2030-01-02T15:04:05Z"},
+	} {
+		if _, _, err := c.Directions(context.Background(), r); err == nil {
+			t.Errorf("RFC 3339 times should return error, request %+v", r)
+		}
+	}
+}
+
+func TestDirectionsDepartAt(t *testing.T) {
+	expectedQuery := "departure_time=1500003600&destination=Parramatta&key=AIzaNotReallyAnAPIKey&origin=Sydney"
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithClock(clock))
+
+	r := &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", DepartAt: clock.Now().Add(time.Hour)}
+	if _, _, err := c.Directions(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+
+	r.DepartAt = clock.Now().Add(-time.Minute)
+	if _, _, err := c.Directions(context.Background(), r); err == nil {
+		t.Errorf("DepartAt in the past should return error")
+	}
+	r = &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", DepartureTime: "1499999000"}
+	if _, _, err := c.Directions(context.Background(), r); err == nil {
+		t.Errorf("DepartureTime in the past should return error")
+	}
+	if server.successful != 1 {
+		t.Errorf("Requests with past departures should not be sent")
+	}
+
+	// Departures computed from the current time are accepted a little later.
+	anyQueryServer := mockServer(200, `{"status":"OK"}"`)
+	defer anyQueryServer.Close()
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(anyQueryServer.URL), WithClock(clock))
+	r = &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", DepartAt: clock.Now().Add(-2 * time.Second)}
+	if _, _, err := c.Directions(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+
+	// Transit directions may depart in the past.
+	r = &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta", DepartureTime: "1499999000", Mode: TravelModeTransit}
+	if _, _, err := c.Directions(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+}
+
 func TestFare(t *testing.T) {
 	// Directions response, sans steps.
 	response := `{
@@ -744,3 +840,261 @@ func TestConstructParamsWithoutOptimizeFlag(t *testing.T) {
 	uri, _ := url.QueryUnescape(v.Encode())
 	require.Equal("destination=Adelaide,SA&origin=Adelaide,SA&waypoints=Barossa+Valley,SA|Clare,SA|Connawarra,SA|McLaren+Vale,SA", uri)
 }
+
+func TestConstructParamsWithTypedWaypoints(t *testing.T) {
+	r := &DirectionsRequest{
+		Origin:      "Adelaide,SA",
+		Destination: "Adelaide,SA",
+		Optimize:    true,
+		TypedWaypoints: []Waypoint{
+			{Address: "Clare,SA"},
+			{PlaceID: "ChIJ3S-JXmauEmsRUcIaWtf4MzE", Via: true},
+			{Location: &LatLng{Lat: -34.9, Lng: 138.6}, SideOfRoad: true},
+			{Path: []LatLng{{Lat: 38.5, Lng: -120.2}, {Lat: 40.7, Lng: -120.95}}, Via: true},
+		},
+	}
+	require.Equal(t, "optimize:true|Clare,SA|via:place_id:ChIJ3S-JXmauEmsRUcIaWtf4MzE|side_of_road:-34.9,138.6|via:enc:_p~iF~ps|U_ulLnnqC:",
+		r.params().Get("waypoints"))
+}
+
+func TestDirectionsTypedWaypointsInvalid(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	for _, r := range []*DirectionsRequest{
+		{Origin: "a", Destination: "b", Waypoints: []string{"c"}, TypedWaypoints: []Waypoint{{Address: "d"}}},
+		{Origin: "a", Destination: "b", TypedWaypoints: []Waypoint{{}}},
+		{Origin: "a", Destination: "b", TypedWaypoints: []Waypoint{{Address: "c", PlaceID: "d"}}},
+		{Origin: "a", Destination: "b", TypedWaypoints: []Waypoint{{PlaceID: "c", SideOfRoad: true}}},
+	} {
+		_, _, err := c.Directions(context.Background(), r)
+		require.Error(t, err)
+	}
+}
+
+func TestDirectionsOptimizeRequiresWaypoints(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &DirectionsRequest{Origin: "a", Destination: "b", Optimize: true, TypedWaypoints: []Waypoint{{Address: "c"}}}
+	_, _, err := c.Directions(context.Background(), r)
+	require.EqualError(t, err, "maps: Optimize requires at least 2 waypoints")
+}
+
+func TestRouteOptimizedWaypoints(t *testing.T) {
+	original := []Waypoint{{Address: "Clare,SA"}, {Address: "Barossa Valley,SA"}, {PlaceID: "ChIJ3S-JXmauEmsRUcIaWtf4MzE"}}
+	r := &Route{WaypointOrder: []int{1, 2, 0}}
+	require.Equal(t, []Waypoint{original[1], original[2], original[0]}, r.OptimizedWaypoints(original))
+	require.Equal(t, "Clare,SA", original[0].Address)
+
+	require.Nil(t, r.OptimizedWaypoints(original[:2]))
+	require.Nil(t, (&Route{WaypointOrder: []int{0, 0, 1}}).OptimizedWaypoints(original))
+	require.Nil(t, (&Route{WaypointOrder: []int{0, 1, 3}}).OptimizedWaypoints(original))
+}
+
+func TestDirectionsLazySteps(t *testing.T) {
+	response := `{
+   "routes" : [
+      {
+         "legs" : [
+            {
+               "distance" : { "text" : "23.8 km", "value" : 23846 },
+               "duration" : { "text" : "37 mins", "value" : 2215 },
+               "end_address" : "Parramatta NSW, Australia",
+               "start_address" : "Sydney NSW, Australia",
+               "steps" : [
+                  {
+                     "distance" : { "text" : "0.4 km", "value" : 366 },
+                     "duration" : { "text" : "1 min", "value" : 70 },
+                     "end_location" : { "lat" : -33.8703, "lng" : 151.2059 },
+                     "html_instructions" : "Head <b>west</b>",
+                     "maneuver" : "turn-left",
+                     "polyline" : { "points" : "nyfmEkw|y[" },
+                     "start_location" : { "lat" : -33.8688, "lng" : 151.2093 },
+                     "travel_mode" : "DRIVING"
+                  }
+               ]
+            }
+         ],
+         "summary" : "M4"
+      }
+   ],
+   "status" : "OK"
+}`
+
+	server := mockServer(200, response)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	eager, _, err := c.Directions(context.Background(), &DirectionsRequest{
+		Origin:      "Sydney",
+		Destination: "Parramatta",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ManeuverTurnLeft, eager[0].Legs[0].Steps[0].Maneuver)
+
+	lazy, _, err := c.Directions(context.Background(), &DirectionsRequest{
+		Origin:      "Sydney",
+		Destination: "Parramatta",
+		LazySteps:   true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, lazy, 1)
+	require.Len(t, lazy[0].Legs, 1)
+	leg := lazy[0].Legs[0]
+	require.Equal(t, "M4", lazy[0].Summary)
+	require.Equal(t, 2215*time.Second, leg.Duration)
+	require.Nil(t, leg.Steps)
+
+	// Steps which have not been decoded are kept when the route is marshalled.
+	data, err := json.Marshal(lazy)
+	require.NoError(t, err)
+	var decoded []Route
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, eager, decoded)
+	require.Nil(t, leg.Steps)
+
+	// Accessors derived from the steps decode them on demand.
+	require.Equal(t, 1, LegRecords(lazy)[0].StepCount)
+	require.Len(t, leg.Pace(0.25).Steps, 1)
+
+	steps, err := leg.DecodeSteps()
+	require.NoError(t, err)
+	require.Equal(t, eager[0].Legs[0].Steps, steps)
+	require.Equal(t, steps, leg.Steps)
+	require.Equal(t, eager, lazy)
+}
+
+func TestLegViaWaypointAndTrafficSpeedEntry(t *testing.T) {
+	data := `{
+		"via_waypoint": [{"location": {"lat": -33.87, "lng": 151.2}, "step_index": 2, "step_interpolation": 0.25}],
+		"traffic_speed_entry": [{"offset_meters": 120, "speed_category": "SLOW", "extra": true}]
+	}`
+	var leg Leg
+	require.NoError(t, json.Unmarshal([]byte(data), &leg))
+	require.Equal(t, []*ViaWaypoint{{Location: LatLng{Lat: -33.87, Lng: 151.2}, StepIndex: 2, StepInterpolation: 0.25}}, leg.ViaWaypoint)
+	require.Len(t, leg.TrafficSpeedEntry, 1)
+	entry := leg.TrafficSpeedEntry[0]
+	require.Equal(t, 120, entry.OffsetMeters)
+	require.Equal(t, "SLOW", entry.SpeedCategory)
+	require.JSONEq(t, `{"offset_meters": 120, "speed_category": "SLOW", "extra": true}`, string(entry.Raw))
+}
+
+func TestRouteTotalsAndPath(t *testing.T) {
+	first := []LatLng{{Lat: 38.5, Lng: -120.2}, {Lat: 40.7, Lng: -120.95}}
+	second := []LatLng{{Lat: 40.7, Lng: -120.95}, {Lat: 43.252, Lng: -126.453}}
+	r := &Route{
+		OverviewPolyline: Polyline{Points: Encode(append(first, second[1]))},
+		Legs: []*Leg{
+			{Distance: Distance{Meters: 1000}, Duration: time.Minute, Steps: []*Step{
+				{Polyline: Polyline{Points: Encode(first)}},
+				{Polyline: Polyline{Points: Encode(second)}},
+			}},
+			{Distance: Distance{Meters: 500}, Duration: 30 * time.Second},
+		},
+	}
+	require.Equal(t, Distance{Meters: 1500}, r.TotalDistance())
+	require.Equal(t, 90*time.Second, r.TotalDuration())
+
+	path, err := r.Path()
+	require.NoError(t, err)
+	require.Len(t, path, 3)
+	require.True(t, path[2].AlmostEqual(&second[1], 1e-9))
+	legPath, err := r.Legs[0].Path()
+	require.NoError(t, err)
+	require.Equal(t, path, legPath)
+	legPath, err = r.Legs[1].Path()
+	require.NoError(t, err)
+	require.Empty(t, legPath)
+}
+
+func TestDirectionsReportWaypointFailures(t *testing.T) {
+	response := `{
+		"geocoded_waypoints": [
+			{"geocoder_status": "OK", "place_id": "origin"},
+			{"geocoder_status": "ZERO_RESULTS"},
+			{"geocoder_status": "OK", "place_id": "waypoint"},
+			{"geocoder_status": "NOT_FOUND"}
+		],
+		"routes": [{"summary": "M4"}],
+		"status": "OK"
+	}`
+	server := mockServer(200, response)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &DirectionsRequest{Origin: "Sydney", Destination: "Nowhere", Waypoints: []string{"Atlantis", "Parramatta"}}
+
+	routes, _, err := c.Directions(context.Background(), r)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	r.ReportWaypointFailures = true
+	routes, waypoints, err := c.Directions(context.Background(), r)
+	require.Len(t, routes, 1)
+	require.Len(t, waypoints, 4)
+	partial, ok := err.(*PartialFailureError)
+	require.True(t, ok, "want *PartialFailureError, got %v", err)
+	require.Equal(t, []WaypointFailure{
+		{Index: 1, Role: "waypoint", Input: "Atlantis", Status: "ZERO_RESULTS"},
+		{Index: 3, Role: "destination", Input: "Nowhere", Status: "NOT_FOUND"},
+	}, partial.Failures)
+	require.EqualError(t, err, `maps: waypoints not geocoded: waypoint "Atlantis": ZERO_RESULTS, destination "Nowhere": NOT_FOUND`)
+
+	require.NoError(t, r.CheckWaypoints(waypoints[:1]))
+}
+
+func TestParseManeuver(t *testing.T) {
+	m, err := ParseManeuver("Roundabout-Right")
+	require.NoError(t, err)
+	require.Equal(t, ManeuverRoundaboutRight, m)
+	_, err = ParseManeuver("teleport")
+	require.Error(t, err)
+}
+
+func TestDirectionsResolveWaypointLocations(t *testing.T) {
+	directions := `{
+		"geocoded_waypoints": [
+			{"geocoder_status": "OK", "place_id": "origin"},
+			{"geocoder_status": "ZERO_RESULTS"},
+			{"geocoder_status": "OK", "place_id": "destination"}
+		],
+		"routes": [{"summary": "M4"}],
+		"status": "OK"
+	}`
+	geocodes := map[string]string{
+		"origin":      `{"results": [{"formatted_address": "Sydney NSW, Australia", "geometry": {"location": {"lat": -33.87, "lng": 151.21}}}], "status": "OK"}`,
+		"destination": `{"results": [{"formatted_address": "Parramatta, MA, USA", "geometry": {"location": {"lat": 42.12, "lng": -71.4}}}], "status": "OK"}`,
+	}
+	var geocodeQueries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		if r.URL.Path == "/maps/api/geocode/json" {
+			geocodeQueries = append(geocodeQueries, r.URL.RawQuery)
+			fmt.Fprintln(w, geocodes[r.URL.Query().Get("place_id")])
+			return
+		}
+		fmt.Fprintln(w, directions)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	r := &DirectionsRequest{
+		Origin:      "Sydney",
+		Destination: "Parramatta",
+		Region:      "au",
+	}
+	_, waypoints, err := c.Directions(context.Background(), r)
+	require.NoError(t, err)
+	require.Nil(t, waypoints[0].Location)
+	require.Empty(t, geocodeQueries)
+
+	r.ResolveWaypointLocations = true
+	routes, waypoints, err := c.Directions(context.Background(), r)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, &LatLng{Lat: -33.87, Lng: 151.21}, waypoints[0].Location)
+	require.Equal(t, "Sydney NSW, Australia", waypoints[0].FormattedAddress)
+	require.Nil(t, waypoints[1].Location)
+	require.Equal(t, &LatLng{Lat: 42.12, Lng: -71.4}, waypoints[2].Location)
+	require.Equal(t, []string{
+		"key=AIzaNotReallyAnAPIKey&place_id=origin&region=au",
+		"key=AIzaNotReallyAnAPIKey&place_id=destination&region=au",
+	}, geocodeQueries)
+}
@@ -20,6 +20,7 @@ package maps
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"strings"
 	"time"
@@ -30,19 +31,24 @@ var distanceMatrixAPI = &apiConfig{
 	path:             "/maps/api/distancematrix/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	acceptsLanguage:  true,
 }
 
 // DistanceMatrix makes a Distance Matrix API request
 func (c *Client) DistanceMatrix(ctx context.Context, r *DistanceMatrixRequest) (*DistanceMatrixResponse, error) {
-
-	if len(r.Origins) == 0 {
-		return nil, errors.New("maps: origins empty")
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
+	if err := r.checkLocations(); err != nil {
+		return nil, err
 	}
-	if len(r.Destinations) == 0 {
-		return nil, errors.New("maps: destinations empty")
+	if err := checkTravelTimes(r.DepartureTime, r.DepartNow, r.DepartAt, r.ArrivalTime, r.ArriveAt); err != nil {
+		return nil, err
 	}
-	if r.DepartureTime != "" && r.ArrivalTime != "" {
-		return nil, errors.New("maps: DepartureTime and ArrivalTime both specified")
+	if r.Mode != TravelModeTransit {
+		if err := validateDepartureTime(c.clock.Now(), departureTime(r.DepartureTime, r.DepartNow, r.DepartAt)); err != nil {
+			return nil, err
+		}
 	}
 	if len(r.TransitMode) != 0 && r.Mode != TravelModeTransit {
 		return nil, errors.New("maps: TransitMode specified while Mode != TravelModeTransit")
@@ -72,8 +78,8 @@ func (c *Client) DistanceMatrix(ctx context.Context, r *DistanceMatrixRequest) (
 
 func (r *DistanceMatrixRequest) params() url.Values {
 	q := make(url.Values)
-	q.Set("origins", strings.Join(r.Origins, "|"))
-	q.Set("destinations", strings.Join(r.Destinations, "|"))
+	q.Set("origins", strings.Join(r.origins(), "|"))
+	q.Set("destinations", strings.Join(r.destinations(), "|"))
 	if r.Mode != "" {
 		q.Set("mode", string(r.Mode))
 	}
@@ -86,11 +92,11 @@ func (r *DistanceMatrixRequest) params() url.Values {
 	if r.Units != "" {
 		q.Set("units", string(r.Units))
 	}
-	if r.DepartureTime != "" {
-		q.Set("departure_time", r.DepartureTime)
+	if departure := departureTime(r.DepartureTime, r.DepartNow, r.DepartAt); departure != "" {
+		q.Set("departure_time", departure)
 	}
-	if r.ArrivalTime != "" {
-		q.Set("arrival_time", r.ArrivalTime)
+	if arrival := arrivalTime(r.ArrivalTime, r.ArriveAt); arrival != "" {
+		q.Set("arrival_time", arrival)
 	}
 	if r.TrafficModel != "" {
 		q.Set("traffic_model", string(r.TrafficModel))
@@ -116,6 +122,12 @@ type DistanceMatrixRequest struct {
 	// Destinations is a list of addresses and/or textual latitude/longitude values
 	// to which to calculate distance and time. Required.
 	Destinations []string
+	// OriginLocations are the origins, as an alternative to Origins which
+	// formats each location for the API.
+	OriginLocations []DistanceMatrixLocation
+	// DestinationLocations are the destinations, as an alternative to
+	// Destinations which formats each location for the API.
+	DestinationLocations []DistanceMatrixLocation
 	// Mode specifies the mode of transport to use when calculating distance.
 	// Valid values are `ModeDriving`, `ModeWalking`, `ModeBicycling`
 	// and `ModeTransit`. Optional.
@@ -136,6 +148,15 @@ type DistanceMatrixRequest struct {
 	// in seconds since midnight, January 1, 1970 UTC. You cannot specify
 	// both `DepartureTime` and `ArrivalTime`. Optional.
 	ArrivalTime string
+	// DepartNow requests travel times departing now, like a DepartureTime of
+	// "now". Optional.
+	DepartNow bool
+	// DepartAt specifies the desired time of departure, as an alternative to
+	// DepartureTime. Optional.
+	DepartAt time.Time
+	// ArriveAt specifies the desired time of arrival for transit requests, as
+	// an alternative to ArrivalTime. Optional.
+	ArriveAt time.Time
 	// TrafficModel determines the type of model that will be used when determining
 	// travel time when using depature times in the future. Options are
 	// `TrafficModelBestGuess`, `TrafficModelOptimistic`` or `TrafficModelPessimistic`.
@@ -152,6 +173,136 @@ type DistanceMatrixRequest struct {
 	TransitRoutingPreference TransitRoutingPreference
 }
 
+// DistanceMatrixLocation is an origin or destination of a Distance Matrix
+// request, given by exactly one of its fields.
+type DistanceMatrixLocation struct {
+	// Address is an address or place name.
+	Address string
+	// Location is the coordinates of the location.
+	Location *LatLng
+	// PlaceID is the place ID of the location.
+	PlaceID string
+	// PlusCode is the global or compound plus code of the location, such as
+	// "849VCWC8+R9".
+	PlusCode string
+	// Path is a series of locations, sent as an encoded polyline.
+	Path []LatLng
+}
+
+// String returns l in the format of the origins and destinations parameters,
+// such as "place_id:ChIJ3S-JXmauEmsRUcIaWtf4MzE".
+func (l *DistanceMatrixLocation) String() string {
+	switch {
+	case l.Location != nil:
+		return l.Location.String()
+	case l.PlaceID != "":
+		return "place_id:" + l.PlaceID
+	case l.PlusCode != "":
+		return l.PlusCode
+	case len(l.Path) != 0:
+		return "enc:" + Encode(l.Path) + ":"
+	}
+	return l.Address
+}
+
+// validate returns an error if l is not a valid location.
+func (l *DistanceMatrixLocation) validate() error {
+	n := 0
+	for _, set := range []bool{l.Address != "", l.Location != nil, l.PlaceID != "", l.PlusCode != "", len(l.Path) != 0} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of Address, Location, PlaceID, PlusCode and Path required")
+	}
+	return nil
+}
+
+// formatLocations returns the parameter value of each of locations.
+func formatLocations(locations []DistanceMatrixLocation) []string {
+	s := make([]string, len(locations))
+	for i := range locations {
+		s[i] = locations[i].String()
+	}
+	return s
+}
+
+// origins returns the origins of r, whether given as Origins or
+// OriginLocations.
+func (r *DistanceMatrixRequest) origins() []string {
+	if len(r.OriginLocations) != 0 {
+		return formatLocations(r.OriginLocations)
+	}
+	return r.Origins
+}
+
+// destinations returns the destinations of r, whether given as Destinations or
+// DestinationLocations.
+func (r *DistanceMatrixRequest) destinations() []string {
+	if len(r.DestinationLocations) != 0 {
+		return formatLocations(r.DestinationLocations)
+	}
+	return r.Destinations
+}
+
+// checkLocations returns an error if r does not have valid origins and
+// destinations.
+func (r *DistanceMatrixRequest) checkLocations() error {
+	if len(r.Origins) != 0 && len(r.OriginLocations) != 0 {
+		return errors.New("maps: Origins and OriginLocations both specified")
+	}
+	if len(r.Destinations) != 0 && len(r.DestinationLocations) != 0 {
+		return errors.New("maps: Destinations and DestinationLocations both specified")
+	}
+	if len(r.origins()) == 0 {
+		return errors.New("maps: origins empty")
+	}
+	if len(r.destinations()) == 0 {
+		return errors.New("maps: destinations empty")
+	}
+	for i := range r.OriginLocations {
+		if err := r.OriginLocations[i].validate(); err != nil {
+			return fmt.Errorf("maps: origin %d: %v", i, err)
+		}
+	}
+	for i := range r.DestinationLocations {
+		if err := r.DestinationLocations[i].validate(); err != nil {
+			return fmt.Errorf("maps: destination %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// GeocodingResultLocations returns the origins or destinations of a Distance
+// Matrix request for results. Results are referenced by place ID, falling back
+// to their location for results without one.
+func GeocodingResultLocations(results []GeocodingResult) []string {
+	locations := make([]string, len(results))
+	for i := range results {
+		locations[i] = placeIDOrLocation(results[i].PlaceID, &results[i].Geometry.Location)
+	}
+	return locations
+}
+
+// PlacesSearchResultLocations returns the origins or destinations of a Distance
+// Matrix request for results. Results are referenced by place ID, falling back
+// to their location for results without one.
+func PlacesSearchResultLocations(results []PlacesSearchResult) []string {
+	locations := make([]string, len(results))
+	for i := range results {
+		locations[i] = placeIDOrLocation(results[i].PlaceID, &results[i].Geometry.Location)
+	}
+	return locations
+}
+
+func placeIDOrLocation(placeID string, location *LatLng) string {
+	if placeID != "" {
+		return "place_id:" + placeID
+	}
+	return location.String()
+}
+
 // DistanceMatrixResponse represents a Distance Matrix API response.
 type DistanceMatrixResponse struct {
 
@@ -173,7 +324,9 @@ type DistanceMatrixElementsRow struct {
 // DistanceMatrixElement is the travel distance and time for a pair of origin
 // and destination.
 type DistanceMatrixElement struct {
-	Status string `json:"status"`
+	// Status is the status of the element. The other fields are only set for
+	// elements with ElementStatusOK.
+	Status ElementStatus `json:"status"`
 	// Duration is the length of time it takes to travel this route.
 	Duration time.Duration `json:"duration"`
 	// DurationInTraffic is the length of time it takes to travel this route
@@ -182,3 +335,89 @@ type DistanceMatrixElement struct {
 	// Distance is the total distance of this route.
 	Distance Distance `json:"distance"`
 }
+
+// ElementStatus is the status of an element of a Distance Matrix response.
+type ElementStatus string
+
+// The statuses of Distance Matrix elements.
+const (
+	// ElementStatusOK indicates the element has a route.
+	ElementStatusOK = ElementStatus("OK")
+	// ElementStatusNotFound indicates the origin or destination could not be
+	// geocoded.
+	ElementStatusNotFound = ElementStatus("NOT_FOUND")
+	// ElementStatusZeroResults indicates there is no route between the origin
+	// and destination.
+	ElementStatusZeroResults = ElementStatus("ZERO_RESULTS")
+	// ElementStatusMaxRouteLengthExceeded indicates the route is too long to
+	// be processed.
+	ElementStatusMaxRouteLengthExceeded = ElementStatus("MAX_ROUTE_LENGTH_EXCEEDED")
+)
+
+// ElementError is returned by DistanceMatrixResponse.Element for an element
+// which has no route.
+type ElementError struct {
+	// Origin and Destination are the indexes of the element.
+	Origin, Destination int
+	// Status is the status of the element.
+	Status ElementStatus
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("maps: no route from origin %d to destination %d: %s", e.Origin, e.Destination, e.Status)
+}
+
+// Element returns the element for the origin and destination at the given
+// indexes of the request. It returns an error if there is no such element, or
+// an *ElementError along with the element if its status is not
+// ElementStatusOK.
+func (r *DistanceMatrixResponse) Element(origin, destination int) (*DistanceMatrixElement, error) {
+	if origin < 0 || origin >= len(r.Rows) {
+		return nil, fmt.Errorf("maps: no origin %d in response of %d rows", origin, len(r.Rows))
+	}
+	elements := r.Rows[origin].Elements
+	if destination < 0 || destination >= len(elements) || elements[destination] == nil {
+		return nil, fmt.Errorf("maps: no destination %d in row of %d elements", destination, len(elements))
+	}
+	e := elements[destination]
+	if e.Status != ElementStatusOK {
+		return e, &ElementError{Origin: origin, Destination: destination, Status: e.Status}
+	}
+	return e, nil
+}
+
+// DurationsMatrix returns the duration of each element of r, indexed by origin
+// then destination. The duration of elements whose status is not
+// ElementStatusOK is -1. AsSeconds converts it to the cost matrix of a route
+// optimization solver, in which those elements are +Inf.
+func (r *DistanceMatrixResponse) DurationsMatrix() [][]time.Duration {
+	m := make([][]time.Duration, len(r.Rows))
+	for i, row := range r.Rows {
+		m[i] = make([]time.Duration, len(row.Elements))
+		for j, e := range row.Elements {
+			m[i][j] = -1
+			if e != nil && e.Status == ElementStatusOK {
+				m[i][j] = e.Duration
+			}
+		}
+	}
+	return m
+}
+
+// DistancesMatrix returns the distance in meters of each element of r, indexed
+// by origin then destination. The distance of elements whose status is not
+// ElementStatusOK is -1. AsMeters converts it to the cost matrix of a route
+// optimization solver, in which those elements are +Inf.
+func (r *DistanceMatrixResponse) DistancesMatrix() [][]int {
+	m := make([][]int, len(r.Rows))
+	for i, row := range r.Rows {
+		m[i] = make([]int, len(row.Elements))
+		for j, e := range row.Elements {
+			m[i][j] = -1
+			if e != nil && e.Status == ElementStatusOK {
+				m[i][j] = e.Distance.Meters
+			}
+		}
+	}
+	return m
+}
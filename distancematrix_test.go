@@ -403,3 +403,135 @@ func TestDistanceMatrixTrafficRequestURL(t *testing.T) {
 		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
 	}
 }
+
+func TestDistanceMatrixDepartAtRequestURL(t *testing.T) {
+	expectedQuery := "departure_time=1600000000&destinations=Parramatta&key=AIzaNotReallyAnAPIKey&origins=Sydney"
+
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithClock(clock))
+
+	r := &DistanceMatrixRequest{
+		Origins:      []string{"Sydney"},
+		Destinations: []string{"Parramatta"},
+		DepartAt:     time.Unix(1600000000, 0),
+	}
+
+	if _, err := c.DistanceMatrix(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error in constructing request URL: %+v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+
+	r.ArriveAt = time.Unix(1600003600, 0)
+	if _, err := c.DistanceMatrix(context.Background(), r); err == nil {
+		t.Errorf("Declaring both DepartAt and ArriveAt should return error")
+	}
+	r = &DistanceMatrixRequest{Origins: []string{"Sydney"}, Destinations: []string{"Parramatta"}, DepartureTime: "2030-01-02T15:04:05Z"}
+	if _, err := c.DistanceMatrix(context.Background(), r); err == nil {
+		t.Errorf("RFC 3339 DepartureTime should return error")
+	}
+	r = &DistanceMatrixRequest{Origins: []string{"Sydney"}, Destinations: []string{"Parramatta"}, DepartAt: clock.Now().Add(-time.Minute)}
+	if _, err := c.DistanceMatrix(context.Background(), r); err == nil {
+		t.Errorf("DepartAt in the past should return error")
+	}
+	if server.successful != 1 {
+		t.Errorf("Requests with past departures should not be sent")
+	}
+}
+
+func TestDistanceMatrixResultLocationsRequestURL(t *testing.T) {
+	expectedQuery := "destinations=place_id%3AChIJP3Sa8ziYEmsRUKgyFmh9AQM%7C-33.8688%2C151.2093&key=AIzaNotReallyAnAPIKey&origins=place_id%3AChIJN1t_tDeuEmsRUsoyG83frY4"
+
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	geocoded := []GeocodingResult{
+		{PlaceID: "ChIJN1t_tDeuEmsRUsoyG83frY4"},
+	}
+	places := []PlacesSearchResult{
+		{PlaceID: "ChIJP3Sa8ziYEmsRUKgyFmh9AQM"},
+		{Geometry: AddressGeometry{Location: LatLng{Lat: -33.8688, Lng: 151.2093}}},
+	}
+	r := &DistanceMatrixRequest{
+		Origins:      GeocodingResultLocations(geocoded),
+		Destinations: PlacesSearchResultLocations(places),
+	}
+
+	_, err := c.DistanceMatrix(context.Background(), r)
+	if err != nil {
+		t.Errorf("Unexpected error in constructing request URL: %+v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+}
+
+func TestDistanceMatrixLocations(t *testing.T) {
+	r := &DistanceMatrixRequest{
+		OriginLocations: []DistanceMatrixLocation{
+			{Address: "Sydney Town Hall"},
+			{PlaceID: "ChIJ3S-JXmauEmsRUcIaWtf4MzE"},
+			{Location: &LatLng{Lat: -33.8, Lng: 151.2}},
+		},
+		DestinationLocations: []DistanceMatrixLocation{
+			{PlusCode: "4RRH46J2+HG"},
+			{Path: []LatLng{{Lat: 38.5, Lng: -120.2}, {Lat: 40.7, Lng: -120.95}}},
+		},
+	}
+	q := r.params()
+	if got, want := q.Get("origins"), "Sydney Town Hall|place_id:ChIJ3S-JXmauEmsRUcIaWtf4MzE|-33.8,151.2"; got != want {
+		t.Errorf("origins = %q, want %q", got, want)
+	}
+	if got, want := q.Get("destinations"), "4RRH46J2+HG|enc:_p~iF~ps|U_ulLnnqC:"; got != want {
+		t.Errorf("destinations = %q, want %q", got, want)
+	}
+
+	c, _ := NewClient(WithAPIKey(apiKey))
+	for _, r := range []*DistanceMatrixRequest{
+		{Origins: []string{"Sydney"}, OriginLocations: []DistanceMatrixLocation{{Address: "Sydney"}}, Destinations: []string{"Perth"}},
+		{Origins: []string{"Sydney"}, DestinationLocations: []DistanceMatrixLocation{{Address: "Perth", PlaceID: "x"}}},
+		{Origins: []string{"Sydney"}, DestinationLocations: []DistanceMatrixLocation{{}}},
+		{DestinationLocations: []DistanceMatrixLocation{{Address: "Perth"}}},
+	} {
+		if _, err := c.DistanceMatrix(context.Background(), r); err == nil {
+			t.Errorf("DistanceMatrix(%+v) should return error", r)
+		}
+	}
+}
+
+func TestDistanceMatrixResponseAccessors(t *testing.T) {
+	resp := &DistanceMatrixResponse{Rows: []DistanceMatrixElementsRow{
+		{Elements: []*DistanceMatrixElement{
+			{Status: ElementStatusOK, Duration: time.Minute, Distance: Distance{Meters: 1000}},
+			{Status: ElementStatusZeroResults},
+		}},
+	}}
+
+	e, err := resp.Element(0, 0)
+	if err != nil || e.Duration != time.Minute {
+		t.Errorf("Element(0, 0) = %+v, %v", e, err)
+	}
+	e, err = resp.Element(0, 1)
+	elementErr, ok := err.(*ElementError)
+	if e == nil || !ok || elementErr.Status != ElementStatusZeroResults || elementErr.Destination != 1 {
+		t.Errorf("Element(0, 1) = %+v, %v, want element and *ElementError", e, err)
+	}
+	for _, index := range [][2]int{{1, 0}, {0, 2}, {-1, 0}} {
+		if e, err := resp.Element(index[0], index[1]); e != nil || err == nil {
+			t.Errorf("Element(%d, %d) = %+v, %v, want error", index[0], index[1], e, err)
+		}
+	}
+
+	if got, want := resp.DurationsMatrix(), [][]time.Duration{{time.Minute, -1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DurationsMatrix() = %v, want %v", got, want)
+	}
+	if got, want := resp.DistancesMatrix(), [][]int{{1000, -1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DistancesMatrix() = %v, want %v", got, want)
+	}
+}
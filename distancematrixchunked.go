@@ -0,0 +1,146 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Limits of a single Distance Matrix API request.
+const (
+	maxDistanceMatrixOrigins      = 25
+	maxDistanceMatrixDestinations = 25
+	maxDistanceMatrixElements     = 100
+)
+
+// distanceMatrixChunk is a block of a matrix, made with a single request.
+type distanceMatrixChunk struct {
+	origin, destination int
+	req                 DistanceMatrixRequest
+	resp                *DistanceMatrixResponse
+	err                 error
+}
+
+// DistanceMatrixChunked makes the Distance Matrix request r, splitting a matrix
+// larger than a single request allows into as few requests as it can, and
+// making at most concurrency of them at a time. Like GeocodeBatch, it makes
+// fewer requests at a time after the API reports that it is over its query
+// limit. The responses are combined into a single response with a row for
+// each origin and an element for each destination. If any request
+// fails, the first error is returned.
+func (c *Client) DistanceMatrixChunked(ctx context.Context, r *DistanceMatrixRequest, concurrency int) (*DistanceMatrixResponse, error) {
+	if concurrency < 1 {
+		return nil, errors.New("maps: concurrency must be at least 1")
+	}
+	if err := r.checkLocations(); err != nil {
+		return nil, err
+	}
+
+	allOrigins, allDestinations := r.origins(), r.destinations()
+	origins, destinations := distanceMatrixChunkSize(len(allOrigins), len(allDestinations))
+	var chunks []*distanceMatrixChunk
+	for o := 0; o < len(allOrigins); o += origins {
+		for d := 0; d < len(allDestinations); d += destinations {
+			chunk := &distanceMatrixChunk{origin: o, destination: d, req: *r}
+			chunk.req.Origins = allOrigins[o:minInt(o+origins, len(allOrigins))]
+			chunk.req.Destinations = allDestinations[d:minInt(d+destinations, len(allDestinations))]
+			chunk.req.OriginLocations, chunk.req.DestinationLocations = nil, nil
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	indexes := make(chan int)
+	limit := newAdaptiveConcurrency(concurrency)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(chunks); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				chunk := chunks[i]
+				chunk.err = limit.do(ctx, c, func() error {
+					var err error
+					chunk.resp, err = c.DistanceMatrix(ctx, &chunk.req)
+					return err
+				})
+				if chunk.err != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+	for i := range chunks {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// Report the error which canceled the other requests, rather than their
+	// cancelation.
+	for _, chunk := range chunks {
+		if chunk.err != nil && chunk.err != context.Canceled {
+			return nil, chunk.err
+		}
+	}
+	for _, chunk := range chunks {
+		if chunk.err != nil {
+			return nil, chunk.err
+		}
+	}
+
+	resp := &DistanceMatrixResponse{
+		OriginAddresses:      make([]string, len(allOrigins)),
+		DestinationAddresses: make([]string, len(allDestinations)),
+		Rows:                 make([]DistanceMatrixElementsRow, len(allOrigins)),
+	}
+	for i := range resp.Rows {
+		resp.Rows[i].Elements = make([]*DistanceMatrixElement, len(allDestinations))
+	}
+	for _, chunk := range chunks {
+		copy(resp.OriginAddresses[chunk.origin:], chunk.resp.OriginAddresses)
+		copy(resp.DestinationAddresses[chunk.destination:], chunk.resp.DestinationAddresses)
+		for i, row := range chunk.resp.Rows {
+			if chunk.origin+i < len(resp.Rows) {
+				copy(resp.Rows[chunk.origin+i].Elements[chunk.destination:], row.Elements)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// distanceMatrixChunkSize returns the number of origins and destinations of the
+// requests which cover a matrix of the given size in the fewest requests.
+func distanceMatrixChunkSize(origins, destinations int) (int, int) {
+	bestOrigins, bestDestinations, bestRequests := 1, 1, -1
+	for o := 1; o <= origins && o <= maxDistanceMatrixOrigins; o++ {
+		d := minInt(destinations, minInt(maxDistanceMatrixDestinations, maxDistanceMatrixElements/o))
+		requests := ((origins + o - 1) / o) * ((destinations + d - 1) / d)
+		if bestRequests < 0 || requests < bestRequests {
+			bestOrigins, bestDestinations, bestRequests = o, d, requests
+		}
+	}
+	return bestOrigins, bestDestinations
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// matrixServer is a Distance Matrix API whose origins and destinations are
+// numbers, the duration between which is 100*origin+destination seconds.
+func matrixServer(t *testing.T, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		origins := strings.Split(r.URL.Query().Get("origins"), "|")
+		destinations := strings.Split(r.URL.Query().Get("destinations"), "|")
+		if len(origins) > 25 || len(destinations) > 25 || len(origins)*len(destinations) > 100 {
+			t.Errorf("request of %d origins and %d destinations", len(origins), len(destinations))
+		}
+		type element struct {
+			Status   string `json:"status"`
+			Duration struct {
+				Value int `json:"value"`
+			} `json:"duration"`
+		}
+		resp := struct {
+			Status               string   `json:"status"`
+			OriginAddresses      []string `json:"origin_addresses"`
+			DestinationAddresses []string `json:"destination_addresses"`
+			Rows                 []struct {
+				Elements []element `json:"elements"`
+			} `json:"rows"`
+		}{Status: "OK"}
+		for _, d := range destinations {
+			resp.DestinationAddresses = append(resp.DestinationAddresses, "Destination "+d)
+		}
+		for _, o := range origins {
+			resp.OriginAddresses = append(resp.OriginAddresses, "Origin "+o)
+			oi, _ := strconv.Atoi(o)
+			var row struct {
+				Elements []element `json:"elements"`
+			}
+			for _, d := range destinations {
+				di, _ := strconv.Atoi(d)
+				e := element{Status: "OK"}
+				e.Duration.Value = 100*oi + di
+				row.Elements = append(row.Elements, e)
+			}
+			resp.Rows = append(resp.Rows, row)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func numbers(n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = strconv.Itoa(i)
+	}
+	return s
+}
+
+func TestDistanceMatrixChunked(t *testing.T) {
+	var requests int32
+	server := matrixServer(t, &requests)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	resp, err := c.DistanceMatrixChunked(context.Background(), &DistanceMatrixRequest{Origins: numbers(30), Destinations: numbers(7)}, 4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, requests)
+	require.Len(t, resp.Rows, 30)
+	assert.Equal(t, "Origin 29", resp.OriginAddresses[29])
+	assert.Equal(t, "Destination 6", resp.DestinationAddresses[6])
+	for o, row := range resp.Rows {
+		require.Len(t, row.Elements, 7)
+		for d, e := range row.Elements {
+			assert.Equal(t, time.Duration(100*o+d)*time.Second, e.Duration, fmt.Sprintf("origin %d destination %d", o, d))
+		}
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	_, err = c.DistanceMatrixChunked(context.Background(), &DistanceMatrixRequest{Origins: numbers(3), Destinations: numbers(5)}, 4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, requests)
+}
+
+func TestDistanceMatrixChunkedError(t *testing.T) {
+	server := mockServer(200, `{"status": "REQUEST_DENIED"}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	_, err := c.DistanceMatrixChunked(context.Background(), &DistanceMatrixRequest{Origins: numbers(30), Destinations: numbers(30)}, 2)
+	assert.Equal(t, "REQUEST_DENIED", ErrorStatus(err))
+	_, err = c.DistanceMatrixChunked(context.Background(), &DistanceMatrixRequest{Origins: numbers(3), Destinations: numbers(3)}, 0)
+	assert.Error(t, err)
+}
+
+func TestDistanceMatrixChunkSize(t *testing.T) {
+	for _, test := range []struct {
+		origins, destinations int
+		wantOrigins           int
+		wantDestinations      int
+	}{
+		{3, 5, 3, 5},
+		{1, 60, 1, 25},
+		{30, 7, 10, 7},
+		{30, 30, 10, 10},
+		{100, 1, 25, 1},
+	} {
+		o, d := distanceMatrixChunkSize(test.origins, test.destinations)
+		assert.Equal(t, test.wantOrigins, o, "%dx%d", test.origins, test.destinations)
+		assert.Equal(t, test.wantDestinations, d, "%dx%d", test.origins, test.destinations)
+	}
+}
@@ -16,5 +16,11 @@
 Package maps provides a client library for the Google Maps Web Service APIs.
 Please see https://developers.google.com/maps/documentation/webservices/ for
 an overview of the Maps Web Service API suite.
+
+Responses are decoded with encoding/json. Build with -tags gojson to decode
+them with github.com/goccy/go-json instead, which behaves identically but
+allocates less.
 */
 package maps // import "googlemaps.github.io/maps"
+
+//go:generate go run ./internal/gen/responses -schema internal/gen/responses/schema.json -out responses_gen.go
@@ -0,0 +1,143 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const contextDryRun = contextKey("DRY-RUN")
+
+// ErrDryRun is returned by the API calls made by DryRun, which build their
+// request but do not send it.
+var ErrDryRun = errors.New("maps: dry run")
+
+// redactedKey replaces the API key of requests returned by DryRun.
+const redactedKey = "REDACTED"
+
+// dryRun is an http.RoundTripper which records the request instead of
+// sending it.
+type dryRun struct {
+	req *http.Request
+}
+
+func (d *dryRun) RoundTrip(req *http.Request) (*http.Response, error) {
+	d.req = req
+	return nil, ErrDryRun
+}
+
+// dryRunFromContext returns the dry run set by DryRun, or nil.
+func dryRunFromContext(ctx context.Context) *dryRun {
+	if d, ok := ctx.Value(contextDryRun).(*dryRun); ok {
+		return d
+	}
+	return nil
+}
+
+// DryRun returns the HTTP request which the API call for r would send, with
+// its signature and headers, without sending it or waiting for the rate
+// limiter. r is validated as it would be by the call. The API key is replaced
+// with "REDACTED" unless includeKey is set.
+//
+// A GeocodingRequest with no Address or Components is built as a reverse
+// geocode, and a StreetViewRequest as a StreetViewStatic image request.
+func (c *Client) DryRun(ctx context.Context, r interface{}, includeKey bool) (*http.Request, error) {
+	d := &dryRun{}
+	parent := ctx
+	ctx = context.WithValue(ctx, contextDryRun, d)
+
+	var err error
+	switch r := r.(type) {
+	case *AddressValidationRequest:
+		_, err = c.ValidateAddress(ctx, r)
+	case *AirQualityHeatmapTileRequest:
+		_, err = c.AirQualityHeatmapTile(ctx, r)
+	case *ValidationFeedbackRequest:
+		err = c.ProvideValidationFeedback(ctx, r)
+	case *DirectionsRequest:
+		_, _, err = c.Directions(ctx, r)
+	case *DistanceMatrixRequest:
+		_, err = c.DistanceMatrix(ctx, r)
+	case *ElevationRequest:
+		_, err = c.Elevation(ctx, r)
+	case *GeocodingRequest:
+		if r.Address == "" && len(r.Components) == 0 {
+			_, err = c.ReverseGeocode(ctx, r)
+		} else {
+			_, err = c.Geocode(ctx, r)
+		}
+	case *GeolocationRequest:
+		_, err = c.Geolocate(ctx, r)
+	case *MapTilesSessionRequest:
+		_, err = c.CreateMapTilesSession(ctx, r)
+	case *MapTileRequest:
+		_, err = c.GetTile(ctx, r)
+	case *MapTilesViewportRequest:
+		_, err = c.MapTilesViewport(ctx, r)
+	case *NearbySearchRequest:
+		_, err = c.NearbySearch(ctx, r)
+	case *TextSearchRequest:
+		_, err = c.TextSearch(ctx, r)
+	case *PlaceDetailsRequest:
+		_, err = c.PlaceDetails(ctx, r)
+	case *QueryAutocompleteRequest:
+		_, err = c.QueryAutocomplete(ctx, r)
+	case *PlaceAutocompleteRequest:
+		_, err = c.PlaceAutocomplete(ctx, r)
+	case *PlacePhotoRequest:
+		_, err = c.PlacePhoto(ctx, r)
+	case *FindPlaceFromTextRequest:
+		_, err = c.FindPlaceFromText(ctx, r)
+	case *PlaceDetailsNewRequest:
+		_, err = c.PlaceDetailsNew(ctx, r)
+	case *PlacePhotoNewRequest:
+		_, err = c.PlacePhotoNew(ctx, r)
+	case *SnapToRoadRequest:
+		_, err = c.SnapToRoad(ctx, r)
+	case *NearestRoadsRequest:
+		_, err = c.NearestRoads(ctx, r)
+	case *SpeedLimitsRequest:
+		_, err = c.SpeedLimits(ctx, r)
+	case *ComputeRoutesRequest:
+		_, err = c.ComputeRoutes(ctx, r)
+	case *StaticMapRequest:
+		_, err = c.StaticMap(ctx, r)
+	case *StreetViewRequest:
+		_, err = c.StreetViewStatic(ctx, r)
+	case *TimezoneRequest:
+		_, err = c.Timezone(ctx, r)
+	default:
+		return nil, fmt.Errorf("maps: unsupported request type %T", r)
+	}
+	if d.req == nil {
+		return nil, err
+	}
+
+	req := d.req.WithContext(parent)
+	if !includeKey {
+		params := strings.Split(req.URL.RawQuery, "&")
+		for i, p := range params {
+			if strings.HasPrefix(p, "key=") {
+				params[i] = "key=" + redactedKey
+			}
+		}
+		req.URL.RawQuery = strings.Join(params, "&")
+	}
+	return req, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	// The client's rate limiter would otherwise block the second request.
+	c, _ := NewClient(WithAPIKey(apiKey), WithRateLimit(1), WithHeader("X-Test", "yes"))
+	r := &GeocodingRequest{Address: "Sydney", Language: "en"}
+
+	req, err := c.DryRun(context.Background(), r, false)
+	require.NoError(t, err)
+	assert.Equal(t, "GET", req.Method)
+	assert.Equal(t, "https://maps.googleapis.com/maps/api/geocode/json?address=Sydney&key=REDACTED&language=en", req.URL.String())
+	assert.Equal(t, "yes", req.Header.Get("X-Test"))
+	assert.Equal(t, userAgent, req.Header.Get("User-Agent"))
+	assert.NoError(t, req.Context().Err())
+
+	req, err = c.DryRun(context.Background(), r, true)
+	require.NoError(t, err)
+	assert.Equal(t, apiKey, req.URL.Query().Get("key"))
+}
+
+func TestDryRunSigned(t *testing.T) {
+	c, _ := NewClient(WithClientIDAndSignature("clientID", "Zm9vYmFy"))
+	req, err := c.DryRun(context.Background(), &TimezoneRequest{Location: &LatLng{Lat: 1, Lng: 2}}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "clientID", req.URL.Query().Get("client"))
+	assert.NotEmpty(t, req.URL.Query().Get("signature"))
+}
+
+func TestDryRunPost(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &ComputeRoutesRequest{
+		Origin:      RoutesWaypoint{Address: "Sydney"},
+		Destination: RoutesWaypoint{Address: "Parramatta"},
+		FieldMask:   []string{"routes.duration"},
+	}
+	req, err := c.DryRun(context.Background(), r, false)
+	require.NoError(t, err)
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, "key=REDACTED", req.URL.RawQuery)
+	assert.Equal(t, "routes.duration", req.Header.Get("X-Goog-FieldMask"))
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"address":"Parramatta"`)
+}
+
+func TestDryRunPlacePhotoNew(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &PlacePhotoNewRequest{Name: "places/ChIJ/photos/abc", MaxWidthPx: 400}
+	req, err := c.DryRun(context.Background(), r, false)
+	require.NoError(t, err)
+	assert.Equal(t, "GET", req.Method)
+	assert.Equal(t, "https://places.googleapis.com/v1/places/ChIJ/photos/abc/media?key=REDACTED&maxWidthPx=400", req.URL.String())
+
+	r.SkipHTTPRedirect = true
+	req, err = c.DryRun(context.Background(), r, false)
+	require.NoError(t, err)
+	assert.Equal(t, "true", req.URL.Query().Get("skipHttpRedirect"))
+}
+
+func TestDryRunInvalid(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	_, err := c.DryRun(context.Background(), &GeocodingRequest{}, false)
+	assert.EqualError(t, err, "maps: LatLng and PlaceID are both missing")
+	_, err = c.DryRun(context.Background(), "Sydney", false)
+	assert.EqualError(t, err, "maps: unsupported request type string")
+}
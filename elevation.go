@@ -29,11 +29,14 @@ var elevationAPI = &apiConfig{
 	path:             "/maps/api/elevation/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	cacheable:        true,
 }
 
 // Elevation makes an Elevation API request
 func (c *Client) Elevation(ctx context.Context, r *ElevationRequest) ([]ElevationResult, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if len(r.Path) == 0 && len(r.Locations) == 0 {
 		return nil, errors.New("maps: Path and Locations empty")
 	}
@@ -87,13 +90,64 @@ type ElevationRequest struct {
 	Samples int
 }
 
-// ElevationResult is a single elevation at a specific location
-type ElevationResult struct {
-	// Location is the position for which elevation data is being computed.
-	Location *LatLng `json:"location"`
-	// Elevation indicates the elevation of the location in meters
-	Elevation float64 `json:"elevation"`
-	// Resolution indicates the maximum distance between data points from which the
-	// elevation was interpolated, in meters.
-	Resolution float64 `json:"resolution"`
+// SmoothElevations returns a copy of elevations with each elevation replaced by
+// the moving average of the elevations up to radius results before and after
+// it. The window is truncated at the ends of the profile. Smoothing removes
+// noise from sampled elevations before computing grades or the ascent and
+// descent.
+func SmoothElevations(elevations []ElevationResult, radius int) []ElevationResult {
+	smoothed := make([]ElevationResult, len(elevations))
+	copy(smoothed, elevations)
+	if radius <= 0 {
+		return smoothed
+	}
+	for i := range elevations {
+		start, end := i-radius, i+radius+1
+		if start < 0 {
+			start = 0
+		}
+		if end > len(elevations) {
+			end = len(elevations)
+		}
+		var sum float64
+		for _, e := range elevations[start:end] {
+			sum += e.Elevation
+		}
+		smoothed[i].Elevation = sum / float64(end-start)
+	}
+	return smoothed
+}
+
+// ElevationGrades returns the grade of each segment between consecutive
+// elevations, as a percentage of rise over horizontal distance. Segments without
+// locations or of zero length have a grade of 0.
+func ElevationGrades(elevations []ElevationResult) []float64 {
+	if len(elevations) < 2 {
+		return nil
+	}
+	grades := make([]float64, len(elevations)-1)
+	for i := range grades {
+		from, to := elevations[i], elevations[i+1]
+		if from.Location == nil || to.Location == nil {
+			continue
+		}
+		if d := from.Location.Distance(to.Location); d > 0 {
+			grades[i] = (to.Elevation - from.Elevation) / d * 100
+		}
+	}
+	return grades
+}
+
+// ElevationAscentDescent returns the total elevation gained and lost over
+// elevations, in meters.
+func ElevationAscentDescent(elevations []ElevationResult) (ascent, descent float64) {
+	for i := 1; i < len(elevations); i++ {
+		delta := elevations[i].Elevation - elevations[i-1].Elevation
+		if delta > 0 {
+			ascent += delta
+		} else {
+			descent -= delta
+		}
+	}
+	return ascent, descent
 }
@@ -16,6 +16,7 @@ package maps
 
 import (
 	"context"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -216,3 +217,49 @@ func TestElevationRequestURL(t *testing.T) {
 		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
 	}
 }
+
+func TestSmoothElevations(t *testing.T) {
+	elevations := []ElevationResult{{Elevation: 10}, {Elevation: 40}, {Elevation: 10}, {Elevation: 40}}
+	smoothed := SmoothElevations(elevations, 1)
+	want := []float64{25, 20, 30, 25}
+	for i, e := range smoothed {
+		if math.Abs(e.Elevation-want[i]) > 1e-9 {
+			t.Errorf("smoothed elevation %d: expected %v, was %v", i, want[i], e.Elevation)
+		}
+	}
+	if elevations[1].Elevation != 40 {
+		t.Errorf("SmoothElevations modified its input")
+	}
+	if got := SmoothElevations(elevations, 0); !reflect.DeepEqual(got, elevations) {
+		t.Errorf("expected radius 0 to return a copy, was %v", got)
+	}
+}
+
+func TestElevationGrades(t *testing.T) {
+	a, b := LatLng{Lat: 0, Lng: 0}, LatLng{Lat: 0, Lng: 0.001}
+	d := a.Distance(&b)
+	elevations := []ElevationResult{
+		{Location: &a, Elevation: 100},
+		{Location: &b, Elevation: 100 + d/10},
+		{Location: &b, Elevation: 50},
+		{Elevation: 60},
+	}
+	grades := ElevationGrades(elevations)
+	want := []float64{10, 0, 0}
+	if len(grades) != len(want) {
+		t.Fatalf("expected %d grades, was %v", len(want), grades)
+	}
+	for i := range want {
+		if math.Abs(grades[i]-want[i]) > 1e-9 {
+			t.Errorf("grade %d: expected %v, was %v", i, want[i], grades[i])
+		}
+	}
+	if grades := ElevationGrades(elevations[:1]); grades != nil {
+		t.Errorf("expected no grades for a single elevation, was %v", grades)
+	}
+
+	ascent, descent := ElevationAscentDescent(elevations)
+	if math.Abs(ascent-(d/10+10)) > 1e-9 || math.Abs(descent-(d/10+50)) > 1e-9 {
+		t.Errorf("expected ascent %v and descent %v, was %v and %v", d/10+10, d/10+50, ascent, descent)
+	}
+}
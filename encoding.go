@@ -37,24 +37,78 @@ type encodedLeg struct {
 	EncDepartureTime     *internal.DateTime `json:"departure_time"`
 }
 
+// leg returns the Leg represented by this encodedLeg.
+func (x *encodedLeg) leg() Leg {
+	leg := Leg(x.safeLeg)
+
+	leg.Duration = x.EncDuration.Duration()
+	leg.DurationInTraffic = x.EncDurationInTraffic.Duration()
+	leg.ArrivalTime = x.EncArrivalTime.Time()
+	leg.DepartureTime = x.EncDepartureTime.Time()
+
+	return leg
+}
+
+// decodedLeg decodes the API representation of Leg, including its steps, in a
+// single pass over the data.
+type decodedLeg struct {
+	encodedLeg
+	EncSteps []*decodedStep `json:"steps"`
+}
+
 // UnmarshalJSON implements json.Unmarshaler for Leg. This decodes the API
 // representation into types useful for Go developers.
 func (leg *Leg) UnmarshalJSON(data []byte) error {
-	x := encodedLeg{}
-	err := json.Unmarshal(data, &x)
+	x := decodedLeg{}
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
-	*leg = Leg(x.safeLeg)
+	*leg = x.leg()
+	leg.Steps, err = decodedSteps(x.EncSteps)
 
-	leg.Duration = x.EncDuration.Duration()
-	leg.DurationInTraffic = x.EncDurationInTraffic.Duration()
-	leg.ArrivalTime = x.EncArrivalTime.Time()
-	leg.DepartureTime = x.EncDepartureTime.Time()
+	return err
+}
+
+// lazyLeg is a Leg whose steps are kept in their encoded form until
+// Leg.DecodeSteps is called.
+type lazyLeg struct {
+	Leg
+}
+
+// UnmarshalJSON implements json.Unmarshaler for lazyLeg. This decodes the API
+// representation of everything but the steps of the leg.
+func (l *lazyLeg) UnmarshalJSON(data []byte) error {
+	x := struct {
+		encodedLeg
+		EncSteps json.RawMessage `json:"steps"`
+	}{}
+	err := unmarshalJSON(data, &x)
+	if err != nil {
+		return err
+	}
+	l.Leg = x.encodedLeg.leg()
+	l.Leg.encodedSteps = x.EncSteps
 
 	return nil
 }
 
+// lazyRoute is a Route whose legs are decoded as lazyLegs.
+type lazyRoute struct {
+	Route
+	Legs []*lazyLeg `json:"legs"`
+}
+
+// route returns the Route represented by this lazyRoute.
+func (r *lazyRoute) route() Route {
+	route := r.Route
+	route.Legs = make([]*Leg, len(r.Legs))
+	for i, l := range r.Legs {
+		route.Legs[i] = &l.Leg
+	}
+	return route
+}
+
 // MarshalJSON implements json.Marshaler for Leg. This encodes Go types back to
 // the API representation.
 func (leg *Leg) MarshalJSON() ([]byte, error) {
@@ -66,6 +120,13 @@ func (leg *Leg) MarshalJSON() ([]byte, error) {
 	x.EncArrivalTime = internal.NewDateTime(leg.ArrivalTime)
 	x.EncDepartureTime = internal.NewDateTime(leg.DepartureTime)
 
+	if leg.encodedSteps != nil {
+		// Steps which have not been decoded are kept in their API representation.
+		return json.Marshal(struct {
+			encodedLeg
+			EncSteps json.RawMessage `json:"steps"`
+		}{x, leg.encodedSteps})
+	}
 	return json.Marshal(x)
 }
 
@@ -79,19 +140,67 @@ type encodedStep struct {
 	EncDuration *internal.Duration `json:"duration"`
 }
 
+// decodedStep decodes the API representation of Step, including its substeps
+// and transit details, in a single pass over the data.
+type decodedStep struct {
+	encodedStep
+	EncSteps          []*decodedStep         `json:"steps"`
+	EncTransitDetails *decodedTransitDetails `json:"transit_details"`
+}
+
+// step returns the Step represented by this decodedStep.
+func (x *decodedStep) step() (Step, error) {
+	step := Step(x.safeStep)
+
+	step.Duration = x.EncDuration.Duration()
+
+	var err error
+	step.Steps, err = decodedSteps(x.EncSteps)
+	if err != nil {
+		return Step{}, err
+	}
+	if x.EncTransitDetails != nil {
+		transitDetails, err := x.EncTransitDetails.transitDetails()
+		if err != nil {
+			return Step{}, err
+		}
+		step.TransitDetails = &transitDetails
+	}
+
+	return step, nil
+}
+
+// decodedSteps converts decoded steps, preserving the distinction between
+// absent and empty steps.
+func decodedSteps(xs []*decodedStep) ([]*Step, error) {
+	if xs == nil {
+		return nil, nil
+	}
+	steps := make([]*Step, len(xs))
+	for i, x := range xs {
+		if x == nil {
+			continue
+		}
+		step, err := x.step()
+		if err != nil {
+			return nil, err
+		}
+		steps[i] = &step
+	}
+	return steps, nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler for Step. This decodes the API
 // representation into types useful for Go developers.
 func (step *Step) UnmarshalJSON(data []byte) error {
-	x := encodedStep{}
-	err := json.Unmarshal(data, &x)
+	x := decodedStep{}
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
-	*step = Step(x.safeStep)
-
-	step.Duration = x.EncDuration.Duration()
+	*step, err = x.step()
 
-	return nil
+	return err
 }
 
 // MarshalJSON implements json.Marshaler for Step. This encodes Go types back to
@@ -117,20 +226,43 @@ type encodedTransitDetails struct {
 	EncDepartureTime *internal.DateTime `json:"departure_time"`
 }
 
+// decodedTransitDetails decodes the API representation of TransitDetails,
+// including its line, in a single pass over the data.
+type decodedTransitDetails struct {
+	encodedTransitDetails
+	EncLine *decodedTransitLine `json:"line"`
+}
+
+// transitDetails returns the TransitDetails represented by this
+// decodedTransitDetails.
+func (x *decodedTransitDetails) transitDetails() (TransitDetails, error) {
+	transitDetails := TransitDetails(x.safeTransitDetails)
+
+	transitDetails.ArrivalTime = x.EncArrivalTime.Time()
+	transitDetails.DepartureTime = x.EncDepartureTime.Time()
+
+	if x.EncLine != nil {
+		line, err := x.EncLine.transitLine()
+		if err != nil {
+			return TransitDetails{}, err
+		}
+		transitDetails.Line = line
+	}
+
+	return transitDetails, nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler for TransitDetails. This decodes
 // the API representation into types useful for Go developers.
 func (transitDetails *TransitDetails) UnmarshalJSON(data []byte) error {
-	x := encodedTransitDetails{}
-	err := json.Unmarshal(data, &x)
+	x := decodedTransitDetails{}
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
-	*transitDetails = TransitDetails(x.safeTransitDetails)
-
-	transitDetails.ArrivalTime = x.EncArrivalTime.Time()
-	transitDetails.DepartureTime = x.EncDepartureTime.Time()
+	*transitDetails, err = x.transitDetails()
 
-	return nil
+	return err
 }
 
 // MarshalJSON implements json.Marshaler for TransitDetails. This encodes Go
@@ -157,26 +289,62 @@ type encodedTransitLine struct {
 	EncIcon string `json:"icon"`
 }
 
-// UnmarshalJSON imlpements json.Unmarshaler for TransitLine. This decodes the
-// API representation into types useful for Go developers.
-func (transitLine *TransitLine) UnmarshalJSON(data []byte) error {
-	x := encodedTransitLine{}
-	err := json.Unmarshal(data, &x)
-	if err != nil {
-		return err
-	}
-	*transitLine = TransitLine(x.safeTransitLine)
+// decodedTransitLine decodes the API representation of TransitLine, including
+// its agencies and vehicle, in a single pass over the data.
+type decodedTransitLine struct {
+	encodedTransitLine
+	EncAgencies []*encodedTransitAgency    `json:"agencies"`
+	EncVehicle  *encodedTransitLineVehicle `json:"vehicle"`
+}
+
+// transitLine returns the TransitLine represented by this decodedTransitLine.
+func (x *decodedTransitLine) transitLine() (TransitLine, error) {
+	transitLine := TransitLine(x.safeTransitLine)
 
+	var err error
 	transitLine.URL, err = url.Parse(x.EncURL)
 	if err != nil {
-		return err
+		return TransitLine{}, err
 	}
 	transitLine.Icon, err = url.Parse(x.EncIcon)
+	if err != nil {
+		return TransitLine{}, err
+	}
+
+	if x.EncAgencies != nil {
+		transitLine.Agencies = make([]*TransitAgency, len(x.EncAgencies))
+		for i, a := range x.EncAgencies {
+			if a == nil {
+				continue
+			}
+			agency, err := a.transitAgency()
+			if err != nil {
+				return TransitLine{}, err
+			}
+			transitLine.Agencies[i] = &agency
+		}
+	}
+	if x.EncVehicle != nil {
+		transitLine.Vehicle, err = x.EncVehicle.transitLineVehicle()
+		if err != nil {
+			return TransitLine{}, err
+		}
+	}
+
+	return transitLine, nil
+}
+
+// UnmarshalJSON imlpements json.Unmarshaler for TransitLine. This decodes the
+// API representation into types useful for Go developers.
+func (transitLine *TransitLine) UnmarshalJSON(data []byte) error {
+	x := decodedTransitLine{}
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
+	*transitLine, err = x.transitLine()
 
-	return nil
+	return err
 }
 
 // MarshalJSON implements json.Marshaler for TransitLine. This encodes Go
@@ -202,22 +370,31 @@ type encodedTransitAgency struct {
 	EncURL string `json:"url"`
 }
 
+// transitAgency returns the TransitAgency represented by this
+// encodedTransitAgency.
+func (x *encodedTransitAgency) transitAgency() (TransitAgency, error) {
+	transitAgency := TransitAgency(x.safeTransitAgency)
+
+	var err error
+	transitAgency.URL, err = url.Parse(x.EncURL)
+	if err != nil {
+		return TransitAgency{}, err
+	}
+
+	return transitAgency, nil
+}
+
 // UnmarshalJSON imlpements json.Unmarshaler for TransitAgency. This decodes the
 // API representation into types useful for Go developers.
 func (transitAgency *TransitAgency) UnmarshalJSON(data []byte) error {
 	x := encodedTransitAgency{}
-	err := json.Unmarshal(data, &x)
-	if err != nil {
-		return err
-	}
-	*transitAgency = TransitAgency(x.safeTransitAgency)
-
-	transitAgency.URL, err = url.Parse(x.EncURL)
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
+	*transitAgency, err = x.transitAgency()
 
-	return nil
+	return err
 }
 
 // MarshalJSON implements json.Marshaler for TransitAgency. This encodes Go
@@ -239,25 +416,41 @@ type safeTransitLineVehicle TransitLineVehicle
 // as per the Maps APIs
 type encodedTransitLineVehicle struct {
 	safeTransitLineVehicle
-	EncIcon string `json:"icon"`
+	EncIcon      string `json:"icon"`
+	EncLocalIcon string `json:"local_icon,omitempty"`
+}
+
+// transitLineVehicle returns the TransitLineVehicle represented by this
+// encodedTransitLineVehicle.
+func (x *encodedTransitLineVehicle) transitLineVehicle() (TransitLineVehicle, error) {
+	transitLineVehicle := TransitLineVehicle(x.safeTransitLineVehicle)
+
+	var err error
+	transitLineVehicle.Icon, err = url.Parse(x.EncIcon)
+	if err != nil {
+		return TransitLineVehicle{}, err
+	}
+	if x.EncLocalIcon != "" {
+		transitLineVehicle.LocalIcon, err = url.Parse(x.EncLocalIcon)
+		if err != nil {
+			return TransitLineVehicle{}, err
+		}
+	}
+
+	return transitLineVehicle, nil
 }
 
 // UnmarshalJSON imlpements json.Unmarshaler for TransitLineVehicle. This
 // decodes the API representation into types useful for Go developers.
 func (transitLineVehicle *TransitLineVehicle) UnmarshalJSON(data []byte) error {
 	x := encodedTransitLineVehicle{}
-	err := json.Unmarshal(data, &x)
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
-	*transitLineVehicle = TransitLineVehicle(x.safeTransitLineVehicle)
+	*transitLineVehicle, err = x.transitLineVehicle()
 
-	transitLineVehicle.Icon, err = url.Parse(x.EncIcon)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 // MarshalJSON implements json.Marshaler for TransitLineVehicle. This encodes
@@ -267,6 +460,9 @@ func (transitLineVehicle *TransitLineVehicle) MarshalJSON() ([]byte, error) {
 	x.safeTransitLineVehicle = safeTransitLineVehicle(*transitLineVehicle)
 
 	x.EncIcon = transitLineVehicle.Icon.String()
+	if transitLineVehicle.LocalIcon != nil {
+		x.EncLocalIcon = transitLineVehicle.LocalIcon.String()
+	}
 
 	return json.Marshal(x)
 }
@@ -287,7 +483,7 @@ type encodedDistanceMatrixElement struct {
 // decodes the API representation into types useful for Go developers.
 func (dme *DistanceMatrixElement) UnmarshalJSON(data []byte) error {
 	x := encodedDistanceMatrixElement{}
-	err := json.Unmarshal(data, &x)
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
@@ -326,7 +522,7 @@ type encodedSnappedPoint struct {
 // API representation into types useful for Go developers.
 func (sp *SnappedPoint) UnmarshalJSON(data []byte) error {
 	x := encodedSnappedPoint{}
-	err := json.Unmarshal(data, &x)
+	err := unmarshalJSON(data, &x)
 	if err != nil {
 		return err
 	}
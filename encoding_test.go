@@ -16,6 +16,7 @@ package maps
 
 import (
 	"encoding/json"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -71,3 +72,97 @@ func TestDistanceMatrixElement_MarshalJSON(t *testing.T) {
 		t.Errorf("expected equal DistanceMatrixElement, was %+v expected %+v", out, dme)
 	}
 }
+
+func TestStepTransitDetailsUnmarshalJSON(t *testing.T) {
+	data := `{
+		"duration" : { "text" : "6 mins", "value" : 360 },
+		"steps" : [ { "duration" : { "text" : "1 min", "value" : 60 }, "travel_mode" : "WALKING" } ],
+		"transit_details" : {
+			"arrival_time" : { "text" : "6:27pm", "time_zone" : "Australia/Sydney", "value" : 1425022047 },
+			"headsign" : "Central",
+			"line" : {
+				"agencies" : [ { "name" : "Sydney Buses", "url" : "http://www.sydneybuses.info/" } ],
+				"icon" : "//maps.gstatic.com/mapfiles/transit/iw2/6/bus.png",
+				"short_name" : "431",
+				"vehicle" : { "icon" : "//maps.gstatic.com/mapfiles/transit/iw2/6/bus.png", "name" : "Bus", "type" : "BUS" }
+			},
+			"num_stops" : 4
+		},
+		"travel_mode" : "TRANSIT"
+	}`
+
+	var step Step
+	if err := json.Unmarshal([]byte(data), &step); err != nil {
+		t.Fatalf("expected ok decode of Step, got: %v", err)
+	}
+
+	icon, _ := url.Parse("//maps.gstatic.com/mapfiles/transit/iw2/6/bus.png")
+	agencyURL, _ := url.Parse("http://www.sydneybuses.info/")
+	loc, _ := time.LoadLocation("Australia/Sydney")
+	expected := Step{
+		Duration:   6 * time.Minute,
+		Steps:      []*Step{{Duration: time.Minute, TravelMode: "WALKING"}},
+		TravelMode: "TRANSIT",
+		TransitDetails: &TransitDetails{
+			ArrivalTime: time.Unix(1425022047, 0).In(loc),
+			Headsign:    "Central",
+			NumStops:    4,
+			Line: TransitLine{
+				ShortName: "431",
+				Agencies:  []*TransitAgency{{Name: "Sydney Buses", URL: agencyURL}},
+				URL:       &url.URL{},
+				Icon:      icon,
+				Vehicle:   TransitLineVehicle{Name: "Bus", Type: "BUS", Icon: icon},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, step) {
+		t.Errorf("expected %+v, was %+v", expected, step)
+	}
+}
+
+func TestTransitDetailsStopCodesAndLocalIcon(t *testing.T) {
+	data := `{
+		"departure_stop" : { "location" : { "lat" : 35.68, "lng" : 139.77 }, "name" : "Tokyo", "stop_code" : "JY01", "stop_id" : "tokyo-1" },
+		"line" : {
+			"vehicle" : {
+				"icon" : "//maps.gstatic.com/mapfiles/transit/iw2/6/rail2.png",
+				"local_icon" : "//maps.gstatic.com/mapfiles/transit/iw2/6/jp-jr.png",
+				"name" : "Train",
+				"type" : "MAGLEV"
+			}
+		}
+	}`
+
+	var details TransitDetails
+	if err := json.Unmarshal([]byte(data), &details); err != nil {
+		t.Fatalf("expected ok decode of TransitDetails, got: %v", err)
+	}
+
+	stop := details.DepartureStop
+	if stop.StopCode != "JY01" || stop.StopID != "tokyo-1" {
+		t.Errorf("expected stop code JY01 and ID tokyo-1, was %+v", stop)
+	}
+	vehicle := details.Line.Vehicle
+	if vehicle.LocalIcon == nil || vehicle.LocalIcon.String() != "//maps.gstatic.com/mapfiles/transit/iw2/6/jp-jr.png" {
+		t.Errorf("unexpected local icon %v", vehicle.LocalIcon)
+	}
+	if vehicle.VehicleType() != VehicleTypeOther || vehicle.Type != "MAGLEV" {
+		t.Errorf("expected VehicleTypeOther with type MAGLEV, was %s with type %s", vehicle.VehicleType(), vehicle.Type)
+	}
+	if v := (&TransitLineVehicle{Type: "BUS"}); v.VehicleType() != VehicleTypeBus {
+		t.Errorf("expected %s, was %s", VehicleTypeBus, v.VehicleType())
+	}
+
+	encoded, err := json.Marshal(&vehicle)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var decoded TransitLineVehicle
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(vehicle, decoded) {
+		t.Errorf("expected %+v, was %+v", vehicle, decoded)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	// EnvAPIKey is the API key.
+	EnvAPIKey = "GOOGLE_MAPS_API_KEY"
+	// EnvClientID is the Maps for Work client ID.
+	EnvClientID = "GOOGLE_MAPS_CLIENT_ID"
+	// EnvSignature is the URL modified Base64 encoded signing secret, used with
+	// either the client ID or the API key.
+	EnvSignature = "GOOGLE_MAPS_SIGNATURE"
+	// EnvRateLimit is the rate limit in requests per second.
+	EnvRateLimit = "GOOGLE_MAPS_RATE_LIMIT"
+	// EnvChannel is the channel.
+	EnvChannel = "GOOGLE_MAPS_CHANNEL"
+	// EnvBaseURL overrides the base URL of every API.
+	EnvBaseURL = "GOOGLE_MAPS_BASE_URL"
+	// EnvLanguage is the default language of requests.
+	EnvLanguage = "GOOGLE_MAPS_LANGUAGE"
+	// EnvRegion is the default region of requests.
+	EnvRegion = "GOOGLE_MAPS_REGION"
+)
+
+// NewClientFromEnv constructs a new Client configured from the environment
+// variables EnvAPIKey, EnvClientID, EnvSignature, EnvRateLimit, EnvChannel,
+// EnvBaseURL, EnvLanguage, and EnvRegion. Unset variables are ignored. The
+// options are applied after the environment, so they take precedence.
+func NewClientFromEnv(options ...ClientOption) (*Client, error) {
+	return newClientFromEnv(os.Getenv, options...)
+}
+
+func newClientFromEnv(getenv func(string) string, options ...ClientOption) (*Client, error) {
+	var env []ClientOption
+
+	apiKey, clientID, signature := getenv(EnvAPIKey), getenv(EnvClientID), getenv(EnvSignature)
+	switch {
+	case clientID != "":
+		env = append(env, WithClientIDAndSignature(clientID, signature))
+		if apiKey != "" {
+			env = append(env, WithAPIKey(apiKey))
+		}
+	case apiKey != "" && signature != "":
+		env = append(env, WithAPIKeyAndSignature(apiKey, signature))
+	case apiKey != "":
+		env = append(env, WithAPIKey(apiKey))
+	}
+
+	if v := getenv(EnvRateLimit); v != "" {
+		rateLimit, err := strconv.Atoi(v)
+		if err != nil || rateLimit < 0 {
+			return nil, fmt.Errorf("maps: invalid %s %q", EnvRateLimit, v)
+		}
+		env = append(env, WithRateLimit(rateLimit))
+	}
+	if v := getenv(EnvChannel); v != "" {
+		env = append(env, WithChannel(v))
+	}
+	if v := getenv(EnvBaseURL); v != "" {
+		env = append(env, WithBaseURL(v))
+	}
+	if v := getenv(EnvLanguage); v != "" {
+		env = append(env, WithDefaultLanguage(v))
+	}
+	if v := getenv(EnvRegion); v != "" {
+		env = append(env, WithDefaultRegion(v))
+	}
+
+	return NewClient(append(env, options...)...)
+}
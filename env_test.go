@@ -0,0 +1,84 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mapEnv(env map[string]string) func(string) string {
+	return func(key string) string {
+		return env[key]
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	c, err := newClientFromEnv(mapEnv(map[string]string{
+		EnvAPIKey:    apiKey,
+		EnvRateLimit: "10",
+		EnvChannel:   "checkout",
+		EnvBaseURL:   "https://maps.example.com",
+		EnvLanguage:  "fr",
+		EnvRegion:    "ca",
+	}))
+	if err != nil {
+		t.Fatalf("newClientFromEnv returned error: %v", err)
+	}
+	assert.Equal(t, apiKey, c.apiKey)
+	assert.Equal(t, 10, c.requestsPerSecond)
+	assert.Equal(t, "checkout", c.channel)
+	assert.Equal(t, "https://maps.example.com", c.baseURL)
+	assert.Equal(t, "fr", c.defaultLanguage)
+	assert.Equal(t, "ca", c.defaultRegion)
+}
+
+func TestNewClientFromEnvClientID(t *testing.T) {
+	c, err := newClientFromEnv(mapEnv(map[string]string{
+		EnvClientID:  "gme-client",
+		EnvSignature: "c2lnbmF0dXJl",
+	}))
+	if err != nil {
+		t.Fatalf("newClientFromEnv returned error: %v", err)
+	}
+	assert.Equal(t, "gme-client", c.clientID)
+	assert.Equal(t, []byte("signature"), c.signature)
+}
+
+func TestNewClientFromEnvOptionsTakePrecedence(t *testing.T) {
+	c, err := newClientFromEnv(mapEnv(map[string]string{
+		EnvAPIKey:   apiKey,
+		EnvLanguage: "fr",
+	}), WithDefaultLanguage("de"))
+	if err != nil {
+		t.Fatalf("newClientFromEnv returned error: %v", err)
+	}
+	assert.Equal(t, "de", c.defaultLanguage)
+}
+
+func TestNewClientFromEnvErrors(t *testing.T) {
+	tests := []map[string]string{
+		{},
+		{EnvAPIKey: apiKey, EnvRateLimit: "fast"},
+		{EnvAPIKey: apiKey, EnvRateLimit: "-1"},
+		{EnvClientID: "gme-client"},
+	}
+	for _, env := range tests {
+		if _, err := newClientFromEnv(mapEnv(env)); err == nil {
+			t.Errorf("%v: expected error", env)
+		}
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
@@ -35,16 +36,16 @@ var (
 	signature                = flag.String("signature", "", "Signature for Maps for Work API access.")
 	origin                   = flag.String("origin", "", "The address or textual latitude/longitude value from which you wish to calculate directions.")
 	destination              = flag.String("destination", "", "The address or textual latitude/longitude value from which you wish to calculate directions.")
-	mode                     = flag.String("mode", "", "The travel mode for this directions request.")
+	mode                     = mapsflag.Mode(flag.CommandLine, "mode", "The travel mode for this directions request.")
 	departureTime            = flag.String("departure_time", "", "The depature time for transit mode directions request.")
 	arrivalTime              = flag.String("arrival_time", "", "The arrival time for transit mode directions request.")
 	waypoints                = flag.String("waypoints", "", "The waypoints for driving directions request, | separated.")
 	alternatives             = flag.Bool("alternatives", false, "Whether the Directions service may provide more than one route alternative in the response.")
-	avoid                    = flag.String("avoid", "", "Indicates that the calculated route(s) should avoid the indicated features, | separated.")
+	avoid                    = mapsflag.Avoids(flag.CommandLine, "avoid", "Indicates that the calculated route(s) should avoid the indicated features, | separated.")
 	language                 = flag.String("language", "", "Specifies the language in which to return results.")
-	units                    = flag.String("units", "", "Specifies the unit system to use when returning results.")
+	units                    = mapsflag.Units(flag.CommandLine, "units", "Specifies the unit system to use when returning results.")
 	region                   = flag.String("region", "", "Specifies the region code, specified as a ccTLD (\"top-level domain\") two-character value.")
-	transitMode              = flag.String("transit_mode", "", "Specifies one or more preferred modes of transit, | separated. This parameter may only be specified for transit directions.")
+	transitMode              = mapsflag.TransitModes(flag.CommandLine, "transit_mode", "Specifies one or more preferred modes of transit, | separated. This parameter may only be specified for transit directions.")
 	transitRoutingPreference = flag.String("transit_routing_preference", "", "Specifies preferences for transit routes.")
 	iterations               = flag.Int("iterations", 1, "Number of times to make API request.")
 	trafficModel             = flag.String("traffic_model", "", "Specifies traffic prediction model when request future directions. Valid values are optimistic, best_guess, and pessimistic. Optional.")
@@ -85,10 +86,12 @@ func main() {
 		Alternatives:  *alternatives,
 		Language:      *language,
 		Region:        *region,
+		Mode:          *mode,
+		Units:         *units,
+		Avoid:         *avoid,
+		TransitMode:   *transitMode,
 	}
 
-	lookupMode(*mode, r)
-	lookupUnits(*units, r)
 	lookupTransitRoutingPreference(*transitRoutingPreference, r)
 	lookupTrafficModel(*trafficModel, r)
 
@@ -96,37 +99,6 @@ func main() {
 		r.Waypoints = strings.Split(*waypoints, "|")
 	}
 
-	if *avoid != "" {
-		for _, a := range strings.Split(*avoid, "|") {
-			switch a {
-			case "tolls":
-				r.Avoid = append(r.Avoid, maps.AvoidTolls)
-			case "highways":
-				r.Avoid = append(r.Avoid, maps.AvoidHighways)
-			case "ferries":
-				r.Avoid = append(r.Avoid, maps.AvoidFerries)
-			default:
-				log.Fatalf("Unknown avoid restriction %s", a)
-			}
-		}
-	}
-	if *transitMode != "" {
-		for _, t := range strings.Split(*transitMode, "|") {
-			switch t {
-			case "bus":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeBus)
-			case "subway":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeSubway)
-			case "train":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeTrain)
-			case "tram":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeTram)
-			case "rail":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeRail)
-			}
-		}
-	}
-
 	if *iterations == 1 {
 		routes, waypoints, err := client.Directions(context.Background(), r)
 		check(err)
@@ -162,36 +134,6 @@ type iterationResult struct {
 	err    error
 }
 
-func lookupMode(mode string, r *maps.DirectionsRequest) {
-	switch mode {
-	case "driving":
-		r.Mode = maps.TravelModeDriving
-	case "walking":
-		r.Mode = maps.TravelModeWalking
-	case "bicycling":
-		r.Mode = maps.TravelModeBicycling
-	case "transit":
-		r.Mode = maps.TravelModeTransit
-	case "":
-		// ignore
-	default:
-		log.Fatalf("Unknown mode '%s'", mode)
-	}
-}
-
-func lookupUnits(units string, r *maps.DirectionsRequest) {
-	switch units {
-	case "metric":
-		r.Units = maps.UnitsMetric
-	case "imperial":
-		r.Units = maps.UnitsImperial
-	case "":
-		// ignore
-	default:
-		log.Fatalf("Unknown units '%s'", units)
-	}
-}
-
 func lookupTransitRoutingPreference(transitRoutingPreference string, r *maps.DirectionsRequest) {
 	switch transitRoutingPreference {
 	case "fewer_transfers":
@@ -26,6 +26,7 @@ import (
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
@@ -34,13 +35,13 @@ var (
 	signature                = flag.String("signature", "", "Signature for Maps for Work API access.")
 	origins                  = flag.String("origins", "", "One or more addresses and/or textual latitude/longitude values, separated with the pipe (|) character, from which to calculate distance and time.")
 	destinations             = flag.String("destinations", "", "One or more addresses and/or textual latitude/longitude values, separated with the pipe (|) character, to which to calculate distance and time.")
-	mode                     = flag.String("mode", "", "Specifies the mode of transport to use when calculating distance.")
+	mode                     = mapsflag.Mode(flag.CommandLine, "mode", "Specifies the mode of transport to use when calculating distance.")
 	language                 = flag.String("language", "", "The language in which to return results.")
-	avoid                    = flag.String("avoid", "", "Introduces restrictions to the route.")
-	units                    = flag.String("units", "", "Specifies the unit system to use when expressing distance as text.")
+	avoid                    = mapsflag.Avoid(flag.CommandLine, "avoid", "Introduces restrictions to the route.")
+	units                    = mapsflag.Units(flag.CommandLine, "units", "Specifies the unit system to use when expressing distance as text.")
 	departureTime            = flag.String("departure_time", "", "The desired time of departure.")
 	arrivalTime              = flag.String("arrival_time", "", "Specifies the desired time of arrival.")
-	transitMode              = flag.String("transit_mode", "", "Specifies one or more preferred modes of transit.")
+	transitMode              = mapsflag.TransitModes(flag.CommandLine, "transit_mode", "Specifies one or more preferred modes of transit, | separated.")
 	transitRoutingPreference = flag.String("transit_routing_preference", "", "Specifies preferences for transit requests.")
 	trafficModel             = flag.String("traffic_model", "", "Specifies the assumptions to use when calculating time in traffic.")
 )
@@ -76,6 +77,10 @@ func main() {
 		Language:      *language,
 		DepartureTime: *departureTime,
 		ArrivalTime:   *arrivalTime,
+		Mode:          *mode,
+		Avoid:         *avoid,
+		Units:         *units,
+		TransitMode:   *transitMode,
 	}
 
 	if *origins != "" {
@@ -85,10 +90,6 @@ func main() {
 		r.Destinations = strings.Split(*destinations, "|")
 	}
 
-	lookupMode(*mode, r)
-	lookupAvoid(*avoid, r)
-	lookupUnits(*units, r)
-	lookupTransitMode(*transitMode, r)
 	lookupTransitRoutingPreference(*transitRoutingPreference, r)
 	lookupTrafficModel(*trafficModel, r)
 
@@ -98,72 +99,6 @@ func main() {
 	pretty.Println(resp)
 }
 
-func lookupMode(mode string, r *maps.DistanceMatrixRequest) {
-	switch mode {
-	case "driving":
-		r.Mode = maps.TravelModeDriving
-	case "walking":
-		r.Mode = maps.TravelModeWalking
-	case "bicycling":
-		r.Mode = maps.TravelModeBicycling
-	case "transit":
-		r.Mode = maps.TravelModeTransit
-	case "":
-		// ignore
-	default:
-		log.Fatalf("Unknown mode %s", mode)
-	}
-}
-
-func lookupAvoid(avoid string, r *maps.DistanceMatrixRequest) {
-	switch avoid {
-	case "tolls":
-		r.Avoid = maps.AvoidTolls
-	case "highways":
-		r.Avoid = maps.AvoidHighways
-	case "ferries":
-		r.Avoid = maps.AvoidFerries
-	case "":
-		// ignore
-	default:
-		log.Fatalf("Unknown avoid restriction %s", avoid)
-	}
-}
-
-func lookupUnits(units string, r *maps.DistanceMatrixRequest) {
-	switch units {
-	case "metric":
-		r.Units = maps.UnitsMetric
-	case "imperial":
-		r.Units = maps.UnitsImperial
-	case "":
-		// ignore
-	default:
-		log.Fatalf("Unknown units %s", units)
-	}
-}
-
-func lookupTransitMode(transitMode string, r *maps.DistanceMatrixRequest) {
-	if transitMode != "" {
-		for _, m := range strings.Split(transitMode, "|") {
-			switch m {
-			case "bus":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeBus)
-			case "subway":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeSubway)
-			case "train":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeTrain)
-			case "tram":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeTram)
-			case "rail":
-				r.TransitMode = append(r.TransitMode, maps.TransitModeRail)
-			default:
-				log.Fatalf("Unknown transit_mode %s", m)
-			}
-		}
-	}
-}
-
 func lookupTransitRoutingPreference(transitRoutingPreference string, r *maps.DistanceMatrixRequest) {
 	switch transitRoutingPreference {
 	case "fewer_transfers":
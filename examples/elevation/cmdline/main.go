@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
@@ -71,14 +70,17 @@ func main() {
 	}
 
 	if *locations != "" {
-		l, err := decodeLocations(*locations)
+		l, err := maps.ParseLocationsArg(*locations)
 		check(err)
 		r.Locations = l
 	}
 
 	if *path != "" {
-		p, err := decodePath(*path)
+		p, err := maps.ParseLocationsArg(*path)
 		check(err)
+		if len(p) < 2 {
+			usageAndExit("path must have at least two locations")
+		}
 		r.Path = p
 	}
 
@@ -89,42 +91,3 @@ func main() {
 
 	pretty.Println(resp)
 }
-
-// decodeLocations takes a location argument string and decodes it.
-// This argument has three different forms, as per documentation at
-// https://developers.google.com/maps/documentation/elevation/#Locations
-func decodeLocations(location string) ([]maps.LatLng, error) {
-	if strings.HasPrefix(location, "enc:") {
-		return maps.DecodePolyline(location[len("enc:"):])
-	}
-
-	if strings.Contains(location, "|") {
-		return maps.ParseLatLngList(location)
-	}
-
-	// single location
-	ll, err := maps.ParseLatLng(location)
-	check(err)
-	return []maps.LatLng{ll}, nil
-}
-
-// decodePath takes a location argument string and decodes it.
-// This argument has two different forms, as per documentation at
-// https://developers.google.com/maps/documentation/elevation/#Paths
-func decodePath(path string) ([]maps.LatLng, error) {
-	if strings.HasPrefix(path, "enc:") {
-		return maps.DecodePolyline(path[len("enc:"):])
-	}
-	result := []maps.LatLng{}
-	if strings.Contains(path, "|") {
-		// | delimited list of locations
-		ls := strings.Split(path, "|")
-		for _, l := range ls {
-			ll, err := maps.ParseLatLng(l)
-			check(err)
-			result = append(result, ll)
-		}
-		return result, nil
-	}
-	return result, fmt.Errorf("Invalid Path argument: '%s'", path)
-}
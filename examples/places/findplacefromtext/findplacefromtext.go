@@ -21,17 +21,17 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
 	apiKey       = flag.String("key", "", "API Key for using Google Maps API.")
 	input        = flag.String("input", "", "The text input specifying which place to search for (for example, a name, address, or phone number).")
 	inputType    = flag.String("inputtype", "", "The type of input. This can be one of either textquery or phonenumber.")
-	fields       = flag.String("fields", "", "Comma seperated list of Fields")
+	fields       = mapsflag.PlaceSearchFields(flag.CommandLine, "fields", "Comma seperated list of Fields")
 	locationbias = flag.String("locationbias", "", "Location bias for this request. Optional. One of ipbias, point, circle, or rectangle.")
 	point        = flag.String("point", "", "The latitude/longitude for location bias point. This must be specified as latitude,longitude.")
 	center       = flag.String("center", "", "The center latitude/longitude for location bias circle. This must be specified as latitude,longitude.")
@@ -72,32 +72,28 @@ func main() {
 	if *locationbias != "" {
 		lb, err := maps.ParseFindPlaceFromTextLocationBiasType(*locationbias)
 		check(err)
-		r.LocationBias = lb
+		r.Bias = &maps.LocationBias{}
 		switch lb {
+		case maps.FindPlaceFromTextLocationBiasIP:
+			r.Bias.IP = true
 		case maps.FindPlaceFromTextLocationBiasPoint:
 			l, err := maps.ParseLatLng(*point)
 			check(err)
-			r.LocationBiasPoint = &l
+			r.Bias.Point = &l
 		case maps.FindPlaceFromTextLocationBiasCircular:
 			l, err := maps.ParseLatLng(*center)
 			check(err)
-			r.LocationBiasCenter = &l
-			r.LocationBiasRadius = *radius
+			r.Bias.Circle = &maps.Circle{Center: l, Radius: float64(*radius)}
 		case maps.FindPlaceFromTextLocationBiasRectangular:
 			sw, err := maps.ParseLatLng(*southwest)
 			check(err)
-			r.LocationBiasSouthWest = &sw
 			ne, err := maps.ParseLatLng(*northeast)
 			check(err)
-			r.LocationBiasNorthEast = &ne
+			r.Bias.Rectangle = &maps.LatLngBounds{SouthWest: sw, NorthEast: ne}
 		}
 	}
 
-	if *fields != "" {
-		f, err := parseFields(*fields)
-		check(err)
-		r.Fields = f
-	}
+	r.Fields = *fields
 
 	resp, err := client.FindPlaceFromText(context.Background(), r)
 	check(err)
@@ -105,18 +101,6 @@ func main() {
 	pretty.Println(resp)
 }
 
-func parseFields(fields string) ([]maps.PlaceSearchFieldMask, error) {
-	var res []maps.PlaceSearchFieldMask
-	for _, s := range strings.Split(fields, ",") {
-		f, err := maps.ParsePlaceSearchFieldMask(s)
-		if err != nil {
-			return nil, err
-		}
-		res = append(res, f)
-	}
-	return res, nil
-}
-
 func parseInputType(inputType string) maps.FindPlaceFromTextInputType {
 	var it maps.FindPlaceFromTextInputType
 	switch inputType {
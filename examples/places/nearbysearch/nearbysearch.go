@@ -25,6 +25,7 @@ import (
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
@@ -40,7 +41,7 @@ var (
 	name      = flag.String("name", "", "One or more terms to be matched against the names of places, separated with a space character.")
 	openNow   = flag.Bool("open_now", false, "Restricts results to only those places that are open for business at the time the query is sent.")
 	rankBy    = flag.String("rankby", "", "Specifies the order in which results are listed. Valid values are prominence or distance.")
-	placeType = flag.String("type", "", "Restricts the results to places matching the specified type.")
+	placeType = mapsflag.PlaceType(flag.CommandLine, "type", "Restricts the results to places matching the specified type.")
 	pageToken = flag.String("pagetoken", "", "Set to retrieve the next page of results.")
 )
 
@@ -78,12 +79,12 @@ func main() {
 		Name:      *name,
 		OpenNow:   *openNow,
 		PageToken: *pageToken,
+		Type:      *placeType,
 	}
 
 	parseLocation(*location, r)
 	parsePriceLevels(*minPrice, *maxPrice, r)
 	parseRankBy(*rankBy, r)
-	parsePlaceType(*placeType, r)
 
 	resp, err := client.NearbySearch(context.Background(), r)
 	check(err)
@@ -128,27 +129,11 @@ func parsePriceLevels(minPrice string, maxPrice string, r *maps.NearbySearchRequ
 }
 
 func parseRankBy(rankBy string, r *maps.NearbySearchRequest) {
-	switch rankBy {
-	case "prominence":
-		r.RankBy = maps.RankByProminence
-		return
-	case "distance":
-		r.RankBy = maps.RankByDistance
-		return
-	case "":
-		return
-	default:
-		usageAndExit(fmt.Sprintf("Unknown rank by: \"%v\"", rankBy))
-	}
-}
-
-func parsePlaceType(placeType string, r *maps.NearbySearchRequest) {
-	if placeType != "" {
-		t, err := maps.ParsePlaceType(placeType)
+	if rankBy != "" {
+		rb, err := maps.ParseRankBy(rankBy)
 		if err != nil {
-			usageAndExit(fmt.Sprintf("Unknown place type \"%v\"", placeType))
+			usageAndExit(err.Error())
 		}
-
-		r.Type = t
+		r.RankBy = rb
 	}
 }
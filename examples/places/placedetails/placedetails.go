@@ -22,10 +22,10 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
@@ -33,7 +33,7 @@ var (
 	clientID  = flag.String("client_id", "", "ClientID for Maps for Work API access.")
 	signature = flag.String("signature", "", "Signature for Maps for Work API access.")
 	placeID   = flag.String("place_id", "", "Textual identifier that uniquely identifies a place.")
-	fields    = flag.String("fields", "", "Comma seperated list of Fields")
+	fields    = mapsflag.PlaceDetailsFields(flag.CommandLine, "fields", "Comma seperated list of Fields")
 )
 
 func usageAndExit(msg string) {
@@ -65,12 +65,7 @@ func main() {
 
 	r := &maps.PlaceDetailsRequest{
 		PlaceID: *placeID,
-	}
-
-	if *fields != "" {
-		f, err := parseFields(*fields)
-		check(err)
-		r.Fields = f
+		Fields:  *fields,
 	}
 
 	resp, err := client.PlaceDetails(context.Background(), r)
@@ -78,15 +73,3 @@ func main() {
 
 	pretty.Println(resp)
 }
-
-func parseFields(fields string) ([]maps.PlaceDetailsFieldMask, error) {
-	var res []maps.PlaceDetailsFieldMask
-	for _, s := range strings.Split(fields, ",") {
-		f, err := maps.ParsePlaceDetailsFieldMask(s)
-		if err != nil {
-			return nil, err
-		}
-		res = append(res, f)
-	}
-	return res, nil
-}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/kr/pretty"
 	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/mapsflag"
 )
 
 var (
@@ -38,7 +39,7 @@ var (
 	minprice  = flag.String("min_price", "", "Restricts results to only those places within the specified price level.")
 	maxprice  = flag.String("max_price", "", "Restricts results to only those places within the specified price level.")
 	opennow   = flag.Bool("open_now", false, "Restricts results to only those places that are open for business at the time the query is sent.")
-	placeType = flag.String("type", "", "Restricts the results to places matching the specified type.")
+	placeType = mapsflag.PlaceType(flag.CommandLine, "type", "Restricts the results to places matching the specified type.")
 )
 
 func usageAndExit(msg string) {
@@ -73,11 +74,11 @@ func main() {
 		Language: *language,
 		Radius:   *radius,
 		OpenNow:  *opennow,
+		Type:     *placeType,
 	}
 
 	parseLocation(*location, r)
 	parsePriceLevels(*minprice, *maxprice, r)
-	parsePlaceType(*placeType, r)
 
 	resp, err := client.TextSearch(context.Background(), r)
 	check(err)
@@ -120,14 +121,3 @@ func parsePriceLevels(minprice string, maxprice string, r *maps.TextSearchReques
 		r.MaxPrice = parsePriceLevel(minprice)
 	}
 }
-
-func parsePlaceType(placeType string, r *maps.TextSearchRequest) {
-	if placeType != "" {
-		t, err := maps.ParsePlaceType(placeType)
-		if err != nil {
-			usageAndExit(fmt.Sprintf("Unknown place type \"%v\"", placeType))
-		}
-
-		r.Type = t
-	}
-}
@@ -59,11 +59,11 @@ func main() {
 	}
 	r := &maps.SpeedLimitsRequest{}
 
-	if *units == "KPH" {
-		r.Units = maps.SpeedLimitKPH
-	}
-	if *units == "MPH" {
-		r.Units = maps.SpeedLimitMPH
+	if *units != "" {
+		r.Units, err = maps.ParseSpeedLimitUnit(*units)
+		if err != nil {
+			usageAndExit(err.Error())
+		}
 	}
 
 	if *path == "" && *placeIDs == "" {
@@ -40,6 +40,7 @@ var (
 	mapid     = flag.String("mapid", "", "MapId defines the mapid to use.")
 	language  = flag.String("language", "", "Language defines the language to use for display of labels on map tiles.")
 	region    = flag.String("region", "", "Region the appropriate borders to display, based on geo-political sensitivities.")
+	path      = flag.String("path", "", "Path to draw on the map, as | separated locations or an enc: prefixed encoded polyline.")
 )
 
 func usageAndExit(msg string) {
@@ -85,6 +86,12 @@ func main() {
 		MapId:    *mapid,
 	}
 
+	if *path != "" {
+		p, err := maps.ParseLocationsArg(*path)
+		check(err)
+		r.Paths = []maps.Path{{Location: p}}
+	}
+
 	resp, err := client.StaticMap(context.Background(), r)
 	check(err)
 
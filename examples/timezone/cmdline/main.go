@@ -83,9 +83,12 @@ func main() {
 
 func parseLocation(location string, r *maps.TimezoneRequest) {
 	if location != "" {
-		l, err := maps.ParseLatLng(location)
+		l, err := maps.ParseLocationsArg(location)
 		check(err)
-		r.Location = &l
+		if len(l) != 1 {
+			usageAndExit("location must be a single location")
+		}
+		r.Location = &l[0]
 	} else {
 		usageAndExit("location is required")
 	}
@@ -0,0 +1,335 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The record types in this file are flattened versions of API results for
+// persisting in data warehouses. Each record is a single row without nested
+// structs, with snake_case JSON names, so that newline delimited JSON of
+// records can be loaded into tables such as BigQuery's directly. Absent
+// timestamps are encoded as null.
+
+// LegRecord is a flattened Directions API Leg.
+type LegRecord struct {
+	RouteIndex               int        `json:"route_index"`
+	RouteSummary             string     `json:"route_summary"`
+	LegIndex                 int        `json:"leg_index"`
+	StartAddress             string     `json:"start_address"`
+	StartLat                 float64    `json:"start_lat"`
+	StartLng                 float64    `json:"start_lng"`
+	EndAddress               string     `json:"end_address"`
+	EndLat                   float64    `json:"end_lat"`
+	EndLng                   float64    `json:"end_lng"`
+	DistanceMeters           int        `json:"distance_meters"`
+	DurationSeconds          float64    `json:"duration_seconds"`
+	DurationInTrafficSeconds float64    `json:"duration_in_traffic_seconds"`
+	DepartureTime            *time.Time `json:"departure_time"`
+	ArrivalTime              *time.Time `json:"arrival_time"`
+	StepCount                int        `json:"step_count"`
+}
+
+// LegRecords returns a record for each leg of routes. It decodes the steps of
+// legs requested with DirectionsRequest.LazySteps to count them.
+func LegRecords(routes []Route) []LegRecord {
+	var records []LegRecord
+	for i, route := range routes {
+		for j, leg := range route.Legs {
+			steps, _ := leg.DecodeSteps()
+			records = append(records, LegRecord{
+				RouteIndex:               i,
+				RouteSummary:             route.Summary,
+				LegIndex:                 j,
+				StartAddress:             leg.StartAddress,
+				StartLat:                 leg.StartLocation.Lat,
+				StartLng:                 leg.StartLocation.Lng,
+				EndAddress:               leg.EndAddress,
+				EndLat:                   leg.EndLocation.Lat,
+				EndLng:                   leg.EndLocation.Lng,
+				DistanceMeters:           leg.Meters,
+				DurationSeconds:          leg.Duration.Seconds(),
+				DurationInTrafficSeconds: leg.DurationInTraffic.Seconds(),
+				DepartureTime:            recordTime(leg.DepartureTime),
+				ArrivalTime:              recordTime(leg.ArrivalTime),
+				StepCount:                len(steps),
+			})
+		}
+	}
+	return records
+}
+
+// GeocodingRecord is a flattened Geocoding API result. The commonly queried
+// address components are extracted into columns.
+type GeocodingRecord struct {
+	PlaceID                  string   `json:"place_id"`
+	FormattedAddress         string   `json:"formatted_address"`
+	Lat                      float64  `json:"lat"`
+	Lng                      float64  `json:"lng"`
+	LocationType             string   `json:"location_type"`
+	Types                    []string `json:"types"`
+	PartialMatch             bool     `json:"partial_match"`
+	StreetNumber             string   `json:"street_number"`
+	Route                    string   `json:"route"`
+	Locality                 string   `json:"locality"`
+	AdministrativeAreaLevel1 string   `json:"administrative_area_level_1"`
+	PostalCode               string   `json:"postal_code"`
+	Country                  string   `json:"country"`
+}
+
+// GeocodingRecords returns a record for each of results.
+func GeocodingRecords(results []GeocodingResult) []GeocodingRecord {
+	records := make([]GeocodingRecord, len(results))
+	for i, r := range results {
+		records[i] = GeocodingRecord{
+			PlaceID:          r.PlaceID,
+			FormattedAddress: r.FormattedAddress,
+			Lat:              r.Geometry.Location.Lat,
+			Lng:              r.Geometry.Location.Lng,
+			LocationType:     r.Geometry.LocationType,
+			Types:            r.Types,
+			PartialMatch:     r.PartialMatch,
+		}
+		for _, c := range r.AddressComponents {
+			for _, t := range c.Types {
+				switch t {
+				case "street_number":
+					records[i].StreetNumber = c.LongName
+				case "route":
+					records[i].Route = c.LongName
+				case "locality":
+					records[i].Locality = c.LongName
+				case "administrative_area_level_1":
+					records[i].AdministrativeAreaLevel1 = c.ShortName
+				case "postal_code":
+					records[i].PostalCode = c.LongName
+				case "country":
+					records[i].Country = c.ShortName
+				}
+			}
+		}
+	}
+	return records
+}
+
+// PlaceRecord is a flattened Places API search or details result.
+type PlaceRecord struct {
+	PlaceID          string     `json:"place_id"`
+	Name             string     `json:"name"`
+	FormattedAddress string     `json:"formatted_address"`
+	Vicinity         string     `json:"vicinity"`
+	Lat              float64    `json:"lat"`
+	Lng              float64    `json:"lng"`
+	Types            []string   `json:"types"`
+	BusinessStatus   string     `json:"business_status"`
+	Rating           float32    `json:"rating"`
+	UserRatingsTotal int        `json:"user_ratings_total"`
+	PriceLevel       PriceLevel `json:"price_level"`
+}
+
+// PlacesSearchRecords returns a record for each of results.
+func PlacesSearchRecords(results []PlacesSearchResult) []PlaceRecord {
+	records := make([]PlaceRecord, len(results))
+	for i, r := range results {
+		records[i] = PlaceRecord{
+			PlaceID:          r.PlaceID,
+			Name:             r.Name,
+			FormattedAddress: r.FormattedAddress,
+			Vicinity:         r.Vicinity,
+			Lat:              r.Geometry.Location.Lat,
+			Lng:              r.Geometry.Location.Lng,
+			Types:            r.Types,
+			BusinessStatus:   r.BusinessStatus,
+			Rating:           r.Rating,
+			UserRatingsTotal: r.UserRatingsTotal,
+			PriceLevel:       r.PriceLevel,
+		}
+	}
+	return records
+}
+
+// PlaceDetailsRecord returns a record for r.
+func PlaceDetailsRecord(r *PlaceDetailsResult) PlaceRecord {
+	return PlaceRecord{
+		PlaceID:          r.PlaceID,
+		Name:             r.Name,
+		FormattedAddress: r.FormattedAddress,
+		Vicinity:         r.Vicinity,
+		Lat:              r.Geometry.Location.Lat,
+		Lng:              r.Geometry.Location.Lng,
+		Types:            r.Types,
+		BusinessStatus:   r.Closure(),
+		Rating:           r.Rating,
+		UserRatingsTotal: r.UserRatingsTotal,
+		PriceLevel:       r.PriceLevel,
+	}
+}
+
+// PlaceRecordColumns are the columns ExportCSV writes by default, in order.
+// They are the JSON names of the PlaceRecord fields.
+var PlaceRecordColumns = []string{
+	"place_id", "name", "formatted_address", "vicinity", "lat", "lng",
+	"types", "business_status", "rating", "user_ratings_total", "price_level",
+}
+
+var placeRecordColumns = map[string]func(r *PlaceRecord) string{
+	"place_id":           func(r *PlaceRecord) string { return r.PlaceID },
+	"name":               func(r *PlaceRecord) string { return r.Name },
+	"formatted_address":  func(r *PlaceRecord) string { return r.FormattedAddress },
+	"vicinity":           func(r *PlaceRecord) string { return r.Vicinity },
+	"lat":                func(r *PlaceRecord) string { return strconv.FormatFloat(r.Lat, 'f', -1, 64) },
+	"lng":                func(r *PlaceRecord) string { return strconv.FormatFloat(r.Lng, 'f', -1, 64) },
+	"types":              func(r *PlaceRecord) string { return strings.Join(r.Types, ";") },
+	"business_status":    func(r *PlaceRecord) string { return r.BusinessStatus },
+	"rating":             func(r *PlaceRecord) string { return strconv.FormatFloat(float64(r.Rating), 'f', -1, 32) },
+	"user_ratings_total": func(r *PlaceRecord) string { return strconv.Itoa(r.UserRatingsTotal) },
+	"price_level":        func(r *PlaceRecord) string { return r.PriceLevel.String() },
+}
+
+// ExportCSV writes results to w as CSV, with a header row followed by a row
+// for each result. fields selects the columns from PlaceRecordColumns, in
+// order; if it is empty all of them are written. Types are joined with ";".
+// Values are quoted as needed, so addresses containing commas, quotes or
+// newlines are preserved.
+func ExportCSV(w io.Writer, results []PlacesSearchResult, fields []string) error {
+	if len(fields) == 0 {
+		fields = PlaceRecordColumns
+	}
+	columns := make([]func(r *PlaceRecord) string, len(fields))
+	for i, f := range fields {
+		column, ok := placeRecordColumns[f]
+		if !ok {
+			return fmt.Errorf("maps: unknown CSV column %q", f)
+		}
+		columns[i] = column
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(fields); err != nil {
+		return err
+	}
+	row := make([]string, len(columns))
+	for _, r := range PlacesSearchRecords(results) {
+		for i, column := range columns {
+			row[i] = column(&r)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// DistanceMatrixCSVColumns are the columns written by
+// DistanceMatrixResponse.ToCSV.
+var DistanceMatrixCSVColumns = []string{
+	"origin_index", "destination_index", "origin_address", "destination_address",
+	"status", "distance_meters", "duration_seconds", "duration_in_traffic_seconds",
+}
+
+// ToCSV writes r to w as CSV, with a header row of DistanceMatrixCSVColumns
+// followed by a row for each element, by origin then destination. The distance
+// and durations of elements whose status is not ElementStatusOK are empty.
+func (r *DistanceMatrixResponse) ToCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(DistanceMatrixCSVColumns); err != nil {
+		return err
+	}
+	row := make([]string, len(DistanceMatrixCSVColumns))
+	for i, elements := range r.Rows {
+		for j, e := range elements.Elements {
+			if e == nil {
+				continue
+			}
+			row[0], row[1] = strconv.Itoa(i), strconv.Itoa(j)
+			row[2], row[3] = indexOrEmpty(r.OriginAddresses, i), indexOrEmpty(r.DestinationAddresses, j)
+			row[4], row[5], row[6], row[7] = string(e.Status), "", "", ""
+			if e.Status == ElementStatusOK {
+				row[5] = strconv.Itoa(e.Distance.Meters)
+				row[6] = strconv.FormatFloat(e.Duration.Seconds(), 'f', -1, 64)
+				if e.DurationInTraffic > 0 {
+					row[7] = strconv.FormatFloat(e.DurationInTraffic.Seconds(), 'f', -1, 64)
+				}
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// AsMeters returns DistancesMatrix as floats, for use as the cost matrix of a
+// route optimization solver. Elements which DistancesMatrix marks -1, because
+// their status is not ElementStatusOK, are +Inf so that they are never chosen.
+func (r *DistanceMatrixResponse) AsMeters() [][]float64 {
+	distances := r.DistancesMatrix()
+	m := make([][]float64, len(distances))
+	for i, row := range distances {
+		m[i] = make([]float64, len(row))
+		for j, meters := range row {
+			m[i][j] = costOrInf(meters >= 0, float64(meters))
+		}
+	}
+	return m
+}
+
+// AsSeconds returns DurationsMatrix in seconds, for use as the cost matrix of a
+// route optimization solver. Elements which DurationsMatrix marks -1, because
+// their status is not ElementStatusOK, are +Inf so that they are never chosen.
+func (r *DistanceMatrixResponse) AsSeconds() [][]float64 {
+	durations := r.DurationsMatrix()
+	m := make([][]float64, len(durations))
+	for i, row := range durations {
+		m[i] = make([]float64, len(row))
+		for j, d := range row {
+			m[i][j] = costOrInf(d >= 0, d.Seconds())
+		}
+	}
+	return m
+}
+
+// costOrInf returns cost, or +Inf if there is no route.
+func costOrInf(ok bool, cost float64) float64 {
+	if !ok {
+		return math.Inf(1)
+	}
+	return cost
+}
+
+func indexOrEmpty(s []string, i int) string {
+	if i < len(s) {
+		return s[i]
+	}
+	return ""
+}
+
+// recordTime returns t in UTC, or nil if t is the zero time.
+func recordTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
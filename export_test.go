@@ -0,0 +1,229 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLegRecords(t *testing.T) {
+	sydney, _ := time.LoadLocation("Australia/Sydney")
+	departure := time.Date(2026, 1, 2, 9, 0, 0, 0, sydney)
+	routes := []Route{
+		{
+			Summary: "M4",
+			Legs: []*Leg{
+				{
+					Steps:         []*Step{{}, {}},
+					Distance:      Distance{HumanReadable: "23 km", Meters: 23000},
+					Duration:      90 * time.Second,
+					DepartureTime: departure,
+					StartLocation: LatLng{Lat: -33.8, Lng: 151.2},
+					EndLocation:   LatLng{Lat: -33.81, Lng: 151},
+					StartAddress:  "Sydney",
+					EndAddress:    "Parramatta",
+				},
+			},
+		},
+		{Summary: "Empty"},
+	}
+
+	records := LegRecords(routes)
+	departureUTC := departure.UTC()
+	expected := []LegRecord{
+		{
+			RouteSummary:    "M4",
+			StartAddress:    "Sydney",
+			StartLat:        -33.8,
+			StartLng:        151.2,
+			EndAddress:      "Parramatta",
+			EndLat:          -33.81,
+			EndLng:          151,
+			DistanceMeters:  23000,
+			DurationSeconds: 90,
+			DepartureTime:   &departureUTC,
+			StepCount:       2,
+		},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("expected %+v, was %+v", expected, records)
+	}
+
+	data, err := json.Marshal(records[0])
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var row map[string]interface{}
+	json.Unmarshal(data, &row)
+	if row["departure_time"] != "2026-01-01T22:00:00Z" || row["arrival_time"] != nil {
+		t.Errorf("unexpected times in %s", data)
+	}
+	for k, v := range row {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			t.Errorf("column %s is nested: %v", k, v)
+		}
+	}
+}
+
+func TestGeocodingRecords(t *testing.T) {
+	results := []GeocodingResult{
+		{
+			AddressComponents: []AddressComponent{
+				{LongName: "1600", ShortName: "1600", Types: []string{"street_number"}},
+				{LongName: "Amphitheatre Parkway", ShortName: "Amphitheatre Pkwy", Types: []string{"route"}},
+				{LongName: "Mountain View", ShortName: "Mountain View", Types: []string{"locality", "political"}},
+				{LongName: "California", ShortName: "CA", Types: []string{"administrative_area_level_1", "political"}},
+				{LongName: "United States", ShortName: "US", Types: []string{"country", "political"}},
+				{LongName: "94043", ShortName: "94043", Types: []string{"postal_code"}},
+			},
+			FormattedAddress: "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+			Geometry:         AddressGeometry{Location: LatLng{Lat: 37.42, Lng: -122.08}, LocationType: "ROOFTOP"},
+			Types:            []string{"street_address"},
+			PlaceID:          "ChIJ2eUgeAK6j4ARbn5u_wAGqWA",
+		},
+	}
+	expected := []GeocodingRecord{
+		{
+			PlaceID:                  "ChIJ2eUgeAK6j4ARbn5u_wAGqWA",
+			FormattedAddress:         "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+			Lat:                      37.42,
+			Lng:                      -122.08,
+			LocationType:             "ROOFTOP",
+			Types:                    []string{"street_address"},
+			StreetNumber:             "1600",
+			Route:                    "Amphitheatre Parkway",
+			Locality:                 "Mountain View",
+			AdministrativeAreaLevel1: "CA",
+			PostalCode:               "94043",
+			Country:                  "US",
+		},
+	}
+	if records := GeocodingRecords(results); !reflect.DeepEqual(records, expected) {
+		t.Errorf("expected %+v, was %+v", expected, records)
+	}
+}
+
+func TestPlaceRecords(t *testing.T) {
+	search := []PlacesSearchResult{
+		{
+			PlaceID:          "ChIJN1t_tDeuEmsRUsoyG83frY4",
+			Name:             "Google",
+			Vicinity:         "48 Pirrama Rd, Pyrmont",
+			Geometry:         AddressGeometry{Location: LatLng{Lat: -33.86, Lng: 151.19}},
+			Types:            []string{"point_of_interest"},
+			BusinessStatus:   BusinessStatusOperational,
+			Rating:           4.5,
+			UserRatingsTotal: 100,
+			PriceLevel:       PriceLevelModerate,
+		},
+	}
+	expected := PlaceRecord{
+		PlaceID:          "ChIJN1t_tDeuEmsRUsoyG83frY4",
+		Name:             "Google",
+		Vicinity:         "48 Pirrama Rd, Pyrmont",
+		Lat:              -33.86,
+		Lng:              151.19,
+		Types:            []string{"point_of_interest"},
+		BusinessStatus:   BusinessStatusOperational,
+		Rating:           4.5,
+		UserRatingsTotal: 100,
+		PriceLevel:       PriceLevelModerate,
+	}
+	if records := PlacesSearchRecords(search); !reflect.DeepEqual(records, []PlaceRecord{expected}) {
+		t.Errorf("expected %+v, was %+v", expected, records)
+	}
+
+	details := &PlaceDetailsResult{PlaceID: "ChIJN1t_tDeuEmsRUsoyG83frY4", PermanentlyClosed: true}
+	if record := PlaceDetailsRecord(details); record.BusinessStatus != BusinessStatusClosedPermanently {
+		t.Errorf("expected %s, was %s", BusinessStatusClosedPermanently, record.BusinessStatus)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	results := []PlacesSearchResult{
+		{
+			PlaceID:  "ChIJN1t_tDeuEmsRUsoyG83frY4",
+			Name:     `Google "Sydney"`,
+			Vicinity: "48 Pirrama Rd, Pyrmont",
+			Geometry: AddressGeometry{Location: LatLng{Lat: -33.86, Lng: 151.19}},
+			Types:    []string{"point_of_interest", "establishment"},
+			Rating:   4.5,
+		},
+		{Name: "Cafe"},
+	}
+
+	var buf bytes.Buffer
+	if err := ExportCSV(&buf, results, []string{"name", "vicinity", "lat", "types", "rating"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "name,vicinity,lat,types,rating\n" +
+		`"Google ""Sydney""","48 Pirrama Rd, Pyrmont",-33.86,point_of_interest;establishment,4.5` + "\n" +
+		"Cafe,,0,,0\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, was %q", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := ExportCSV(&buf, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if header := "place_id,name,formatted_address,vicinity,lat,lng,types,business_status,rating,user_ratings_total,price_level\n"; buf.String() != header {
+		t.Errorf("expected %q, was %q", header, buf.String())
+	}
+
+	if err := ExportCSV(&buf, results, []string{"name", "phone"}); err == nil {
+		t.Error("expected error for unknown column")
+	}
+}
+
+func testDistanceMatrixResponse() *DistanceMatrixResponse {
+	return &DistanceMatrixResponse{
+		OriginAddresses:      []string{"Sydney NSW, Australia"},
+		DestinationAddresses: []string{"Parramatta NSW, Australia", "Nowhere"},
+		Rows: []DistanceMatrixElementsRow{{Elements: []*DistanceMatrixElement{
+			{Status: ElementStatusOK, Distance: Distance{Meters: 23000}, Duration: 90 * time.Second, DurationInTraffic: 95500 * time.Millisecond},
+			{Status: ElementStatusZeroResults},
+		}}},
+	}
+}
+
+func TestDistanceMatrixResponseToCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testDistanceMatrixResponse().ToCSV(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "origin_index,destination_index,origin_address,destination_address,status,distance_meters,duration_seconds,duration_in_traffic_seconds\n" +
+		`0,0,"Sydney NSW, Australia","Parramatta NSW, Australia",OK,23000,90,95.5` + "\n" +
+		`0,1,"Sydney NSW, Australia",Nowhere,ZERO_RESULTS,,,` + "\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, was %q", expected, buf.String())
+	}
+}
+
+func TestDistanceMatrixResponseDense(t *testing.T) {
+	r := testDistanceMatrixResponse()
+	if m := r.AsMeters(); !reflect.DeepEqual(m, [][]float64{{23000, math.Inf(1)}}) {
+		t.Errorf("expected meters [[23000 +Inf]], was %v", m)
+	}
+	if m := r.AsSeconds(); !reflect.DeepEqual(m, [][]float64{{90, math.Inf(1)}}) {
+		t.Errorf("expected seconds [[90 +Inf]], was %v", m)
+	}
+}
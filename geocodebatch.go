@@ -0,0 +1,174 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// locationTypeConfidence is the confidence in a result of each location type.
+var locationTypeConfidence = map[string]float64{
+	string(GeocodeAccuracyRooftop):           1,
+	string(GeocodeAccuracyRangeInterpolated): 0.8,
+	string(GeocodeAccuracyGeometricCenter):   0.6,
+	string(GeocodeAccuracyApproximate):       0.4,
+}
+
+// partialMatchConfidence scales the confidence in partially matched results.
+const partialMatchConfidence = 0.7
+
+// confidenceComponentTypes are the types of result components which are
+// matched against the address of a request.
+var confidenceComponentTypes = []string{"street_number", "route", "locality", "postal_code"}
+
+// GeocodeConfidence returns a score from 0 to 1 of how likely result is to be
+// the place requested by r. It combines the precision of the result's location
+// type, whether it is a partial match, and the fraction of its street number,
+// route, locality and postal code found in r.Address, and of r.Components found
+// in the result.
+func GeocodeConfidence(r *GeocodingRequest, result *GeocodingResult) float64 {
+	confidence := locationTypeConfidence[result.Geometry.LocationType]
+	if result.PartialMatch {
+		confidence *= partialMatchConfidence
+	}
+
+	matched, total := 0, 0
+	if r.Address != "" {
+		address := " " + normalizeAddressText(r.Address) + " "
+		for _, t := range confidenceComponentTypes {
+			c := findAddressComponent(result.AddressComponents, t)
+			if c == nil {
+				continue
+			}
+			total++
+			if strings.Contains(address, " "+normalizeAddressText(c.LongName)+" ") ||
+				strings.Contains(address, " "+normalizeAddressText(c.ShortName)+" ") {
+				matched++
+			}
+		}
+	}
+	for component, value := range r.Components {
+		total++
+		c := findAddressComponent(result.AddressComponents, string(component))
+		if c != nil && (strings.EqualFold(c.LongName, value) || strings.EqualFold(c.ShortName, value)) {
+			matched++
+		}
+	}
+	if total > 0 {
+		confidence *= float64(matched) / float64(total)
+	}
+	return confidence
+}
+
+// findAddressComponent returns the first of components with a type starting
+// with t, so that administrative_area matches administrative_area_level_1.
+func findAddressComponent(components []AddressComponent, t string) *AddressComponent {
+	for i, c := range components {
+		for _, ct := range c.Types {
+			if strings.HasPrefix(ct, t) {
+				return &components[i]
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeAddressText returns s in lower case with runs of punctuation and
+// spaces replaced by a single space.
+func normalizeAddressText(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// GeocodeBatchResult is the result of geocoding one request of a batch.
+type GeocodeBatchResult struct {
+	// Request is the request which was geocoded.
+	Request *GeocodingRequest
+	// Results are the results of the Geocoding API, or nil if Err is set.
+	Results []GeocodingResult
+	// Err is the error geocoding the request, if any.
+	Err error
+	// Confidence is the GeocodeConfidence of the first of Results, or 0 if
+	// there are none.
+	Confidence float64
+}
+
+// GeocodeBatch is the result of Client.GeocodeBatch.
+type GeocodeBatch struct {
+	// Results holds a result for each request, in the order of the requests.
+	Results []*GeocodeBatchResult
+	// Accepted holds the results with a confidence of at least the threshold.
+	Accepted []*GeocodeBatchResult
+	// Review holds the results with a lower confidence, including those with no
+	// results, for manual review. Results with an error are in neither.
+	Review []*GeocodeBatchResult
+}
+
+// GeocodeBatch geocodes rs, making at most concurrency Geocoding API requests
+// at a time. Like ValidateAddressBatch, it makes fewer requests at a time
+// after the API reports that it is over its query limit. Results whose
+// confidence is below threshold are routed to GeocodeBatch.Review.
+func (c *Client) GeocodeBatch(ctx context.Context, rs []*GeocodingRequest, concurrency int, threshold float64) (*GeocodeBatch, error) {
+	if concurrency < 1 {
+		return nil, errors.New("maps: concurrency must be at least 1")
+	}
+
+	results := make([]*GeocodeBatchResult, len(rs))
+	indexes := make(chan int)
+	limit := newAdaptiveConcurrency(concurrency)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(rs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := &GeocodeBatchResult{Request: rs[i]}
+				var resp GeocodingResponse
+				err := limit.do(ctx, c, func() error {
+					var err error
+					resp, err = c.Geocode(ctx, rs[i])
+					return err
+				})
+				result.Results, result.Err = resp.Results, err
+				if err == nil && len(result.Results) > 0 {
+					result.Confidence = GeocodeConfidence(rs[i], &result.Results[0])
+				}
+				results[i] = result
+			}
+		}()
+	}
+	for i := range rs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	batch := &GeocodeBatch{Results: results}
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+		case result.Confidence >= threshold && len(result.Results) > 0:
+			batch.Accepted = append(batch.Accepted, result)
+		default:
+			batch.Review = append(batch.Review, result)
+		}
+	}
+	return batch, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var googleplexComponents = []AddressComponent{
+	{LongName: "1600", ShortName: "1600", Types: []string{"street_number"}},
+	{LongName: "Amphitheatre Parkway", ShortName: "Amphitheatre Pkwy", Types: []string{"route"}},
+	{LongName: "Mountain View", ShortName: "Mountain View", Types: []string{"locality", "political"}},
+	{LongName: "California", ShortName: "CA", Types: []string{"administrative_area_level_1", "political"}},
+	{LongName: "94043", ShortName: "94043", Types: []string{"postal_code"}},
+}
+
+func TestGeocodeConfidence(t *testing.T) {
+	result := GeocodingResult{
+		AddressComponents: googleplexComponents,
+		Geometry:          AddressGeometry{LocationType: string(GeocodeAccuracyRooftop)},
+	}
+	tests := []struct {
+		request      GeocodingRequest
+		partialMatch bool
+		locationType GeocodeAccuracy
+		expected     float64
+	}{
+		{GeocodingRequest{Address: "1600 Amphitheatre Pkwy, Mountain View, CA 94043"}, false, GeocodeAccuracyRooftop, 1},
+		{GeocodingRequest{Address: "1600 amphitheatre parkway mountain view"}, false, GeocodeAccuracyRangeInterpolated, 0.6},
+		{GeocodingRequest{Address: "1600 Amphitheatre Pkwy, Mountain View, CA 94043"}, true, GeocodeAccuracyRooftop, 0.7},
+		{GeocodingRequest{Address: "16 Amphitheatre Pkwy, Palo Alto"}, false, GeocodeAccuracyRooftop, 0.25},
+		{GeocodingRequest{Components: map[Component]string{ComponentPostalCode: "94043", ComponentAdministrativeArea: "ca"}}, false, GeocodeAccuracyApproximate, 0.4},
+		{GeocodingRequest{Components: map[Component]string{ComponentPostalCode: "94040"}}, false, GeocodeAccuracyApproximate, 0},
+		{GeocodingRequest{LatLng: &LatLng{Lat: 37.42, Lng: -122.08}}, false, GeocodeAccuracyGeometricCenter, 0.6},
+	}
+	for _, test := range tests {
+		result.PartialMatch = test.partialMatch
+		result.Geometry.LocationType = string(test.locationType)
+		if c := GeocodeConfidence(&test.request, &result); math.Abs(c-test.expected) > 1e-9 {
+			t.Errorf("GeocodeConfidence(%+v) expected %v, was %v", test.request, test.expected, c)
+		}
+	}
+}
+
+func TestGeocodeBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		switch r.URL.Query().Get("address") {
+		case "1600 Amphitheatre Pkwy, Mountain View, CA 94043":
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"address_components": [{"long_name": "1600", "short_name": "1600", "types": ["street_number"]}], "geometry": {"location_type": "ROOFTOP"}}]}`)
+		case "Mountain View":
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"partial_match": true, "geometry": {"location_type": "APPROXIMATE"}}]}`)
+		case "Nowhere":
+			fmt.Fprintln(w, `{"status": "ZERO_RESULTS", "results": []}`)
+		default:
+			fmt.Fprintln(w, `{"status": "INVALID_REQUEST"}`)
+		}
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	var rs []*GeocodingRequest
+	for _, address := range []string{"1600 Amphitheatre Pkwy, Mountain View, CA 94043", "Mountain View", "Nowhere", "Invalid"} {
+		rs = append(rs, &GeocodingRequest{Address: address})
+	}
+	batch, err := c.GeocodeBatch(context.Background(), rs, 2, 0.5)
+	if err != nil {
+		t.Fatalf("GeocodeBatch returned error: %v", err)
+	}
+	if len(batch.Results) != 4 {
+		t.Fatalf("expected 4 results, was %d", len(batch.Results))
+	}
+	if len(batch.Accepted) != 1 || batch.Accepted[0] != batch.Results[0] || batch.Results[0].Confidence != 1 {
+		t.Errorf("expected only the first result accepted, was %+v", batch.Accepted)
+	}
+	if len(batch.Review) != 2 || batch.Review[0] != batch.Results[1] || batch.Review[1] != batch.Results[2] {
+		t.Errorf("expected the second and third results for review, was %+v", batch.Review)
+	}
+	if batch.Results[3].Err == nil {
+		t.Errorf("expected error for the fourth result")
+	}
+
+	if _, err := c.GeocodeBatch(context.Background(), rs, 0, 0.5); err == nil {
+		t.Errorf("expected error for zero concurrency")
+	}
+}
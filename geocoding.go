@@ -29,13 +29,22 @@ var geocodingAPI = &apiConfig{
 	path:             "/maps/api/geocode/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	acceptsLanguage:  true,
+	acceptsRegion:    true,
+	cacheable:        true,
 }
 
 // Geocode makes a Geocoding API request
 func (c *Client) Geocode(ctx context.Context, r *GeocodingRequest) (GeocodingResponse, error) {
+	if err := c.validateRequest(r); err != nil {
+		return GeocodingResponse{}, err
+	}
 	if r.Address == "" && len(r.Components) == 0 && r.LatLng == nil {
 		return GeocodingResponse{}, errors.New("maps: address, components and LatLng are all missing")
 	}
+	if r.EnableAddressDescriptor {
+		return GeocodingResponse{}, errors.New("maps: EnableAddressDescriptor is only supported for reverse geocoding")
+	}
 
 	var response struct {
 		Results []GeocodingResult `json:"results"`
@@ -55,6 +64,9 @@ func (c *Client) Geocode(ctx context.Context, r *GeocodingRequest) (GeocodingRes
 
 // ReverseGeocode makes a Reverse Geocoding API request
 func (c *Client) ReverseGeocode(ctx context.Context, r *GeocodingRequest) (GeocodingResponse, error) {
+	if err := c.validateRequest(r); err != nil {
+		return GeocodingResponse{}, err
+	}
 	// Since Geocode() does not allow a nil LatLng, whereas it is allowed here
 	if r.LatLng == nil && r.PlaceID == "" {
 		return GeocodingResponse{}, errors.New("maps: LatLng and PlaceID are both missing")
@@ -77,6 +89,78 @@ func (c *Client) ReverseGeocode(ctx context.Context, r *GeocodingRequest) (Geoco
 	return GeocodingResponse{response.Results, response.AddressDescriptor}, nil
 }
 
+// ErrNoResults is returned by ReverseGeocodeFirst when there is no matching
+// result.
+var ErrNoResults = errors.New("maps: no results")
+
+// ReverseGeocodeOptions are the options of ReverseGeocodeFirst.
+type ReverseGeocodeOptions struct {
+	// ResultTypes restricts the result to one with at least one of these
+	// types, such as AddressComponentStreetAddress. Optional.
+	ResultTypes []AddressComponentType
+	// LocationTypes restricts the result to one with one of these location
+	// types. Optional.
+	LocationTypes []GeocodeAccuracy
+	// Language is the language in which to return the result. Optional.
+	Language string
+}
+
+// ReverseGeocodeFirst reverse geocodes latlng and returns the best result, the
+// first result which matches opts, which may be nil. It returns ErrNoResults if
+// there is none.
+func (c *Client) ReverseGeocodeFirst(ctx context.Context, latlng LatLng, opts *ReverseGeocodeOptions) (*GeocodingResult, error) {
+	if opts == nil {
+		opts = &ReverseGeocodeOptions{}
+	}
+	r := &GeocodingRequest{
+		LatLng:       &latlng,
+		LocationType: opts.LocationTypes,
+		Language:     opts.Language,
+	}
+	for _, t := range opts.ResultTypes {
+		r.ResultType = append(r.ResultType, string(t))
+	}
+	resp, err := c.ReverseGeocode(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Results {
+		if resp.Results[i].hasType(opts.ResultTypes) && resp.Results[i].hasLocationType(opts.LocationTypes) {
+			return &resp.Results[i], nil
+		}
+	}
+	return nil, ErrNoResults
+}
+
+// hasType reports whether r has one of types, or types is empty.
+func (r *GeocodingResult) hasType(types []AddressComponentType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, rt := range r.Types {
+		for _, t := range types {
+			if rt == string(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasLocationType reports whether r's location type is one of types, or types
+// is empty.
+func (r *GeocodingResult) hasLocationType(types []GeocodeAccuracy) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if r.Geometry.LocationType == string(t) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *GeocodingRequest) params() url.Values {
 	q := make(url.Values)
 
@@ -179,7 +263,9 @@ type GeocodingRequest struct {
 	// Language is the language in which to return results. Optional.
 	Language string
 
-	// Language is the language in which to return results. Optional.
+	// EnableAddressDescriptor requests the address descriptor of the location,
+	// which describes it relative to nearby landmarks and areas. It is only
+	// supported by ReverseGeocode. Optional.
 	EnableAddressDescriptor bool
 
 	// Custom allows passing through custom parameters to the Geocoding back end.
@@ -196,59 +282,6 @@ type GeocodingResponse struct {
 	AddressDescriptor AddressDescriptor
 }
 
-// GeocodingResult is a single geocoded address
-type GeocodingResult struct {
-	AddressComponents []AddressComponent `json:"address_components"`
-	FormattedAddress  string             `json:"formatted_address"`
-	Geometry          AddressGeometry    `json:"geometry"`
-	Types             []string           `json:"types"`
-	PlaceID           string             `json:"place_id"`
-
-	// PartialMatch indicates that the geocoder did not return an exact match for
-	// the original request, though it was able to match part of the requested address.
-	// You may wish to examine the original request for misspellings and/or an incomplete address.
-	// Partial matches most often occur for street addresses that do not exist within the
-	// locality you pass in the request.
-	// Partial matches may also be returned when a request matches two or more locations in
-	// the same locality. For example, "21 Henr St, Bristol, UK" will return a partial match
-	// for both Henry Street and Henrietta Street.
-	// Note that if a request includes a misspelled address component, the geocoding service may
-	// suggest an alternative address.
-	// Suggestions triggered in this way will also be marked as a partial match.
-	PartialMatch bool `json:"partial_match"`
-
-	// PlusCode (see https://en.wikipedia.org/wiki/Open_Location_Code and https://plus.codes/)
-	// is an encoded location reference, derived from latitude and longitude coordinates,
-	// that represents an area: 1/8000th of a degree by 1/8000th of a degree (about 14m x 14m at the equator)
-	// or smaller.
-	//
-	// Plus codes can be used as a replacement for street addresses in places where they do not exist
-	// (where buildings are not numbered or streets are not named).
-	// The plus code is formatted as a global code and a compound code:
-	// Typically, both the global code and compound code are returned.
-	// However, if the result is in a remote location (for example, an ocean or desert)
-	// only the global code may be returned.
-	PlusCode AddressPlusCode `json:"plus_code"`
-}
-
-// AddressPlusCode (see https://en.wikipedia.org/wiki/Open_Location_Code and https://plus.codes/)
-// is an encoded location reference, derived from latitude and longitude coordinates,
-// that represents an area: 1/8000th of a degree by 1/8000th of a degree (about 14m x 14m at the equator)
-// or smaller.
-//
-// Plus codes can be used as a replacement for street addresses in places where they do not exist
-// (where buildings are not numbered or streets are not named).
-// The plus code is formatted as a global code and a compound code:
-// Typically, both the global code and compound code are returned.
-// However, if the result is in a remote location (for example, an ocean or desert)
-// only the global code may be returned.
-type AddressPlusCode struct {
-	// GlobalCode is a 4 character area code and 6 character or longer local code (849VCWC8+R9).
-	GlobalCode string `json:"global_code"`
-	// CompoundCode is a 6 character or longer local code with an explicit location (CWC8+R9, Mountain View, CA, USA).
-	CompoundCode string `json:"compound_code"`
-}
-
 // AddressComponent is a part of an address
 type AddressComponent struct {
 	LongName  string   `json:"long_name"`
@@ -256,6 +289,58 @@ type AddressComponent struct {
 	Types     []string `json:"types"`
 }
 
+// Component returns the first of r's address components of type t, or nil if
+// it has none.
+func (r *GeocodingResult) Component(t AddressComponentType) *AddressComponent {
+	for i, c := range r.AddressComponents {
+		for _, ct := range c.Types {
+			if ct == string(t) {
+				return &r.AddressComponents[i]
+			}
+		}
+	}
+	return nil
+}
+
+// componentName returns the long or short name of r's address component of
+// type t, or "" if it has none.
+func (r *GeocodingResult) componentName(t AddressComponentType, short bool) string {
+	c := r.Component(t)
+	switch {
+	case c == nil:
+		return ""
+	case short:
+		return c.ShortName
+	}
+	return c.LongName
+}
+
+// Country returns the two letter ISO 3166-1 code of r's country, or "" if it
+// has none.
+func (r *GeocodingResult) Country() string {
+	return r.componentName(AddressComponentCountry, true)
+}
+
+// Locality returns the name of r's locality, or "" if it has none.
+func (r *GeocodingResult) Locality() string {
+	return r.componentName(AddressComponentLocality, false)
+}
+
+// PostalCode returns r's postal code, or "" if it has none.
+func (r *GeocodingResult) PostalCode() string {
+	return r.componentName(AddressComponentPostalCode, false)
+}
+
+// NavigationPoint is a point at which to start or end navigation to a
+// geocoding result.
+type NavigationPoint struct {
+	// Location is the location of the point, usually on a road.
+	Location LatLng `json:"location"`
+	// RestrictedTravelModes are the only travel modes, such as "DRIVE" or
+	// "WALK", with which the point can be reached. Empty means any.
+	RestrictedTravelModes []string `json:"restricted_travel_modes,omitempty"`
+}
+
 // AddressGeometry is the location of a an address
 type AddressGeometry struct {
 	Location     LatLng       `json:"location"`
@@ -828,3 +828,137 @@ func TestReverseGeocodingZeroResults(t *testing.T) {
 		t.Errorf("Unexpected response for ZERO_RESULTS status")
 	}
 }
+
+func TestGeocodingResultComponents(t *testing.T) {
+	r := &GeocodingResult{AddressComponents: []AddressComponent{
+		{LongName: "1600", ShortName: "1600", Types: []string{"street_number"}},
+		{LongName: "Mountain View", ShortName: "Mountain View", Types: []string{"locality", "political"}},
+		{LongName: "United States", ShortName: "US", Types: []string{"country", "political"}},
+		{LongName: "94043", ShortName: "94043", Types: []string{"postal_code"}},
+	}}
+	if c := r.Component("street_number"); c == nil || c.LongName != "1600" {
+		t.Errorf("expected street number 1600, was %+v", c)
+	}
+	if c := r.Component(AddressComponentPolitical); c == nil || c.LongName != "Mountain View" {
+		t.Errorf("expected first political component Mountain View, was %+v", c)
+	}
+	if c := r.Component(AddressComponentRoute); c != nil {
+		t.Errorf("expected no route, was %+v", c)
+	}
+	if got := r.Country(); got != "US" {
+		t.Errorf("expected country US, was %q", got)
+	}
+	if got := r.Locality(); got != "Mountain View" {
+		t.Errorf("expected locality Mountain View, was %q", got)
+	}
+	if got := r.PostalCode(); got != "94043" {
+		t.Errorf("expected postal code 94043, was %q", got)
+	}
+	if got := (&GeocodingResult{}).PostalCode(); got != "" {
+		t.Errorf("expected no postal code, was %q", got)
+	}
+}
+
+func TestGeocodingNavigationPoints(t *testing.T) {
+	server := mockServer(200, `{
+    "results": [
+        {
+            "formatted_address": "1600 Amphitheatre Pkwy, Mountain View, CA 94043, USA",
+            "navigation_points": [
+                {
+                    "location": {"latitude": 37.4226618, "longitude": -122.0829302},
+                    "restricted_travel_modes": ["WALK"]
+                },
+                {
+                    "location": {"latitude": 37.4220625, "longitude": -122.0842568}
+                }
+            ],
+            "plus_code": {"compound_code": "CWC8+W5 Mountain View, CA, USA", "global_code": "849VCWC8+W5"},
+            "place_id": "ChIJtYuu0V25j4ARwu5e4wwRYgE"
+        }
+    ],
+    "status": "OK"
+}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	resp, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "1600 Amphitheatre Parkway"})
+	if err != nil {
+		t.Fatalf("r.Get returned non nil error: %v", err)
+	}
+	expected := []NavigationPoint{
+		{Location: LatLng{Lat: 37.4226618, Lng: -122.0829302}, RestrictedTravelModes: []string{"WALK"}},
+		{Location: LatLng{Lat: 37.4220625, Lng: -122.0842568}},
+	}
+	if !reflect.DeepEqual(resp.Results[0].NavigationPoints, expected) {
+		t.Errorf("expected %+v, was %+v", expected, resp.Results[0].NavigationPoints)
+	}
+	if code := resp.Results[0].PlusCode.GlobalCode; code != "849VCWC8+W5" {
+		t.Errorf("expected global code 849VCWC8+W5, was %q", code)
+	}
+}
+
+func TestGeocodingEnableAddressDescriptor(t *testing.T) {
+	server := mockServerForQuery("enable_address_descriptor=true&key=AIzaNotReallyAnAPIKey&latlng=37.4224764%2C-122.0842499", 200, `{"results" : [], "status" : "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	r := &GeocodingRequest{
+		LatLng:                  &LatLng{Lat: 37.4224764, Lng: -122.0842499},
+		EnableAddressDescriptor: true,
+	}
+
+	if _, err := c.ReverseGeocode(context.Background(), r); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want enable_address_descriptor=true", server.failed)
+	}
+
+	r = &GeocodingRequest{Address: "Sydney Town Hall", EnableAddressDescriptor: true}
+	if _, err := c.Geocode(context.Background(), r); err == nil {
+		t.Errorf("Geocode should return error for EnableAddressDescriptor")
+	}
+	if err := r.Validate(); err == nil {
+		t.Errorf("Validate should return error for EnableAddressDescriptor")
+	}
+}
+
+func TestReverseGeocodeFirst(t *testing.T) {
+	server := mockServer(200, `{
+    "results": [
+        {"place_id": "a", "types": ["route"], "geometry": {"location_type": "GEOMETRIC_CENTER"}},
+        {"place_id": "b", "types": ["street_address"], "geometry": {"location_type": "ROOFTOP"}}
+    ],
+    "status": "OK"
+}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	latlng := LatLng{Lat: 40.714224, Lng: -73.961452}
+
+	result, err := c.ReverseGeocodeFirst(context.Background(), latlng, nil)
+	if err != nil || result.PlaceID != "a" {
+		t.Errorf("expected first result a, was %+v, %v", result, err)
+	}
+	result, err = c.ReverseGeocodeFirst(context.Background(), latlng, &ReverseGeocodeOptions{ResultTypes: []AddressComponentType{AddressComponentStreetAddress}})
+	if err != nil || result.PlaceID != "b" {
+		t.Errorf("expected street address b, was %+v, %v", result, err)
+	}
+	result, err = c.ReverseGeocodeFirst(context.Background(), latlng, &ReverseGeocodeOptions{LocationTypes: []GeocodeAccuracy{GeocodeAccuracyApproximate}})
+	if err != ErrNoResults {
+		t.Errorf("expected ErrNoResults, was %+v, %v", result, err)
+	}
+}
+
+func TestReverseGeocodeFirstZeroResults(t *testing.T) {
+	server := mockServerForQuery("key=AIzaNotReallyAnAPIKey&language=fr&latlng=28%2C140&result_type=street_address", 200, `{"results" : [], "status" : "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	opts := &ReverseGeocodeOptions{ResultTypes: []AddressComponentType{AddressComponentStreetAddress}, Language: "fr"}
+	if _, err := c.ReverseGeocodeFirst(context.Background(), LatLng{Lat: 28, Lng: 140}, opts); err != ErrNoResults {
+		t.Errorf("expected ErrNoResults, was %v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v", server.failed)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+// LatLng is the location type used throughout this package. The types below
+// carry locations in the forms used by particular APIs, and convert to and
+// from LatLng so that results of one API can be passed to another, for example
+// validating an address, then routing to its geocode.
+
+// AddressValidationLatLng is a location as encoded by the newer Google Maps
+// APIs, such as the Address Validation, Places (New) and Routes APIs.
+type AddressValidationLatLng struct {
+	// Latitude in degrees.
+	Latitude float64 `json:"latitude"`
+	// Longitude in degrees.
+	Longitude float64 `json:"longitude"`
+}
+
+// Viewport is a bounding box as encoded by the newer Google Maps APIs.
+type Viewport struct {
+	// Low is the south west corner of the box.
+	Low LatLng `json:"low"`
+	// High is the north east corner of the box.
+	High LatLng `json:"high"`
+}
+
+// LatLng returns l as a LatLng.
+func (l AddressValidationLatLng) LatLng() LatLng {
+	return LatLng{Lat: l.Latitude, Lng: l.Longitude}
+}
+
+// AddressValidationLatLng returns l as an AddressValidationLatLng.
+func (l LatLng) AddressValidationLatLng() AddressValidationLatLng {
+	return AddressValidationLatLng{Latitude: l.Lat, Longitude: l.Lng}
+}
+
+// RoutesLocation returns l as a RoutesLocation without a heading.
+func (l LatLng) RoutesLocation() *RoutesLocation {
+	return &RoutesLocation{LatLng: l}
+}
+
+// RoutesWaypoint returns a Routes API waypoint at l.
+func (l LatLng) RoutesWaypoint() RoutesWaypoint {
+	return RoutesWaypoint{Location: l.RoutesLocation()}
+}
+
+// LatLngBounds returns v as a LatLngBounds.
+func (v Viewport) LatLngBounds() LatLngBounds {
+	return LatLngBounds{NorthEast: v.High, SouthWest: v.Low}
+}
+
+// LatLng returns the geocoded location of g.
+func (g *AddressValidationGeocode) LatLng() LatLng {
+	return g.Location.LatLng()
+}
+
+// RoutesWaypoint returns a Routes API waypoint for g, identified by its place
+// ID when it has one, and by its location otherwise.
+func (g *AddressValidationGeocode) RoutesWaypoint() RoutesWaypoint {
+	if g.PlaceID != "" {
+		return RoutesWaypoint{PlaceID: g.PlaceID}
+	}
+	return g.LatLng().RoutesWaypoint()
+}
+
+// RoutesWaypoint returns a Routes API waypoint for r, identified by its place
+// ID when it has one, and by its location otherwise.
+func (r *GeocodingResult) RoutesWaypoint() RoutesWaypoint {
+	if r.PlaceID != "" {
+		return RoutesWaypoint{PlaceID: r.PlaceID}
+	}
+	return r.Geometry.Location.RoutesWaypoint()
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeometryConversions(t *testing.T) {
+	l := LatLng{Lat: 37.4225, Lng: -122.0847}
+	assert.Equal(t, AddressValidationLatLng{Latitude: 37.4225, Longitude: -122.0847}, l.AddressValidationLatLng())
+	assert.Equal(t, l, l.AddressValidationLatLng().LatLng())
+	assert.Equal(t, RoutesWaypoint{Location: &RoutesLocation{LatLng: l}}, l.RoutesWaypoint())
+
+	v := RoutesViewport{Low: LatLng{Lat: 1, Lng: 2}, High: LatLng{Lat: 3, Lng: 4}}
+	assert.Equal(t, LatLngBounds{NorthEast: LatLng{Lat: 3, Lng: 4}, SouthWest: LatLng{Lat: 1, Lng: 2}}, v.LatLngBounds())
+
+	g := &AddressValidationGeocode{Location: l.AddressValidationLatLng()}
+	assert.Equal(t, l, g.LatLng())
+	assert.Equal(t, l.RoutesWaypoint(), g.RoutesWaypoint())
+	g.PlaceID = "ChIJF4Yf2Ry7j4AR__1AkytDyAE"
+	assert.Equal(t, RoutesWaypoint{PlaceID: "ChIJF4Yf2Ry7j4AR__1AkytDyAE"}, g.RoutesWaypoint())
+
+	r := &GeocodingResult{Geometry: AddressGeometry{Location: l}}
+	assert.Equal(t, l.RoutesWaypoint(), r.RoutesWaypoint())
+	r.PlaceID = "ChIJ2eUgeAK6j4ARbn5u_wAGqWA"
+	assert.Equal(t, RoutesWaypoint{PlaceID: "ChIJ2eUgeAK6j4ARbn5u_wAGqWA"}, r.RoutesWaypoint())
+}
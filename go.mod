@@ -3,6 +3,7 @@ module googlemaps.github.io/maps
 go 1.14
 
 require (
+	github.com/goccy/go-json v0.9.11
 	github.com/google/uuid v1.1.1
 	github.com/kr/pretty v0.2.0
 	github.com/sergi/go-diff v1.1.0
@@ -0,0 +1,192 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command responses generates Go response structs for the legacy Maps Web
+// Service APIs from a curated JSON schema, so that fields documented by the
+// APIs are not silently dropped when decoding responses.
+//
+// Each struct in the schema lists its fields by their JSON name and schema
+// type. A field may override the derived Go name with goName, and the derived
+// Go type with goType.
+//
+// Usage:
+//
+//	go run ./internal/gen/responses -schema internal/gen/responses/schema.json -out responses_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// schema is the curated description of the generated response structs.
+type schema struct {
+	Structs []structSchema `json:"structs"`
+}
+
+// structSchema describes a single generated struct.
+type structSchema struct {
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	Fields      []fieldSchema `json:"fields"`
+}
+
+// fieldSchema describes a single field of a generated struct.
+type fieldSchema struct {
+	// JSON is the name of the field in the API response, or "-" for fields
+	// which are populated by the client rather than decoded.
+	JSON string `json:"json"`
+	// Type is the JSON schema type of the field: string, integer, number,
+	// boolean, or array.
+	Type string `json:"type"`
+	// Items is the schema type of the elements of an array field.
+	Items *fieldSchema `json:"items"`
+	// Description is the documentation of the field.
+	Description string `json:"description"`
+	// OmitEmpty adds omitempty to the field's JSON tag.
+	OmitEmpty bool `json:"omitempty"`
+	// GoName overrides the Go name derived from JSON.
+	GoName string `json:"goName"`
+	// GoType overrides the Go type derived from Type.
+	GoType string `json:"goType"`
+}
+
+// initialisms are the name parts which are upper cased in Go names.
+var initialisms = map[string]bool{
+	"html": true,
+	"id":   true,
+	"uri":  true,
+	"url":  true,
+}
+
+func (f *fieldSchema) goName() string {
+	if f.GoName != "" {
+		return f.GoName
+	}
+	var b strings.Builder
+	for _, part := range strings.Split(f.JSON, "_") {
+		if part == "" {
+			continue
+		}
+		if initialisms[strings.ToLower(part)] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func (f *fieldSchema) goType() (string, error) {
+	if f.GoType != "" {
+		return f.GoType, nil
+	}
+	switch f.Type {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if f.Items == nil {
+			return "", fmt.Errorf("array field %q is missing items", f.JSON)
+		}
+		t, err := f.Items.goType()
+		if err != nil {
+			return "", err
+		}
+		return "[]" + t, nil
+	}
+	return "", fmt.Errorf("field %q has unknown type %q", f.JSON, f.Type)
+}
+
+// writeComment writes text as a Go comment wrapped at roughly 85 columns.
+func writeComment(b *bytes.Buffer, indent, text string) {
+	for i, paragraph := range strings.Split(text, "\n\n") {
+		if i > 0 {
+			b.WriteString(indent + "//\n")
+		}
+		line := indent + "//"
+		for _, word := range strings.Fields(paragraph) {
+			if len(line)+1+len(word) > 85 && line != indent+"//" {
+				b.WriteString(line + "\n")
+				line = indent + "//"
+			}
+			line += " " + word
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
+// generate returns the formatted Go source for the structs in s.
+func generate(s *schema) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by internal/gen/responses from schema.json. DO NOT EDIT.\n\n")
+	b.WriteString("package maps\n")
+	for _, st := range s.Structs {
+		b.WriteString("\n")
+		writeComment(&b, "", st.Description)
+		fmt.Fprintf(&b, "type %s struct {\n", st.Name)
+		for _, f := range st.Fields {
+			t, err := f.goType()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", st.Name, err)
+			}
+			tag := f.JSON
+			if f.OmitEmpty {
+				tag += ",omitempty"
+			}
+			writeComment(&b, "\t", f.Description)
+			fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", f.goName(), t, tag)
+		}
+		b.WriteString("}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// generateFile reads the schema at path and returns the generated source.
+func generateFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &schema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return generate(s)
+}
+
+func main() {
+	schemaPath := flag.String("schema", "schema.json", "path of the curated response schema")
+	out := flag.String("out", "responses_gen.go", "path of the generated Go file")
+	flag.Parse()
+
+	src, err := generateFile(*schemaPath)
+	if err != nil {
+		log.Fatalf("responses: %v", err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("responses: %v", err)
+	}
+}
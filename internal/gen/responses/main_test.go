@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedResponsesUpToDate(t *testing.T) {
+	want, err := generateFile("schema.json")
+	if err != nil {
+		t.Fatalf("generateFile returned error: %v", err)
+	}
+	got, err := ioutil.ReadFile("../../../responses_gen.go")
+	if err != nil {
+		t.Fatalf("Unable to read generated file: %v", err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("responses_gen.go is out of date with schema.json, run go generate")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	s := &schema{
+		Structs: []structSchema{{
+			Name:        "Thing",
+			Description: "Thing is a thing.",
+			Fields: []fieldSchema{
+				{JSON: "place_id", Type: "string", Description: "PlaceID is an ID."},
+				{JSON: "html_attributions", Type: "array", Items: &fieldSchema{Type: "string"}, OmitEmpty: true, Description: "HTMLAttributions are attributions."},
+				{JSON: "location", GoType: "LatLng", Description: "Location is a location."},
+			},
+		}},
+	}
+	src, err := generate(s)
+	if err != nil {
+		t.Fatalf("generate returned error: %v", err)
+	}
+	for _, want := range []string{
+		"PlaceID string `json:\"place_id\"`",
+		"HTMLAttributions []string `json:\"html_attributions,omitempty\"`",
+		"Location LatLng `json:\"location\"`",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("expected generated source to contain %q, was:\n%s", want, src)
+		}
+	}
+}
+
+func TestGenerateUnknownType(t *testing.T) {
+	s := &schema{
+		Structs: []structSchema{{
+			Name:   "Thing",
+			Fields: []fieldSchema{{JSON: "value", Type: "decimal"}},
+		}},
+	}
+	if _, err := generate(s); err == nil {
+		t.Errorf("Unknown type should return error")
+	}
+}
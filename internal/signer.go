@@ -36,10 +36,15 @@ func generateSignature(key []byte, message string) (string, error) {
 // See: https://developers.google.com/maps/faq#using-google-maps-apis
 func SignURL(path string, signature []byte, q url.Values) (string, error) {
 	encodedQuery := q.Encode()
-	message := fmt.Sprintf("%s?%s", path, encodedQuery)
-	s, err := generateSignature(signature, message)
+	s, err := Signature(path, signature, encodedQuery)
 	if err != nil {
 		return "", err
 	}
 	return fmt.Sprintf("%s&signature=%s", encodedQuery, s), nil
 }
+
+// Signature returns the URL safe base64 encoded signature of a URL with path
+// and the already encoded query rawQuery.
+func Signature(path string, signature []byte, rawQuery string) (string, error) {
+	return generateSignature(signature, fmt.Sprintf("%s?%s", path, rawQuery))
+}
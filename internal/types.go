@@ -14,7 +14,10 @@
 
 package internal
 
-import "time"
+import (
+	"sync"
+	"time"
+)
 
 // DateTime is the public API representation of a point in time.
 type DateTime struct {
@@ -36,14 +39,31 @@ func (dt *DateTime) Time() time.Time {
 		return time.Time{}
 	}
 
-	loc, err := time.LoadLocation(dt.TimeZone)
 	t := time.Unix(dt.Value, 0)
-	if err == nil && loc != nil {
+	if loc := loadLocation(dt.TimeZone); loc != nil {
 		t = t.In(loc)
 	}
 	return t
 }
 
+// locations caches the results of time.LoadLocation, which reads the time zone
+// database on every call, by time zone name.
+var locations sync.Map
+
+// loadLocation returns the time.Location with the given name, or nil if it
+// cannot be loaded.
+func loadLocation(name string) *time.Location {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = nil
+	}
+	locations.Store(name, loc)
+	return loc
+}
+
 // NewDateTime builds a DateTime from the given time.Time. This will be nil
 // if time.Time is the zero time.
 func NewDateTime(t time.Time) *DateTime {
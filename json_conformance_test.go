@@ -0,0 +1,191 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+// directionsConformanceResponse mirrors the response decoded by
+// Client.Directions.
+type directionsConformanceResponse struct {
+	Routes            []Route            `json:"routes"`
+	GeocodedWaypoints []GeocodedWaypoint `json:"geocoded_waypoints"`
+	commonResponse
+}
+
+// lazyDirectionsConformanceResponse mirrors the response decoded when
+// DirectionsRequest.LazySteps is set.
+type lazyDirectionsConformanceResponse struct {
+	Routes []lazyRoute `json:"routes"`
+	commonResponse
+}
+
+// directionsConformanceJSON is a Directions response which exercises every
+// custom decoder in encoding.go.
+const directionsConformanceJSON = `{
+	"status": "OK",
+	"routes": [{
+		"summary": "Line 1",
+		"overview_polyline": {"points": "_p~iF~ps|U_ulLnnqC_mqNvxq@"},
+		"bounds": {"northeast": {"lat": 1.5, "lng": 2.5}, "southwest": {"lat": -1, "lng": -2}},
+		"warnings": ["careful"],
+		"waypoint_order": [1, 0],
+		"legs": [{
+			"distance": {"text": "1 km", "value": 1000},
+			"duration": {"text": "2 mins", "value": 120},
+			"duration_in_traffic": {"text": "3 mins", "value": 180},
+			"arrival_time": {"text": "10:02am", "time_zone": "Australia/Sydney", "value": 1600000120},
+			"departure_time": {"text": "10:00am", "time_zone": "Australia/Sydney", "value": 1600000000},
+			"start_address": "Start é",
+			"end_address": "End",
+			"start_location": {"lat": -33.86, "lng": 151.2},
+			"end_location": {"lat": -33.87, "lng": 151.21},
+			"via_waypoint": [{"location": {"lat": 1, "lng": 2}, "step_index": 0, "step_interpolation": 0.5}],
+			"steps": [{
+				"html_instructions": "Take the <b>train</b>",
+				"travel_mode": "TRANSIT",
+				"distance": {"text": "1 km", "value": 1000},
+				"duration": {"text": "2 mins", "value": 120},
+				"polyline": {"points": "abc"},
+				"steps": [{"html_instructions": "Walk", "duration": {"text": "1 min", "value": 60}}],
+				"transit_details": {
+					"arrival_stop": {"name": "B", "location": {"lat": 1, "lng": 2}},
+					"departure_stop": {"name": "A", "location": {"lat": 3, "lng": 4}},
+					"arrival_time": {"text": "10:02am", "time_zone": "Australia/Sydney", "value": 1600000120},
+					"departure_time": {"text": "10:00am", "time_zone": "Australia/Sydney", "value": 1600000000},
+					"headsign": "City",
+					"headway": 300,
+					"num_stops": 2,
+					"line": {
+						"name": "Line 1",
+						"short_name": "L1",
+						"color": "#ff0000",
+						"agencies": [{"name": "Trains", "url": "https://example.com/trains", "phone": "123"}],
+						"url": "https://example.com/l1",
+						"icon": "https://example.com/icon",
+						"text_color": "#ffffff",
+						"vehicle": {"name": "Train", "type": "HEAVY_RAIL", "icon": "https://example.com/train"}
+					}
+				}
+			}]
+		}]
+	}],
+	"geocoded_waypoints": [{"geocoder_status": "OK", "place_id": "abc", "types": ["route"]}]
+}`
+
+// jsonConformanceCases are decoded with both encoding/json and the backend
+// selected by build tags. Run with and without -tags gojson.
+var jsonConformanceCases = []struct {
+	name string
+	new  func() interface{}
+	data string
+}{
+	{
+		name: "directions",
+		new:  func() interface{} { return &directionsConformanceResponse{} },
+		data: directionsConformanceJSON,
+	},
+	{
+		name: "lazy directions",
+		new:  func() interface{} { return &lazyDirectionsConformanceResponse{} },
+		data: directionsConformanceJSON,
+	},
+	{
+		name: "distance matrix",
+		new:  func() interface{} { return &DistanceMatrixResponse{} },
+		data: `{
+			"origin_addresses": ["A"],
+			"destination_addresses": ["B", "C"],
+			"rows": [{"elements": [
+				{"status": "OK", "distance": {"text": "1 km", "value": 1000}, "duration": {"text": "1 min", "value": 60}, "duration_in_traffic": {"text": "2 mins", "value": 120}},
+				{"status": "ZERO_RESULTS"}
+			]}]
+		}`,
+	},
+	{
+		name: "snapped points",
+		new:  func() interface{} { return &[]SnappedPoint{} },
+		data: `[
+			{"location": {"latitude": -35.2784167, "longitude": 149.1294692}, "originalIndex": 0, "placeId": "a"},
+			{"location": {"latitude": -35.280, "longitude": 149.129}, "placeId": "b"}
+		]`,
+	},
+	{
+		name: "unknown fields",
+		new:  func() interface{} { return &TimezoneResult{} },
+		data: `{"dstOffset": 3600, "rawOffset": -28800, "timeZoneId": "America/Los_Angeles", "unknown": {"nested": [1, 2, 3]}}`,
+	},
+	{
+		name: "null values",
+		new:  func() interface{} { return &directionsConformanceResponse{} },
+		data: `{"routes": [{"legs": [{"steps": null, "duration": null, "arrival_time": null}]}], "geocoded_waypoints": []}`,
+	},
+	{
+		name: "invalid json",
+		new:  func() interface{} { return &directionsConformanceResponse{} },
+		data: `{"routes": [`,
+	},
+	{
+		name: "type mismatch",
+		new:  func() interface{} { return &TimezoneResult{} },
+		data: `{"dstOffset": "3600"}`,
+	},
+}
+
+func TestJSONBackendConformance(t *testing.T) {
+	places, err := ioutil.ReadFile("testdata/places_nearbysearch.json")
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+	cases := append(jsonConformanceCases, struct {
+		name string
+		new  func() interface{}
+		data string
+	}{
+		name: "places nearby search fixture",
+		new:  func() interface{} { return &PlacesSearchResponse{} },
+		data: string(places),
+	})
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			want, got := c.new(), c.new()
+			wantErr := json.Unmarshal([]byte(c.data), want)
+			gotErr := unmarshalJSON([]byte(c.data), got)
+			if (wantErr == nil) != (gotErr == nil) {
+				t.Fatalf("%s: expected error %v, was %v", jsonBackend, wantErr, gotErr)
+			}
+			if wantErr != nil {
+				return
+			}
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%s: expected %+v, was %+v", jsonBackend, want, got)
+			}
+
+			streamed := c.new()
+			if err := decodeJSON(bytes.NewReader([]byte(c.data)), streamed); err != nil {
+				t.Fatalf("%s: decodeJSON: %v", jsonBackend, err)
+			}
+			if !reflect.DeepEqual(want, streamed) {
+				t.Errorf("%s: decodeJSON expected %+v, was %+v", jsonBackend, want, streamed)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !gojson
+// +build !gojson
+
+package maps
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonBackend names the JSON decoding backend selected at build time.
+const jsonBackend = "encoding/json"
+
+// unmarshalJSON decodes data into v using the selected JSON backend.
+func unmarshalJSON(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// decodeJSON decodes the next JSON value in r into v using the selected JSON
+// backend.
+func decodeJSON(r io.Reader, v interface{}) error {
+	return json.NewDecoder(r).Decode(v)
+}
@@ -15,46 +15,391 @@
 package maps
 
 import (
+	"fmt"
 	"math"
+	"net/url"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-// LatLng represents a location on the Earth.
+// LatLng represents a location on the Earth. It is encoded in JSON as
+// {"lat": ..., "lng": ...}, and decoded from either that or the
+// {"latitude": ..., "longitude": ...} form used by the newer APIs, such as
+// AddressValidationLatLng.
 type LatLng struct {
 	Lat float64 `json:"lat"`
 	Lng float64 `json:"lng"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler for LatLng, accepting both the
+// lat/lng and latitude/longitude key names.
+func (l *LatLng) UnmarshalJSON(data []byte) error {
+	// LatLngs are decoded in bulk with every route, so simple objects are
+	// scanned directly rather than with a nested decoder.
+	if lat, lng, ok := scanLatLngJSON(data); ok {
+		l.Lat, l.Lng = lat, lng
+		return nil
+	}
+
+	var x struct {
+		Lat       *float64 `json:"lat"`
+		Lng       *float64 `json:"lng"`
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+	}
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	if x.Lat == nil {
+		x.Lat = x.Latitude
+	}
+	if x.Lng == nil {
+		x.Lng = x.Longitude
+	}
+	if x.Lat != nil {
+		l.Lat = *x.Lat
+	}
+	if x.Lng != nil {
+		l.Lng = *x.Lng
+	}
+	return nil
+}
+
+// scanLatLngJSON scans a JSON object of only numeric lat/lng or
+// latitude/longitude members, without escapes. It returns false for anything
+// else, which must be decoded in full.
+func scanLatLngJSON(data []byte) (lat, lng float64, ok bool) {
+	i := 0
+	skipSpace := func() {
+		for i < len(data) && (data[i] == ' ' || data[i] == '\t' || data[i] == '\n' || data[i] == '\r') {
+			i++
+		}
+	}
+	expect := func(c byte) bool {
+		skipSpace()
+		if i < len(data) && data[i] == c {
+			i++
+			return true
+		}
+		return false
+	}
+
+	if !expect('{') {
+		return 0, 0, false
+	}
+	for n := 0; ; n++ {
+		if n == 0 && expect('}') {
+			return lat, lng, true
+		}
+		if !expect('"') {
+			return 0, 0, false
+		}
+		start := i
+		for i < len(data) && data[i] != '"' && data[i] != '\\' {
+			i++
+		}
+		if i == len(data) || data[i] != '"' {
+			return 0, 0, false
+		}
+		key := data[start:i]
+		i++
+		if !expect(':') {
+			return 0, 0, false
+		}
+		skipSpace()
+		start = i
+		for i < len(data) && (data[i] == '-' || data[i] == '+' || data[i] == '.' || data[i] == 'e' || data[i] == 'E' || (data[i] >= '0' && data[i] <= '9')) {
+			i++
+		}
+		v, err := strconv.ParseFloat(string(data[start:i]), 64)
+		if err != nil || data[start] == '+' {
+			return 0, 0, false
+		}
+		switch string(key) {
+		case "lat", "latitude":
+			lat = v
+		case "lng", "longitude":
+			lng = v
+		default:
+			return 0, 0, false
+		}
+		if expect('}') {
+			skipSpace()
+			return lat, lng, i == len(data)
+		}
+		if !expect(',') {
+			return 0, 0, false
+		}
+	}
+}
+
+// LatLngParseError describes a failure to parse a LatLng.
+type LatLngParseError struct {
+	// Input is the string being parsed.
+	Input string
+	// Offset is the byte offset in Input at which parsing failed.
+	Offset int
+	// Reason describes the failure.
+	Reason string
+}
+
+func (e *LatLngParseError) Error() string {
+	return fmt.Sprintf("maps: invalid LatLng %q at offset %d: %s", e.Input, e.Offset, e.Reason)
+}
+
 // ParseLatLng will parse a string representation of a Lat,Lng pair.
+//
+// The latitude and longitude may be separated by a comma, with optional
+// whitespace around it, or by whitespace alone. Each may be a decimal number
+// of degrees, optionally followed by a hemisphere letter, or in degrees,
+// minutes and seconds notation such as 40°26'46"N 79°58'56"W. geo: URIs and
+// Google Maps URLs containing @lat,lng or a q, query or ll parameter are also
+// accepted. Errors are of type *LatLngParseError.
 func ParseLatLng(location string) (LatLng, error) {
-	l := strings.Split(location, ",")
-	lat, err := strconv.ParseFloat(l[0], 64)
+	text, offset, err := latLngText(location)
 	if err != nil {
 		return LatLng{}, err
 	}
-	lng, err := strconv.ParseFloat(l[1], 64)
-	if err != nil {
-		return LatLng{}, err
-	}
-	return LatLng{Lat: lat, Lng: lng}, nil
+	return parseLatLngAt(location, text, offset)
 }
 
-// ParseLatLngList will parse a string of | separated Lat,Lng pairs.
+// ParseLatLngList will parse a string of | separated Lat,Lng pairs, each in a
+// format accepted by ParseLatLng. Errors are of type *LatLngParseError, with
+// offsets in locations.
 func ParseLatLngList(locations string) ([]LatLng, error) {
 	result := []LatLng{}
 
-	ls := strings.Split(locations, "|")
-	for _, l := range ls {
-		ll, err := ParseLatLng(l)
+	offset := 0
+	for _, l := range strings.Split(locations, "|") {
+		ll, err := parseLatLngAt(locations, l, offset)
 		if err != nil {
 			return []LatLng{}, err
 		}
 		result = append(result, ll)
+		offset += len(l) + 1
 	}
 	return result, nil
 }
 
+// ParseLocationsArg will parse locations in the forms accepted by the
+// locations and path parameters of the Elevation API: an encoded polyline
+// prefixed with "enc:", a | separated list of Lat,Lng pairs, or a single
+// Lat,Lng pair in a format accepted by ParseLatLng.
+func ParseLocationsArg(locations string) ([]LatLng, error) {
+	if strings.HasPrefix(locations, "enc:") {
+		return DecodePolylineErr(locations[len("enc:"):])
+	}
+	if strings.Contains(locations, "|") {
+		return ParseLatLngList(locations)
+	}
+	ll, err := ParseLatLng(locations)
+	if err != nil {
+		return []LatLng{}, err
+	}
+	return []LatLng{ll}, nil
+}
+
+// latLngText returns the text of the coordinates in location, and its offset,
+// extracting it from location if it is a URL.
+func latLngText(location string) (string, int, error) {
+	if strings.HasPrefix(location, "geo:") {
+		text := location[len("geo:"):]
+		if i := strings.IndexAny(text, ";?"); i >= 0 {
+			text = text[:i]
+		}
+		// geo: URIs may include an altitude.
+		if i := strings.IndexByte(text, ','); i >= 0 {
+			if j := strings.IndexByte(text[i+1:], ','); j >= 0 {
+				text = text[:i+1+j]
+			}
+		}
+		return text, len("geo:"), nil
+	}
+	if !strings.Contains(location, "://") {
+		return location, 0, nil
+	}
+
+	if i := strings.IndexByte(location, '@'); i >= 0 {
+		// For example https://www.google.com/maps/@37.42,-122.08,15z.
+		start := i + 1
+		text := location[start:]
+		if j := strings.IndexAny(text, "/?#"); j >= 0 {
+			text = text[:j]
+		}
+		if j := strings.IndexByte(text, ','); j >= 0 {
+			if k := strings.IndexByte(text[j+1:], ','); k >= 0 {
+				text = text[:j+1+k]
+			}
+		}
+		return text, start, nil
+	}
+	if i := strings.IndexByte(location, '?'); i >= 0 {
+		params := location[i+1:]
+		if j := strings.IndexByte(params, '#'); j >= 0 {
+			params = params[:j]
+		}
+		offset := i + 1
+		for _, param := range strings.Split(params, "&") {
+			for _, key := range []string{"q=", "query=", "ll="} {
+				if !strings.HasPrefix(param, key) {
+					continue
+				}
+				start := offset + len(key)
+				text, err := url.QueryUnescape(param[len(key):])
+				if err != nil {
+					return "", 0, &LatLngParseError{Input: location, Offset: start, Reason: err.Error()}
+				}
+				return text, start, nil
+			}
+			offset += len(param) + 1
+		}
+	}
+	return "", 0, &LatLngParseError{Input: location, Offset: 0, Reason: "no coordinates in URL"}
+}
+
+// parseLatLngAt parses text, which is at offset in input, as a LatLng.
+func parseLatLngAt(input, text string, offset int) (LatLng, error) {
+	latText, lngText, lngOffset, ok := splitLatLng(text)
+	if !ok {
+		return LatLng{}, &LatLngParseError{Input: input, Offset: offset, Reason: "expected a latitude and longitude"}
+	}
+	lat, err := parseCoordinate(input, latText, offset, "NS", 90)
+	if err != nil {
+		return LatLng{}, err
+	}
+	lng, err := parseCoordinate(input, lngText, offset+lngOffset, "EW", 180)
+	if err != nil {
+		return LatLng{}, err
+	}
+	return LatLng{Lat: lat, Lng: lng}, nil
+}
+
+// splitLatLng splits text into its latitude and longitude, returning the
+// offset of the longitude in text.
+func splitLatLng(text string) (lat, lng string, lngOffset int, ok bool) {
+	if i := strings.IndexByte(text, ','); i >= 0 {
+		return text[:i], text[i+1:], i + 1, true
+	}
+	// A latitude with a hemisphere ends at the hemisphere, for example
+	// 40° 26' 46" N 79° 58' 56" W.
+	if i := strings.IndexAny(text, "NSns"); i >= 0 && strings.TrimSpace(text[i+1:]) != "" {
+		return text[:i+1], text[i+1:], i + 1, true
+	}
+	fields := strings.Fields(text)
+	if len(fields) != 2 {
+		return "", "", 0, false
+	}
+	i := strings.Index(text, fields[0]) + len(fields[0])
+	return text[:i], text[i:], i, true
+}
+
+// parseCoordinate parses text, which is at offset in input, as a latitude or
+// longitude in degrees, with optional hemisphere letters, positive then
+// negative, and a maximum absolute value.
+func parseCoordinate(input, text string, offset int, hemispheres string, limit float64) (float64, error) {
+	fail := func(at int, reason string) error {
+		return &LatLngParseError{Input: input, Offset: offset + at, Reason: reason}
+	}
+
+	end := len(strings.TrimRightFunc(text, unicode.IsSpace))
+	start := len(text) - len(strings.TrimLeftFunc(text, unicode.IsSpace))
+	if start >= end {
+		return 0, fail(start, "missing coordinate")
+	}
+
+	valueStart := start
+	negative := false
+	hemisphere := false
+	if last := unicode.ToUpper(rune(text[end-1])); strings.ContainsRune(hemispheres, last) {
+		hemisphere = true
+		negative = last == rune(hemispheres[1])
+		end = len(strings.TrimRightFunc(text[:end-1], unicode.IsSpace))
+	}
+	if start < end && (text[start] == '-' || text[start] == '+') {
+		if hemisphere {
+			return 0, fail(start, "sign and hemisphere both specified")
+		}
+		negative = text[start] == '-'
+		start++
+	}
+
+	value, err := parseDegrees(text[start:end])
+	if err != nil {
+		return 0, fail(start+err.offset, err.reason)
+	}
+	if value > limit {
+		return 0, fail(valueStart, fmt.Sprintf("coordinate out of range [-%v, %v]", limit, limit))
+	}
+	if negative {
+		value = -value
+	}
+	return value, nil
+}
+
+type degreesError struct {
+	offset int
+	reason string
+}
+
+// parseDegrees parses an unsigned number of degrees, either decimal or in
+// degrees, minutes and seconds notation.
+func parseDegrees(text string) (float64, *degreesError) {
+	if !strings.ContainsAny(text, "°º'′\"″") {
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil || strings.ContainsAny(text, "+-") || math.IsNaN(value) || math.IsInf(value, 0) {
+			return 0, &degreesError{0, fmt.Sprintf("invalid number %q", text)}
+		}
+		return value, nil
+	}
+
+	var value float64
+	units := []struct {
+		symbols string
+		divisor float64
+	}{
+		{"°º", 1},
+		{"'′", 60},
+		{"\"″", 3600},
+	}
+	unit := 0
+	i := 0
+	for i < len(text) {
+		for i < len(text) && text[i] == ' ' {
+			i++
+		}
+		if i == len(text) {
+			break
+		}
+		j := i
+		for j < len(text) && (text[j] == '.' || (text[j] >= '0' && text[j] <= '9')) {
+			j++
+		}
+		number, err := strconv.ParseFloat(text[i:j], 64)
+		if err != nil {
+			return 0, &degreesError{i, fmt.Sprintf("invalid number %q", text[i:j])}
+		}
+		for j < len(text) && text[j] == ' ' {
+			j++
+		}
+		symbol, size := utf8.DecodeRuneInString(text[j:])
+		for unit < len(units) && !strings.ContainsRune(units[unit].symbols, symbol) {
+			unit++
+		}
+		if unit == len(units) {
+			return 0, &degreesError{j, "expected degrees, minutes or seconds symbol"}
+		}
+		if unit > 0 && number >= 60 {
+			return 0, &degreesError{i, "minutes and seconds must be less than 60"}
+		}
+		value += number / units[unit].divisor
+		unit++
+		i = j + size
+	}
+	return value, nil
+}
+
 func (l *LatLng) String() string {
 	return strconv.FormatFloat(l.Lat, 'f', -1, 64) +
 		"," +
@@ -67,6 +412,21 @@ func (l *LatLng) AlmostEqual(other *LatLng, epsilon float64) bool {
 	return math.Abs(l.Lat-other.Lat) < epsilon && math.Abs(l.Lng-other.Lng) < epsilon
 }
 
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371008.8
+
+// Distance returns the great-circle distance in meters between this LatLng and
+// the other LatLng, using the haversine formula.
+func (l *LatLng) Distance(other *LatLng) float64 {
+	lat1 := l.Lat * math.Pi / 180
+	lat2 := other.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.Lng - l.Lng) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(math.Min(h, 1)))
+}
+
 // LatLngBounds represents a bounded square area on the Earth.
 type LatLngBounds struct {
 	NorthEast LatLng `json:"northeast"`
@@ -14,7 +14,10 @@
 
 package maps
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestParseLatLng(t *testing.T) {
 	expected := &LatLng{Lat: 12.34, Lng: 56.78}
@@ -45,3 +48,153 @@ func TestParseLatLngList(t *testing.T) {
 		t.Errorf("LatLng failed to parse expected value. Actual '%+v', expected '%+v'", actual[1], expected1)
 	}
 }
+
+func TestParseLocationsArg(t *testing.T) {
+	for _, tc := range []struct {
+		arg  string
+		want []LatLng
+	}{
+		{"enc:_p~iF~ps|U_ulLnnqC", []LatLng{{38.5, -120.2}, {40.7, -120.95}}},
+		{"38.5,-120.2|40.7,-120.95", []LatLng{{38.5, -120.2}, {40.7, -120.95}}},
+		{"38.5,-120.2", []LatLng{{38.5, -120.2}}},
+	} {
+		actual, err := ParseLocationsArg(tc.arg)
+		if err != nil {
+			t.Errorf("ParseLocationsArg(%q) failed: %v", tc.arg, err)
+			continue
+		}
+		if len(actual) != len(tc.want) {
+			t.Errorf("ParseLocationsArg(%q) = %v, expected %v", tc.arg, actual, tc.want)
+			continue
+		}
+		for i := range actual {
+			if !actual[i].AlmostEqual(&tc.want[i], 0.0001) {
+				t.Errorf("ParseLocationsArg(%q) = %v, expected %v", tc.arg, actual, tc.want)
+			}
+		}
+	}
+
+	for _, arg := range []string{"", "38.5", "38.5,-120.2|"} {
+		if _, err := ParseLocationsArg(arg); err == nil {
+			t.Errorf("ParseLocationsArg(%q) should have failed", arg)
+		}
+	}
+}
+
+func TestLatLngDistance(t *testing.T) {
+	sydney := &LatLng{Lat: -33.8688, Lng: 151.2093}
+	melbourne := &LatLng{Lat: -37.8136, Lng: 144.9631}
+
+	// The great-circle distance between Sydney and Melbourne is about 714 km.
+	if d := sydney.Distance(melbourne); d < 713000 || d > 715000 {
+		t.Errorf("expected about 714 km, was %f m", d)
+	}
+	if d := melbourne.Distance(sydney); d != sydney.Distance(melbourne) {
+		t.Errorf("expected distance to be symmetric, was %f m", d)
+	}
+	if d := sydney.Distance(sydney); d != 0 {
+		t.Errorf("expected 0 m, was %f m", d)
+	}
+}
+
+func TestParseLatLngFormats(t *testing.T) {
+	tests := []struct {
+		location string
+		expected LatLng
+	}{
+		{"12.34,56.78", LatLng{Lat: 12.34, Lng: 56.78}},
+		{" 12.34 , -56.78 ", LatLng{Lat: 12.34, Lng: -56.78}},
+		{"12.34 56.78", LatLng{Lat: 12.34, Lng: 56.78}},
+		{"12.34\t-56.78", LatLng{Lat: 12.34, Lng: -56.78}},
+		{"12.5S, 56.78W", LatLng{Lat: -12.5, Lng: -56.78}},
+		{`40°26'46"N 79°58'56"W`, LatLng{Lat: 40 + 26.0/60 + 46.0/3600, Lng: -(79 + 58.0/60 + 56.0/3600)}},
+		{`40° 26' N, 79° 58.5' W`, LatLng{Lat: 40 + 26.0/60, Lng: -(79 + 58.5/60)}},
+		{`33°52′S 151°12′E`, LatLng{Lat: -(33 + 52.0/60), Lng: 151 + 12.0/60}},
+		{"geo:37.42,-122.08", LatLng{Lat: 37.42, Lng: -122.08}},
+		{"geo:37.42,-122.08,30;u=35", LatLng{Lat: 37.42, Lng: -122.08}},
+		{"https://www.google.com/maps/@37.42,-122.08,15z", LatLng{Lat: 37.42, Lng: -122.08}},
+		{"https://maps.google.com/?q=37.42%2C-122.08&z=5", LatLng{Lat: 37.42, Lng: -122.08}},
+		{"https://www.google.com/maps/search/?api=1&query=37.42,-122.08", LatLng{Lat: 37.42, Lng: -122.08}},
+	}
+	for _, test := range tests {
+		actual, err := ParseLatLng(test.location)
+		if err != nil {
+			t.Errorf("ParseLatLng(%q) returned error %v", test.location, err)
+			continue
+		}
+		if !actual.AlmostEqual(&test.expected, 0.000001) {
+			t.Errorf("ParseLatLng(%q) = %+v, expected %+v", test.location, actual, test.expected)
+		}
+	}
+}
+
+func TestParseLatLngErrors(t *testing.T) {
+	tests := []struct {
+		location string
+		offset   int
+	}{
+		{"12.34", 0},
+		{"12.34,", 6},
+		{"12.34,abc", 6},
+		{"12.34, 5x", 7},
+		{"91,0", 0},
+		{"0,-181", 2},
+		{"-12.5S,0", 0},
+		{`40°61'N,0`, 4},
+		{`40°26"10'N,0`, 9},
+		{"https://www.google.com/maps/place/Sydney", 0},
+	}
+	for _, test := range tests {
+		_, err := ParseLatLng(test.location)
+		parseErr, ok := err.(*LatLngParseError)
+		if !ok {
+			t.Errorf("ParseLatLng(%q) returned %v, expected a *LatLngParseError", test.location, err)
+			continue
+		}
+		if parseErr.Offset != test.offset {
+			t.Errorf("ParseLatLng(%q) failed at offset %d, expected %d: %v", test.location, parseErr.Offset, test.offset, err)
+		}
+	}
+
+	_, err := ParseLatLngList("1,2|3,4|5,x")
+	if parseErr, ok := err.(*LatLngParseError); !ok || parseErr.Offset != 10 {
+		t.Errorf("expected error at offset 10, was %v", err)
+	}
+}
+
+func TestLatLngUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected LatLng
+	}{
+		{`{"lat": -33.8688, "lng": 151.2093}`, LatLng{Lat: -33.8688, Lng: 151.2093}},
+		{`{"latitude":-33.8688,"longitude":151.2093}`, LatLng{Lat: -33.8688, Lng: 151.2093}},
+		{`{ "lng" : 1e2 , "lat" : 0.5 }`, LatLng{Lat: 0.5, Lng: 100}},
+		{`{}`, LatLng{}},
+		{`{"lat": 1, "lng": 2, "altitude": 3}`, LatLng{Lat: 1, Lng: 2}},
+		{`{"latitude": 1, "longitude": 2, "heading": {"deg": 90}}`, LatLng{Lat: 1, Lng: 2}},
+	}
+	for _, test := range tests {
+		var actual LatLng
+		if err := json.Unmarshal([]byte(test.data), &actual); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error %v", test.data, err)
+			continue
+		}
+		if actual != test.expected {
+			t.Errorf("json.Unmarshal(%s) = %+v, expected %+v", test.data, actual, test.expected)
+		}
+	}
+
+	for _, data := range []string{`{"lat": "1", "lng": 2}`, `{"lat": 1,}`, `{"lat": +1}`, `[1, 2]`} {
+		var actual LatLng
+		if err := json.Unmarshal([]byte(data), &actual); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, expected an error", data, actual)
+		}
+	}
+
+	// The JSON encoding is unchanged.
+	data, _ := json.Marshal(LatLng{Lat: 1, Lng: 2})
+	if string(data) != `{"lat":1,"lng":2}` {
+		t.Errorf("unexpected encoding %s", data)
+	}
+}
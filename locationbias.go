@@ -0,0 +1,152 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// Circle is a circular area on the Earth.
+type Circle struct {
+	// Center is the center of the circle.
+	Center LatLng
+	// Radius is the radius of the circle in meters.
+	Radius float64
+}
+
+// LocationBias biases Places results towards an area, without excluding
+// results outside of it. Exactly one of IP, Point, Circle and Rectangle must be
+// set.
+//
+// LocationBias is sent as the locationbias parameter by the Places API
+// endpoints, and marshals to the JSON of the Places API (New), which only
+// supports Circle and Rectangle.
+type LocationBias struct {
+	// IP biases results towards the location of the caller's IP address.
+	IP bool
+	// Point biases results towards a point.
+	Point *LatLng
+	// Circle biases results towards a circular area.
+	Circle *Circle
+	// Rectangle biases results towards a rectangular area.
+	Rectangle *LatLngBounds
+}
+
+// LocationRestriction restricts Places results to an area. Exactly one of
+// Circle and Rectangle must be set.
+//
+// LocationRestriction is sent as the locationrestriction parameter by the
+// Places API endpoints, and marshals to the JSON of the Places API (New).
+type LocationRestriction struct {
+	// Circle restricts results to a circular area.
+	Circle *Circle
+	// Rectangle restricts results to a rectangular area.
+	Rectangle *LatLngBounds
+}
+
+func (b *LocationBias) validate() error {
+	set := 0
+	for _, ok := range []bool{b.IP, b.Point != nil, b.Circle != nil, b.Rectangle != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("maps: exactly one of IP, Point, Circle and Rectangle required in LocationBias")
+	}
+	if b.Circle != nil && b.Circle.Radius <= 0 {
+		return errors.New("maps: positive Radius required for LocationBias Circle")
+	}
+	return nil
+}
+
+// param returns b in the format of the locationbias parameter.
+func (b *LocationBias) param() string {
+	switch {
+	case b.IP:
+		return "ipbias"
+	case b.Point != nil:
+		return "point:" + b.Point.String()
+	}
+	return areaParam(b.Circle, b.Rectangle)
+}
+
+// MarshalJSON marshals b as a Places API (New) locationBias.
+func (b *LocationBias) MarshalJSON() ([]byte, error) {
+	if b.IP || b.Point != nil {
+		return nil, errors.New("maps: LocationBias IP and Point are not supported by the Places API (New)")
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(areaJSON(b.Circle, b.Rectangle))
+}
+
+func (r *LocationRestriction) validate() error {
+	if (r.Circle == nil) == (r.Rectangle == nil) {
+		return errors.New("maps: exactly one of Circle and Rectangle required in LocationRestriction")
+	}
+	if r.Circle != nil && r.Circle.Radius <= 0 {
+		return errors.New("maps: positive Radius required for LocationRestriction Circle")
+	}
+	return nil
+}
+
+// param returns r in the format of the locationrestriction parameter.
+func (r *LocationRestriction) param() string {
+	return areaParam(r.Circle, r.Rectangle)
+}
+
+// MarshalJSON marshals r as a Places API (New) locationRestriction.
+func (r *LocationRestriction) MarshalJSON() ([]byte, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(areaJSON(r.Circle, r.Rectangle))
+}
+
+// areaParam formats a circle or rectangle as a Places API parameter value.
+func areaParam(circle *Circle, rectangle *LatLngBounds) string {
+	if circle != nil {
+		return "circle:" + strconv.FormatFloat(circle.Radius, 'f', -1, 64) + "@" + circle.Center.String()
+	}
+	return "rectangle:" + rectangle.String()
+}
+
+// areaJSON returns a circle or rectangle in the Places API (New) format.
+func areaJSON(circle *Circle, rectangle *LatLngBounds) interface{} {
+	if circle != nil {
+		var area struct {
+			Circle struct {
+				Center AddressValidationLatLng `json:"center"`
+				Radius float64                 `json:"radius"`
+			} `json:"circle"`
+		}
+		area.Circle.Center = circle.Center.AddressValidationLatLng()
+		area.Circle.Radius = circle.Radius
+		return area
+	}
+	var area struct {
+		Rectangle struct {
+			Low  AddressValidationLatLng `json:"low"`
+			High AddressValidationLatLng `json:"high"`
+		} `json:"rectangle"`
+	}
+	area.Rectangle.Low = rectangle.SouthWest.AddressValidationLatLng()
+	area.Rectangle.High = rectangle.NorthEast.AddressValidationLatLng()
+	return area
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationBiasParam(t *testing.T) {
+	circle := &Circle{Center: LatLng{Lat: 47.69, Lng: -122.22}, Radius: 2000}
+	rectangle := &LatLngBounds{SouthWest: LatLng{Lat: 1, Lng: 2}, NorthEast: LatLng{Lat: 3, Lng: 4}}
+	tests := []struct {
+		bias     LocationBias
+		expected string
+	}{
+		{LocationBias{IP: true}, "ipbias"},
+		{LocationBias{Point: &LatLng{Lat: 1.5, Lng: 2}}, "point:1.5,2"},
+		{LocationBias{Circle: circle}, "circle:2000@47.69,-122.22"},
+		{LocationBias{Rectangle: rectangle}, "rectangle:1,2|3,4"},
+	}
+	for _, test := range tests {
+		assert.NoError(t, test.bias.validate())
+		assert.Equal(t, test.expected, test.bias.param())
+	}
+
+	assert.Error(t, (&LocationBias{}).validate())
+	assert.Error(t, (&LocationBias{IP: true, Circle: circle}).validate())
+	assert.Error(t, (&LocationBias{Circle: &Circle{Center: circle.Center}}).validate())
+	assert.Error(t, (&LocationRestriction{}).validate())
+	assert.Error(t, (&LocationRestriction{Circle: circle, Rectangle: rectangle}).validate())
+	assert.Equal(t, "rectangle:1,2|3,4", (&LocationRestriction{Rectangle: rectangle}).param())
+}
+
+func TestLocationBiasJSON(t *testing.T) {
+	circle := &Circle{Center: LatLng{Lat: 47.69, Lng: -122.22}, Radius: 2000}
+	data, err := json.Marshal(&LocationBias{Circle: circle})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"circle": {"center": {"latitude": 47.69, "longitude": -122.22}, "radius": 2000}}`, string(data))
+
+	rectangle := &LatLngBounds{SouthWest: LatLng{Lat: 1, Lng: 2}, NorthEast: LatLng{Lat: 3, Lng: 4}}
+	data, err = json.Marshal(&LocationRestriction{Rectangle: rectangle})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"rectangle": {"low": {"latitude": 1, "longitude": 2}, "high": {"latitude": 3, "longitude": 4}}}`, string(data))
+
+	_, err = json.Marshal(&LocationBias{IP: true})
+	assert.Error(t, err)
+}
+
+func TestFindPlaceFromTextBias(t *testing.T) {
+	expectedQuery := "input=mongolian+grill&inputtype=textquery&key=AIzaNotReallyAnAPIKey&locationbias=circle%3A2000%4047.6918452%2C-122.2226413"
+	server := mockServerForQuery(expectedQuery, 200, `{"candidates": [], "status": "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	r := &FindPlaceFromTextRequest{
+		Input:     "mongolian grill",
+		InputType: FindPlaceFromTextInputTypeTextQuery,
+		Bias:      &LocationBias{Circle: &Circle{Center: LatLng{Lat: 47.6918452, Lng: -122.2226413}, Radius: 2000}},
+	}
+	_, err := c.FindPlaceFromText(context.Background(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.successful, server.failed)
+
+	r.LocationBias = FindPlaceFromTextLocationBiasIP
+	_, err = c.FindPlaceFromText(context.Background(), r)
+	assert.Error(t, err)
+}
+
+func TestPlaceAutocompleteLocationRestriction(t *testing.T) {
+	expectedQuery := "input=Sydney&key=AIzaNotReallyAnAPIKey&locationrestriction=rectangle%3A-34%2C151%7C-33%2C152"
+	server := mockServerForQuery(expectedQuery, 200, `{"predictions": [], "status": "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	r := &PlaceAutocompleteRequest{
+		Input: "Sydney",
+		LocationRestriction: &LocationRestriction{
+			Rectangle: &LatLngBounds{SouthWest: LatLng{Lat: -34, Lng: 151}, NorthEast: LatLng{Lat: -33, Lng: 152}},
+		},
+	}
+	_, err := c.PlaceAutocomplete(context.Background(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, server.successful, server.failed)
+
+	r.Radius = 1000
+	_, err = c.PlaceAutocomplete(context.Background(), r)
+	assert.Error(t, err)
+}
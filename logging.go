@@ -0,0 +1,112 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Logger is the logger used by a client configured WithLogger. It is
+// satisfied by *slog.Logger, whose DebugContext takes alternating keys and
+// values.
+type Logger interface {
+	DebugContext(ctx context.Context, msg string, args ...interface{})
+}
+
+// redactedParams are the query parameters whose values are not logged.
+var redactedParams = map[string]bool{
+	"key":       true,
+	"signature": true,
+}
+
+// WithLogger configures a Maps API client to log a debug entry for each
+// request it makes with logger, including each attempt of a retried request.
+// Entries have the attributes endpoint, query, with the API key and signature
+// redacted, latency, http_status and, for APIs which report one, maps_status,
+// as well as error if the request failed. Default is not to log.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// logRequest logs a request to config which was started at start and ended
+// with httpResp and err, decoding into resp if it is not nil.
+func (c *Client) logRequest(ctx context.Context, config *apiConfig, start time.Time, httpResp *http.Response, err error, resp interface{}) {
+	if c.logger == nil {
+		return
+	}
+	args := []interface{}{"endpoint", config.path}
+	if httpResp != nil && httpResp.Request != nil {
+		args = append(args, "query", redactQuery(httpResp.Request.URL.Query()))
+	}
+	args = append(args, "latency", c.clock.Now().Sub(start))
+	if httpResp != nil {
+		args = append(args, "http_status", httpResp.StatusCode)
+	}
+	if status := mapsStatus(resp); status != "" && err == nil {
+		args = append(args, "maps_status", status)
+	}
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	c.logger.DebugContext(ctx, "maps: request", args...)
+}
+
+// mapsStatus returns the status reported in resp, or "" if it has none.
+func mapsStatus(resp interface{}) string {
+	if r, ok := resp.(interface{ status() string }); ok {
+		return r.status()
+	}
+	if r, ok := resp.(interface{ StatusError() error }); ok {
+		if status := ErrorStatus(r.StatusError()); status != "" {
+			return status
+		}
+		return "OK"
+	}
+	return ""
+}
+
+func (c *commonResponse) status() string {
+	return c.Status
+}
+
+// redactQuery returns q encoded with the values of redactedParams replaced.
+func redactQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		for _, v := range q[k] {
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			if redactedParams[k] {
+				v = "REDACTED"
+			}
+			b.WriteString(url.QueryEscape(k) + "=" + url.QueryEscape(v))
+		}
+	}
+	return b.String()
+}
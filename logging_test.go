@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger is a Logger which records the attributes of each entry.
+type recordingLogger struct {
+	entries []map[string]interface{}
+}
+
+func (l *recordingLogger) DebugContext(ctx context.Context, msg string, args ...interface{}) {
+	entry := map[string]interface{}{"msg": msg}
+	for i := 0; i+1 < len(args); i += 2 {
+		entry[args[i].(string)] = args[i+1]
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func TestClientWithLogger(t *testing.T) {
+	server := mockServer(200, `{"results" : [], "status" : "ZERO_RESULTS"}`)
+	defer server.Close()
+	logger := &recordingLogger{}
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithLogger(logger))
+
+	_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Nowhere"})
+	require.NoError(t, err)
+	require.Len(t, logger.entries, 1)
+	entry := logger.entries[0]
+	assert.Equal(t, "maps: request", entry["msg"])
+	assert.Equal(t, "/maps/api/geocode/json", entry["endpoint"])
+	assert.Equal(t, "address=Nowhere&key=REDACTED", entry["query"])
+	assert.Equal(t, 200, entry["http_status"])
+	assert.Equal(t, "ZERO_RESULTS", entry["maps_status"])
+	assert.Contains(t, entry, "latency")
+	assert.NotContains(t, entry, "error")
+}
+
+func TestRedactQuery(t *testing.T) {
+	q := url.Values{"signature": {"sig"}, "client": {"gme-x"}, "key": {apiKey}, "address": {"a b"}}
+	assert.Equal(t, "address=a+b&client=gme-x&key=REDACTED&signature=REDACTED", redactQuery(q))
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mapsflag provides flag.Value implementations for the enumerated
+// parameters of Google Maps API requests, so that command line tools can
+// register typed flags which reject unknown values:
+//
+//	mode := mapsflag.Mode(flag.CommandLine, "mode", "The travel mode.")
+//
+// or, to set a request field directly:
+//
+//	flag.Var((*mapsflag.ModeValue)(&r.Mode), "mode", "The travel mode.")
+//
+// Lists, such as avoided features and transit modes, are separated by "|" and
+// field masks by ",". Repeating a list flag appends to it.
+package mapsflag
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"googlemaps.github.io/maps"
+)
+
+// parseEnum returns s if it is one of values.
+func parseEnum(kind, s string, values ...string) (string, error) {
+	for _, v := range values {
+		if s == v {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown %s %q, want one of %s", kind, s, strings.Join(values, ", "))
+}
+
+// split returns the non-empty elements of s separated by sep.
+func split(s, sep string) []string {
+	var elems []string
+	for _, e := range strings.Split(s, sep) {
+		if e = strings.TrimSpace(e); e != "" {
+			elems = append(elems, e)
+		}
+	}
+	return elems
+}
+
+// ModeValue is a flag.Value for a maps.Mode.
+type ModeValue maps.Mode
+
+func (v *ModeValue) String() string { return string(*v) }
+
+func (v *ModeValue) Set(s string) error {
+	mode, err := parseEnum("mode", s, string(maps.TravelModeDriving), string(maps.TravelModeWalking),
+		string(maps.TravelModeBicycling), string(maps.TravelModeTransit))
+	if err != nil {
+		return err
+	}
+	*v = ModeValue(mode)
+	return nil
+}
+
+// Mode defines a maps.Mode flag in fs and returns the address of its value.
+func Mode(fs *flag.FlagSet, name, usage string) *maps.Mode {
+	p := new(maps.Mode)
+	fs.Var((*ModeValue)(p), name, usage)
+	return p
+}
+
+// AvoidValue is a flag.Value for a maps.Avoid.
+type AvoidValue maps.Avoid
+
+func (v *AvoidValue) String() string { return string(*v) }
+
+func (v *AvoidValue) Set(s string) error {
+	avoid, err := parseAvoid(s)
+	if err != nil {
+		return err
+	}
+	*v = AvoidValue(avoid)
+	return nil
+}
+
+func parseAvoid(s string) (maps.Avoid, error) {
+	avoid, err := parseEnum("avoid", s, string(maps.AvoidTolls), string(maps.AvoidHighways), string(maps.AvoidFerries))
+	return maps.Avoid(avoid), err
+}
+
+// Avoid defines a maps.Avoid flag in fs and returns the address of its value.
+func Avoid(fs *flag.FlagSet, name, usage string) *maps.Avoid {
+	p := new(maps.Avoid)
+	fs.Var((*AvoidValue)(p), name, usage)
+	return p
+}
+
+// AvoidsValue is a flag.Value for a list of maps.Avoid separated by "|".
+type AvoidsValue []maps.Avoid
+
+func (v *AvoidsValue) String() string {
+	var s []string
+	for _, avoid := range *v {
+		s = append(s, string(avoid))
+	}
+	return strings.Join(s, "|")
+}
+
+func (v *AvoidsValue) Set(s string) error {
+	for _, e := range split(s, "|") {
+		avoid, err := parseAvoid(e)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, avoid)
+	}
+	return nil
+}
+
+// Avoids defines a flag in fs for a list of maps.Avoid separated by "|", and
+// returns the address of its value.
+func Avoids(fs *flag.FlagSet, name, usage string) *[]maps.Avoid {
+	p := new([]maps.Avoid)
+	fs.Var((*AvoidsValue)(p), name, usage)
+	return p
+}
+
+// UnitsValue is a flag.Value for maps.Units.
+type UnitsValue maps.Units
+
+func (v *UnitsValue) String() string { return string(*v) }
+
+func (v *UnitsValue) Set(s string) error {
+	units, err := parseEnum("units", s, string(maps.UnitsMetric), string(maps.UnitsImperial))
+	if err != nil {
+		return err
+	}
+	*v = UnitsValue(units)
+	return nil
+}
+
+// Units defines a maps.Units flag in fs and returns the address of its value.
+func Units(fs *flag.FlagSet, name, usage string) *maps.Units {
+	p := new(maps.Units)
+	fs.Var((*UnitsValue)(p), name, usage)
+	return p
+}
+
+// TransitModesValue is a flag.Value for a list of maps.TransitMode separated
+// by "|".
+type TransitModesValue []maps.TransitMode
+
+func (v *TransitModesValue) String() string {
+	var s []string
+	for _, mode := range *v {
+		s = append(s, string(mode))
+	}
+	return strings.Join(s, "|")
+}
+
+func (v *TransitModesValue) Set(s string) error {
+	for _, e := range split(s, "|") {
+		mode, err := parseEnum("transit mode", e, string(maps.TransitModeBus), string(maps.TransitModeSubway),
+			string(maps.TransitModeTrain), string(maps.TransitModeTram), string(maps.TransitModeRail))
+		if err != nil {
+			return err
+		}
+		*v = append(*v, maps.TransitMode(mode))
+	}
+	return nil
+}
+
+// TransitModes defines a flag in fs for a list of maps.TransitMode separated
+// by "|", and returns the address of its value.
+func TransitModes(fs *flag.FlagSet, name, usage string) *[]maps.TransitMode {
+	p := new([]maps.TransitMode)
+	fs.Var((*TransitModesValue)(p), name, usage)
+	return p
+}
+
+// PlaceTypeValue is a flag.Value for a maps.PlaceType.
+type PlaceTypeValue maps.PlaceType
+
+func (v *PlaceTypeValue) String() string { return string(*v) }
+
+func (v *PlaceTypeValue) Set(s string) error {
+	placeType, err := maps.ParsePlaceType(s)
+	if err != nil {
+		return err
+	}
+	*v = PlaceTypeValue(placeType)
+	return nil
+}
+
+// PlaceType defines a maps.PlaceType flag in fs and returns the address of
+// its value.
+func PlaceType(fs *flag.FlagSet, name, usage string) *maps.PlaceType {
+	p := new(maps.PlaceType)
+	fs.Var((*PlaceTypeValue)(p), name, usage)
+	return p
+}
+
+// PlaceDetailsFieldsValue is a flag.Value for a list of
+// maps.PlaceDetailsFieldMask separated by ",".
+type PlaceDetailsFieldsValue []maps.PlaceDetailsFieldMask
+
+func (v *PlaceDetailsFieldsValue) String() string {
+	var s []string
+	for _, field := range *v {
+		s = append(s, string(field))
+	}
+	return strings.Join(s, ",")
+}
+
+func (v *PlaceDetailsFieldsValue) Set(s string) error {
+	for _, e := range split(s, ",") {
+		field, err := maps.ParsePlaceDetailsFieldMask(e)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, field)
+	}
+	return nil
+}
+
+// PlaceDetailsFields defines a flag in fs for a list of
+// maps.PlaceDetailsFieldMask separated by ",", and returns the address of its
+// value.
+func PlaceDetailsFields(fs *flag.FlagSet, name, usage string) *[]maps.PlaceDetailsFieldMask {
+	p := new([]maps.PlaceDetailsFieldMask)
+	fs.Var((*PlaceDetailsFieldsValue)(p), name, usage)
+	return p
+}
+
+// PlaceSearchFieldsValue is a flag.Value for a list of
+// maps.PlaceSearchFieldMask separated by ",".
+type PlaceSearchFieldsValue []maps.PlaceSearchFieldMask
+
+func (v *PlaceSearchFieldsValue) String() string {
+	var s []string
+	for _, field := range *v {
+		s = append(s, string(field))
+	}
+	return strings.Join(s, ",")
+}
+
+func (v *PlaceSearchFieldsValue) Set(s string) error {
+	for _, e := range split(s, ",") {
+		field, err := maps.ParsePlaceSearchFieldMask(e)
+		if err != nil {
+			return err
+		}
+		*v = append(*v, field)
+	}
+	return nil
+}
+
+// PlaceSearchFields defines a flag in fs for a list of
+// maps.PlaceSearchFieldMask separated by ",", and returns the address of its
+// value.
+func PlaceSearchFields(fs *flag.FlagSet, name, usage string) *[]maps.PlaceSearchFieldMask {
+	p := new([]maps.PlaceSearchFieldMask)
+	fs.Var((*PlaceSearchFieldsValue)(p), name, usage)
+	return p
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapsflag
+
+import (
+	"flag"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"googlemaps.github.io/maps"
+)
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+func TestFlags(t *testing.T) {
+	fs := newFlagSet()
+	mode := Mode(fs, "mode", "")
+	avoid := Avoid(fs, "avoid", "")
+	avoids := Avoids(fs, "avoids", "")
+	units := Units(fs, "units", "")
+	transitModes := TransitModes(fs, "transit_mode", "")
+	placeType := PlaceType(fs, "type", "")
+	detailsFields := PlaceDetailsFields(fs, "details_fields", "")
+	searchFields := PlaceSearchFields(fs, "search_fields", "")
+
+	err := fs.Parse([]string{
+		"-mode", "transit",
+		"-avoid", "tolls",
+		"-avoids", "tolls|ferries", "-avoids", "highways",
+		"-units", "imperial",
+		"-transit_mode", "bus|rail",
+		"-type", "cafe",
+		"-details_fields", "name,place_id",
+		"-search_fields", "formatted_address",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, test := range []struct {
+		got, want interface{}
+	}{
+		{*mode, maps.TravelModeTransit},
+		{*avoid, maps.AvoidTolls},
+		{*avoids, []maps.Avoid{maps.AvoidTolls, maps.AvoidFerries, maps.AvoidHighways}},
+		{*units, maps.UnitsImperial},
+		{*transitModes, []maps.TransitMode{maps.TransitModeBus, maps.TransitModeRail}},
+		{*placeType, maps.PlaceTypeCafe},
+		{*detailsFields, []maps.PlaceDetailsFieldMask{maps.PlaceDetailsFieldMaskName, maps.PlaceDetailsFieldMaskPlaceID}},
+		{*searchFields, []maps.PlaceSearchFieldMask{maps.PlaceSearchFieldMaskFormattedAddress}},
+	} {
+		if !reflect.DeepEqual(test.got, test.want) {
+			t.Errorf("got %v, want %v", test.got, test.want)
+		}
+	}
+	if got := fs.Lookup("avoids").Value.String(); got != "tolls|ferries|highways" {
+		t.Errorf("avoids String() = %q", got)
+	}
+}
+
+func TestFlagsInvalid(t *testing.T) {
+	for _, args := range [][]string{
+		{"-mode", "flying"},
+		{"-avoids", "tolls|stairs"},
+		{"-units", "furlongs"},
+		{"-transit_mode", "hovercraft"},
+		{"-type", "castle"},
+	} {
+		fs := newFlagSet()
+		mode := Mode(fs, "mode", "")
+		Avoids(fs, "avoids", "")
+		Units(fs, "units", "")
+		TransitModes(fs, "transit_mode", "")
+		PlaceType(fs, "type", "")
+		err := fs.Parse(args)
+		if err == nil {
+			t.Errorf("%v: expected an error", args)
+			continue
+		}
+		if *mode != "" {
+			t.Errorf("%v: mode set to %q", args, *mode)
+		}
+		if args[0] == "-mode" && !strings.Contains(err.Error(), "want one of driving, walking, bicycling, transit") {
+			t.Errorf("%v: unexpected error %v", args, err)
+		}
+	}
+}
+
+func TestFlagVar(t *testing.T) {
+	fs := newFlagSet()
+	r := &maps.DirectionsRequest{}
+	fs.Var((*ModeValue)(&r.Mode), "mode", "")
+	fs.Var((*AvoidsValue)(&r.Avoid), "avoid", "")
+	if err := fs.Parse([]string{"-mode", "walking", "-avoid", "ferries"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if r.Mode != maps.TravelModeWalking || !reflect.DeepEqual(r.Avoid, []maps.Avoid{maps.AvoidFerries}) {
+		t.Errorf("Unexpected request %+v", r)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mapstest records responses from the Google Maps APIs to fixture
+// files, and replays them from a local server, so that code using the maps
+// package can be unit tested deterministically and offline.
+//
+// Record the fixtures once with real credentials:
+//
+//	rec := &mapstest.Recorder{Dir: "testdata/fixtures"}
+//	c, _ := maps.NewClient(maps.WithAPIKey(key), maps.WithHTTPClient(&http.Client{Transport: rec}))
+//
+// and then replay them in tests:
+//
+//	server := mapstest.NewReplayServer("testdata/fixtures")
+//	defer server.Close()
+//	c, _ := maps.NewClient(maps.WithAPIKey("fake"), maps.WithBaseURL(server.URL))
+//
+// Credentials are removed from the URLs of requests before they are recorded
+// or matched, so fixtures contain no API keys or signatures.
+package mapstest // import "googlemaps.github.io/maps/mapstest"
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// credentialParams are the query parameters removed by NormalizeURL.
+var credentialParams = map[string]bool{
+	"key":       true,
+	"client":    true,
+	"signature": true,
+}
+
+// unrecordedHeaders are the response headers which are not recorded, as they
+// vary between responses or are meaningless when replayed.
+var unrecordedHeaders = map[string]bool{
+	"Alt-Svc":        true,
+	"Content-Length": true,
+	"Date":           true,
+	"Expires":        true,
+	"Server-Timing":  true,
+	"Set-Cookie":     true,
+}
+
+// NormalizeURL returns the path and query of u, without credentials and with
+// the parameters sorted, which identifies the request in fixtures.
+func NormalizeURL(u *url.URL) string {
+	q := u.Query()
+	for k := range q {
+		if credentialParams[k] {
+			q.Del(k)
+		}
+	}
+	if len(q) == 0 {
+		return u.EscapedPath()
+	}
+	return u.EscapedPath() + "?" + q.Encode()
+}
+
+// Fixture is a recorded response to a request.
+type Fixture struct {
+	// Method is the method of the request.
+	Method string `json:"method"`
+	// URL is the request URL, as normalized by NormalizeURL.
+	URL string `json:"url"`
+	// RequestBody is the body of the request, if any.
+	RequestBody string `json:"requestBody,omitempty"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header is the header of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response, if it is UTF-8 text.
+	Body string `json:"body,omitempty"`
+	// BinaryBody is the body of the response, if it is not UTF-8 text, such
+	// as an image.
+	BinaryBody []byte `json:"binaryBody,omitempty"`
+}
+
+// FixtureName returns the file name of the fixture of a request with method,
+// URL normalized by NormalizeURL, and body.
+func FixtureName(method, normalizedURL string, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", method, normalizedURL)
+	h.Write(body)
+	return strings.ToLower(method) + "-" + hex.EncodeToString(h.Sum(nil)[:8]) + ".json"
+}
+
+// readBody reads and replaces the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Recorder is an http.RoundTripper which makes requests with Base and saves
+// each response as a fixture in Dir, replacing any earlier fixture of the
+// same request.
+type Recorder struct {
+	// Base makes the requests. Optional, default is http.DefaultTransport.
+	Base http.RoundTripper
+	// Dir is the directory fixtures are saved in. It is created if needed.
+	// Required.
+	Dir string
+}
+
+// RoundTrip makes req with Base and records the response.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := r.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	reqBody, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	f := Fixture{
+		Method:      req.Method,
+		URL:         NormalizeURL(req.URL),
+		RequestBody: string(reqBody),
+		StatusCode:  resp.StatusCode,
+		Header:      http.Header{},
+	}
+	for k, v := range resp.Header {
+		if !unrecordedHeaders[k] {
+			f.Header[k] = v
+		}
+	}
+	if utf8.Valid(body) {
+		f.Body = string(body)
+	} else {
+		f.BinaryBody = body
+	}
+	if err := r.save(&f, reqBody); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *Recorder) save(f *Fixture, reqBody []byte) error {
+	if err := os.MkdirAll(r.Dir, 0755); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(r.Dir, FixtureName(f.Method, f.URL, reqBody))
+	return ioutil.WriteFile(name, append(b, '\n'), 0644)
+}
+
+// LoadFixtures reads the fixtures in dir.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var fixtures []Fixture
+	for _, name := range names {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		var f Fixture
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("mapstest: %s: %v", name, err)
+		}
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// Replayer is an http.Handler which responds to requests with the matching
+// fixture, or 404 Not Found if there is none.
+type Replayer struct {
+	fixtures map[string]Fixture
+}
+
+// NewReplayer returns a Replayer of fixtures.
+func NewReplayer(fixtures []Fixture) *Replayer {
+	r := &Replayer{fixtures: make(map[string]Fixture)}
+	for _, f := range fixtures {
+		r.fixtures[FixtureName(f.Method, f.URL, []byte(f.RequestBody))] = f
+	}
+	return r
+}
+
+// ServeHTTP responds to req with its fixture.
+func (r *Replayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := readBody(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	u := NormalizeURL(req.URL)
+	f, ok := r.fixtures[FixtureName(req.Method, u, body)]
+	if !ok {
+		http.Error(w, fmt.Sprintf("mapstest: no fixture for %s %s", req.Method, u), http.StatusNotFound)
+		return
+	}
+	for k, v := range f.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(f.StatusCode)
+	if f.BinaryBody != nil {
+		w.Write(f.BinaryBody)
+	} else {
+		w.Write([]byte(f.Body))
+	}
+}
+
+// NewReplayServer returns a started server which replays the fixtures in dir,
+// for use with maps.WithBaseURL. It panics if the fixtures cannot be read.
+func NewReplayServer(dir string) *httptest.Server {
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		panic(err)
+	}
+	return httptest.NewServer(NewReplayer(fixtures))
+}
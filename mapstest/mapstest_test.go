@@ -0,0 +1,111 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapstest
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"googlemaps.github.io/maps"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	u, _ := url.Parse("https://maps.googleapis.com/maps/api/geocode/json?key=secret&address=Sydney&client=c&signature=s&language=en")
+	if got, want := NormalizeURL(u), "/maps/api/geocode/json?address=Sydney&language=en"; got != want {
+		t.Errorf("NormalizeURL was %q, expected %q", got, want)
+	}
+	u, _ = url.Parse("https://places.googleapis.com/v1/places/abc?key=secret")
+	if got, want := NormalizeURL(u), "/v1/places/abc"; got != want {
+		t.Errorf("NormalizeURL was %q, expected %q", got, want)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/maps/api/geocode/json":
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			fmt.Fprintf(w, `{"status": "OK", "results": [{"formatted_address": "%s NSW", "place_id": "abc"}]}`, r.URL.Query().Get("address"))
+		case "/maps/api/staticmap":
+			w.Header().Set("Content-Type", "image/png")
+			png.Encode(w, image.NewRGBA(image.Rect(0, 0, 64, 32)))
+		}
+	}))
+	defer live.Close()
+	dir, err := ioutil.TempDir("", "mapstest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	rec := &Recorder{Dir: dir}
+	c, err := maps.NewClient(maps.WithAPIKey("AIzaRealSecretKey"), maps.WithBaseURL(live.URL), maps.WithHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Geocode(ctx, &maps.GeocodingRequest{Address: "Sydney"}); err != nil {
+		t.Fatalf("Geocode failed while recording: %v", err)
+	}
+	if _, err := c.StaticMap(ctx, &maps.StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "64x32"}); err != nil {
+		t.Fatalf("StaticMap failed while recording: %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 2 {
+		t.Fatalf("expected 2 fixtures, were %v", files)
+	}
+	for _, name := range files {
+		b, _ := ioutil.ReadFile(name)
+		if strings.Contains(string(b), "AIzaRealSecretKey") {
+			t.Errorf("fixture %s contains the API key", name)
+		}
+	}
+
+	server := NewReplayServer(dir)
+	defer server.Close()
+	c, err = maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Geocode(ctx, &maps.GeocodingRequest{Address: "Sydney"})
+	if err != nil {
+		t.Fatalf("Geocode failed while replaying: %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].FormattedAddress != "Sydney NSW" {
+		t.Errorf("unexpected replayed response %+v", resp)
+	}
+	img, err := c.StaticMap(ctx, &maps.StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "64x32"})
+	if err != nil {
+		t.Fatalf("StaticMap failed while replaying: %v", err)
+	}
+	if img.Bounds() != image.Rect(0, 0, 64, 32) {
+		t.Errorf("unexpected replayed image bounds %v", img.Bounds())
+	}
+
+	if _, err := c.Geocode(ctx, &maps.GeocodingRequest{Address: "Melbourne"}); err == nil {
+		t.Errorf("expected a request without a fixture to fail")
+	}
+}
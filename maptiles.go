@@ -0,0 +1,287 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var mapTilesCreateSessionAPI = &apiConfig{
+	host:             "https://tile.googleapis.com",
+	path:             "/v1/createSession",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+var mapTiles2DAPI = &apiConfig{
+	host:             "https://tile.googleapis.com",
+	path:             "/v1/2dtiles/",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+var mapTilesViewportAPI = &apiConfig{
+	host:             "https://tile.googleapis.com",
+	path:             "/tile/v1/viewport",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// MapTilesMapType is the type of base map of a Map Tiles API session.
+type MapTilesMapType string
+
+// The types of base map.
+const (
+	MapTilesRoadmap   = MapTilesMapType("roadmap")
+	MapTilesSatellite = MapTilesMapType("satellite")
+	MapTilesTerrain   = MapTilesMapType("terrain")
+)
+
+// MapTilesLayerType is a layer added to the base map of a Map Tiles API
+// session.
+type MapTilesLayerType string
+
+// The types of layer.
+const (
+	MapTilesLayerRoadmap    = MapTilesLayerType("layerRoadmap")
+	MapTilesLayerStreetview = MapTilesLayerType("layerStreetview")
+	MapTilesLayerTraffic    = MapTilesLayerType("layerTraffic")
+)
+
+// MapTilesScale scales up the size of map elements, such as labels, while
+// keeping the tile size.
+type MapTilesScale string
+
+// The scale factors.
+const (
+	MapTilesScale1x = MapTilesScale("scaleFactor1x")
+	MapTilesScale2x = MapTilesScale("scaleFactor2x")
+	MapTilesScale4x = MapTilesScale("scaleFactor4x")
+)
+
+// MapTilesSessionRequest is the request structure for the Map Tiles API
+// createSession method.
+type MapTilesSessionRequest struct {
+	// MapType is the type of base map. Required.
+	MapType MapTilesMapType `json:"mapType"`
+	// Language is the IETF language tag of the information displayed on the
+	// tiles, such as en-US. Required.
+	Language string `json:"language"`
+	// Region is the CLDR region identifier of the user, such as US. Required.
+	Region string `json:"region"`
+	// ImageFormat is the file format of the tiles, either jpeg or png.
+	// Optional.
+	ImageFormat string `json:"imageFormat,omitempty"`
+	// Scale scales up the size of map elements. Optional.
+	Scale MapTilesScale `json:"scale,omitempty"`
+	// HighDPI requests tiles of 512x512 pixels rather than 256x256. Optional.
+	HighDPI bool `json:"highDpi,omitempty"`
+	// LayerTypes are the layers added to the base map. Optional.
+	LayerTypes []MapTilesLayerType `json:"layerTypes,omitempty"`
+	// Styles are JSON style objects customizing the appearance of the map.
+	// Optional.
+	Styles []json.RawMessage `json:"styles,omitempty"`
+	// Overlay makes the layers render as a separate overlay without the base
+	// map. Optional.
+	Overlay bool `json:"overlay,omitempty"`
+}
+
+// MapTilesSession is a Map Tiles API session. Its token must be passed to all
+// tile and viewport requests.
+type MapTilesSession struct {
+	// Session is the session token.
+	Session string `json:"session"`
+	// Expiry is the time the session token expires, in seconds since the Unix
+	// epoch.
+	Expiry int64 `json:"expiry,string"`
+	// TileWidth is the width of the tiles in pixels.
+	TileWidth int `json:"tileWidth"`
+	// TileHeight is the height of the tiles in pixels.
+	TileHeight int `json:"tileHeight"`
+	// ImageFormat is the file format of the tiles.
+	ImageFormat string `json:"imageFormat"`
+}
+
+// ExpiresAt returns the time the session token expires.
+func (s *MapTilesSession) ExpiresAt() time.Time {
+	return time.Unix(s.Expiry, 0)
+}
+
+// CreateMapTilesSession makes a Map Tiles API createSession request, which
+// returns a session token for tile requests. Session tokens are valid for two
+// weeks and should be reused rather than created for each tile.
+func (c *Client) CreateMapTilesSession(ctx context.Context, r *MapTilesSessionRequest) (*MapTilesSession, error) {
+	if r.MapType == "" {
+		return nil, errors.New("maps: MapType missing")
+	}
+	if r.Language == "" {
+		return nil, errors.New("maps: Language missing")
+	}
+	if r.Region == "" {
+		return nil, errors.New("maps: Region missing")
+	}
+
+	var response struct {
+		MapTilesSession
+		googleAPIResponse
+	}
+	if err := c.postJSON(ctx, mapTilesCreateSessionAPI, r, &response); err != nil {
+		return nil, err
+	}
+	if err := response.StatusError(); err != nil {
+		return nil, err
+	}
+	return &response.MapTilesSession, nil
+}
+
+// MapTileRequest is the request structure for the Map Tiles API 2D tile
+// request.
+type MapTileRequest struct {
+	// Session is the session token from CreateMapTilesSession. Required.
+	Session string
+	// Zoom is the zoom level of the tile.
+	Zoom int
+	// X is the column of the tile, counting east from the antimeridian.
+	X int
+	// Y is the row of the tile, counting south from the north edge of the map.
+	Y int
+}
+
+func (r *MapTileRequest) resourcePath() string {
+	return fmt.Sprintf("%d/%d/%d", r.Zoom, r.X, r.Y)
+}
+
+func (r *MapTileRequest) params() url.Values {
+	q := make(url.Values)
+	q.Set("session", r.Session)
+	return q
+}
+
+// MapTileResponse is a response to the Map Tiles API 2D tile request.
+type MapTileResponse struct {
+	// ContentType is the server reported type of the Image.
+	ContentType string
+	// Data is the server returned image data. You must close this after you are
+	// finished.
+	Data io.ReadCloser
+}
+
+// Image will read and close response.Data and return it as an image.
+func (resp *MapTileResponse) Image() (image.Image, error) {
+	defer resp.Data.Close()
+	if !strings.HasPrefix(resp.ContentType, "image/") {
+		return nil, errors.New("Image of unknown format: " + resp.ContentType)
+	}
+	img, _, err := image.Decode(resp.Data)
+	return img, err
+}
+
+// GetTile makes a Map Tiles API 2D tile request.
+func (c *Client) GetTile(ctx context.Context, r *MapTileRequest) (MapTileResponse, error) {
+	if r.Session == "" {
+		return MapTileResponse{}, errors.New("maps: Session missing")
+	}
+	if r.Zoom < 0 || r.X < 0 || r.Y < 0 || r.X >= 1<<uint(r.Zoom) || r.Y >= 1<<uint(r.Zoom) {
+		return MapTileResponse{}, fmt.Errorf("maps: no tile %d/%d/%d", r.Zoom, r.X, r.Y)
+	}
+
+	resp, err := c.getBinary(ctx, mapTiles2DAPI, r)
+	if err != nil {
+		return MapTileResponse{}, err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		defer resp.data.Close()
+		b, err := ioutil.ReadAll(resp.data)
+		if err != nil {
+			return MapTileResponse{}, err
+		}
+		return MapTileResponse{}, httpStatusError("Map Tiles API", resp, b)
+	}
+
+	return MapTileResponse{resp.contentType, resp.data}, nil
+}
+
+// MapTilesViewportRequest is the request structure for the Map Tiles API
+// viewport request.
+type MapTilesViewportRequest struct {
+	// Session is the session token from CreateMapTilesSession. Required.
+	Session string
+	// Zoom is the zoom level of the viewport.
+	Zoom int
+	// Bounds is the area of the viewport. Required.
+	Bounds LatLngBounds
+}
+
+func (r *MapTilesViewportRequest) params() url.Values {
+	q := make(url.Values)
+	q.Set("session", r.Session)
+	q.Set("zoom", strconv.Itoa(r.Zoom))
+	q.Set("north", strconv.FormatFloat(r.Bounds.NorthEast.Lat, 'f', -1, 64))
+	q.Set("south", strconv.FormatFloat(r.Bounds.SouthWest.Lat, 'f', -1, 64))
+	q.Set("east", strconv.FormatFloat(r.Bounds.NorthEast.Lng, 'f', -1, 64))
+	q.Set("west", strconv.FormatFloat(r.Bounds.SouthWest.Lng, 'f', -1, 64))
+	return q
+}
+
+// MapTilesViewport is the metadata of a viewport of a Map Tiles API session.
+type MapTilesViewport struct {
+	// Copyright is the attribution that must be displayed with the tiles of
+	// the viewport.
+	Copyright string `json:"copyright"`
+	// MaxZoomRects are the areas of the viewport with the maximum zoom level
+	// tiles are available at within them.
+	MaxZoomRects []MaxZoomRect `json:"maxZoomRects"`
+}
+
+// MaxZoomRect is an area with the maximum zoom level tiles are available at.
+type MaxZoomRect struct {
+	MaxZoom int     `json:"maxZoom"`
+	North   float64 `json:"north"`
+	South   float64 `json:"south"`
+	East    float64 `json:"east"`
+	West    float64 `json:"west"`
+}
+
+// MapTilesViewport makes a Map Tiles API viewport request, which returns the
+// attribution and available zoom levels of an area.
+func (c *Client) MapTilesViewport(ctx context.Context, r *MapTilesViewportRequest) (*MapTilesViewport, error) {
+	if r.Session == "" {
+		return nil, errors.New("maps: Session missing")
+	}
+
+	var response struct {
+		MapTilesViewport
+		googleAPIResponse
+	}
+	if err := c.getJSON(ctx, mapTilesViewportAPI, r, &response); err != nil {
+		return nil, err
+	}
+	if err := response.StatusError(); err != nil {
+		return nil, err
+	}
+	return &response.MapTilesViewport, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMapTilesSession(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/createSession", r.URL.Path)
+		assert.Equal(t, "key=AIzaNotReallyAnAPIKey", r.URL.RawQuery)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"session": "IgAAAHGU9jnAU4KOAfwY3Bcd6eH_WxQsyocSBAdUnAr", "expiry": "1361469600", "tileWidth": 256, "imageFormat": "png", "tileHeight": 256}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	session, err := c.CreateMapTilesSession(context.Background(), &MapTilesSessionRequest{
+		MapType:    MapTilesRoadmap,
+		Language:   "en-US",
+		Region:     "US",
+		LayerTypes: []MapTilesLayerType{MapTilesLayerTraffic},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"mapType":    "roadmap",
+		"language":   "en-US",
+		"region":     "US",
+		"layerTypes": []interface{}{"layerTraffic"},
+	}, body)
+	assert.Equal(t, "IgAAAHGU9jnAU4KOAfwY3Bcd6eH_WxQsyocSBAdUnAr", session.Session)
+	assert.Equal(t, time.Date(2013, 2, 21, 18, 0, 0, 0, time.UTC), session.ExpiresAt().UTC())
+	assert.Equal(t, 256, session.TileWidth)
+
+	_, err = c.CreateMapTilesSession(context.Background(), &MapTilesSessionRequest{MapType: MapTilesRoadmap, Language: "en-US"})
+	assert.Error(t, err)
+}
+
+func TestGetTile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/2dtiles/2/1/3" {
+			http.Error(w, `{"error": {"code": 404, "message": "Tile not found", "status": "NOT_FOUND"}}`, 404)
+			return
+		}
+		assert.Equal(t, "key=AIzaNotReallyAnAPIKey&session=abc", r.URL.RawQuery)
+		w.Header().Set("Content-Type", "image/png")
+		png.Encode(w, image.NewRGBA(image.Rect(0, 0, 256, 256)))
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	resp, err := c.GetTile(context.Background(), &MapTileRequest{Session: "abc", Zoom: 2, X: 1, Y: 3})
+	require.NoError(t, err)
+	img, err := resp.Image()
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 256, 256), img.Bounds())
+
+	_, err = c.GetTile(context.Background(), &MapTileRequest{Session: "abc", Zoom: 2, X: 1, Y: 2})
+	assert.Error(t, err)
+	_, err = c.GetTile(context.Background(), &MapTileRequest{Session: "abc", Zoom: 2, X: 4, Y: 0})
+	assert.Error(t, err)
+	_, err = c.GetTile(context.Background(), &MapTileRequest{Zoom: 2})
+	assert.Error(t, err)
+}
+
+func TestMapTilesViewport(t *testing.T) {
+	response := `{
+		"copyright": "Map data ©2023",
+		"maxZoomRects": [{"maxZoom": 19, "north": 90, "south": -90, "east": 180, "west": -180}]
+	}`
+	server := mockServerForQuery("east=-117.5&key=AIzaNotReallyAnAPIKey&north=35.5&session=abc&south=33.5&west=-119.5&zoom=6", 200, response)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	viewport, err := c.MapTilesViewport(context.Background(), &MapTilesViewportRequest{
+		Session: "abc",
+		Zoom:    6,
+		Bounds: LatLngBounds{
+			NorthEast: LatLng{Lat: 35.5, Lng: -117.5},
+			SouthWest: LatLng{Lat: 33.5, Lng: -119.5},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &MapTilesViewport{
+		Copyright:    "Map data ©2023",
+		MaxZoomRects: []MaxZoomRect{{MaxZoom: 19, North: 90, South: -90, East: 180, West: -180}},
+	}, viewport)
+}
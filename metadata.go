@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/http"
+)
+
+// ResponseMetadata describes the HTTP response to an API call.
+type ResponseMetadata struct {
+	// HTTPStatus is the HTTP status code of the response.
+	HTTPStatus int
+	// MetroArea is the metro area the request was attributed to, from the
+	// x-goog-maps-metro-area header.
+	MetroArea string
+	// RequestID identifies the request to Google support, from the
+	// X-Goog-Request-Id header.
+	RequestID string
+	// Header is the HTTP response header.
+	Header http.Header
+}
+
+// CallResponseMetadata makes calls record the metadata of their response in
+// md. If a call is retried, md describes the last response. md is not
+// updated for responses served from the client's Cache, and must not be
+// shared by concurrent calls.
+//
+//	var md maps.ResponseMetadata
+//	ctx = maps.WithCallOptions(ctx, maps.CallResponseMetadata(&md))
+//	resp, err := c.Geocode(ctx, r)
+//	log.Printf("metro area %s", md.MetroArea)
+func CallResponseMetadata(md *ResponseMetadata) CallOption {
+	return func(o *callOptions) {
+		o.metadata = md
+	}
+}
+
+// recordResponseMetadata records the metadata of resp in the ResponseMetadata
+// set in ctx with CallResponseMetadata, if any.
+func recordResponseMetadata(ctx context.Context, resp *http.Response) {
+	o := callOptionsFromContext(ctx)
+	if o == nil || o.metadata == nil {
+		return
+	}
+	*o.metadata = ResponseMetadata{
+		HTTPStatus: resp.StatusCode,
+		MetroArea:  resp.Header.Get("x-goog-maps-metro-area"),
+		RequestID:  resp.Header.Get("X-Goog-Request-Id"),
+		Header:     resp.Header,
+	}
+}
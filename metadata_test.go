@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallResponseMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.Header().Set("x-goog-maps-metro-area", "Sydney, NSW")
+		w.Header().Set("X-Goog-Request-Id", "abc123")
+		w.Write([]byte(`{"results": [], "status": "ZERO_RESULTS"}`))
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	var md ResponseMetadata
+	ctx := WithCallOptions(context.Background(), CallResponseMetadata(&md))
+	ctx = WithCallOptions(ctx, CallHeader("X-Test", "1"))
+	_, err := c.Geocode(ctx, &GeocodingRequest{Address: "Nowhere"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, md.HTTPStatus)
+	assert.Equal(t, "Sydney, NSW", md.MetroArea)
+	assert.Equal(t, "abc123", md.RequestID)
+	assert.Equal(t, "application/json; charset=UTF-8", md.Header.Get("Content-Type"))
+
+}
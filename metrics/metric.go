@@ -13,6 +13,14 @@ type Request interface {
 	EndRequest(ctx context.Context, err error, httpResp *http.Response, metro string)
 }
 
+// BodyInspector is optionally implemented by a Request to inspect the raw body
+// of a response before it is decoded, for example to log malformed payloads.
+// OnBeforeDecode may read httpResp.Body freely; the client decodes the
+// response from its own copy of the body.
+type BodyInspector interface {
+	OnBeforeDecode(ctx context.Context, httpResp *http.Response)
+}
+
 type NoOpReporter struct {
 }
 
@@ -3,6 +3,7 @@ package metrics_test
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -69,3 +70,50 @@ func TestClientWithMetricReporter(t *testing.T) {
 		t.Errorf("expected one end call")
 	}
 }
+
+type inspectingReporter struct {
+	bodies []string
+}
+
+func (r *inspectingReporter) NewRequest(name string) metrics.Request {
+	return &inspectingMetric{reporter: r}
+}
+
+type inspectingMetric struct {
+	reporter *inspectingReporter
+}
+
+func (m *inspectingMetric) OnBeforeDecode(ctx context.Context, httpResp *http.Response) {
+	body, _ := ioutil.ReadAll(httpResp.Body)
+	m.reporter.bodies = append(m.reporter.bodies, string(body))
+}
+
+func (m *inspectingMetric) EndRequest(ctx context.Context, err error, httpResp *http.Response, metro string) {
+}
+
+func TestClientWithBodyInspector(t *testing.T) {
+	body := `{"results" : [{"elevation" : 1608.6}], "status" : "OK"}`
+	server := mockServer([]int{200}, body)
+	defer server.Close()
+	reporter := &inspectingReporter{}
+	c, err := maps.NewClient(
+		maps.WithAPIKey("AIza-Maps-API-Key"),
+		maps.WithBaseURL(server.URL),
+		maps.WithMetricReporter(reporter))
+	if err != nil {
+		t.Errorf("Unable to create client with MetricReporter")
+	}
+	r := &maps.ElevationRequest{
+		Locations: []maps.LatLng{{Lat: 39.73915360, Lng: -104.9847034}},
+	}
+	resp, err := c.Elevation(context.Background(), r)
+	if err != nil {
+		t.Errorf("r.Get returned non nil error, was %+v", err)
+	}
+	if len(resp) != 1 || resp[0].Elevation != 1608.6 {
+		t.Errorf("expected the response to be decoded after inspection, was %+v", resp)
+	}
+	if len(reporter.bodies) != 1 || reporter.bodies[0] != body+"\n" {
+		t.Errorf("expected the raw body to be inspected, was %q", reporter.bodies)
+	}
+}
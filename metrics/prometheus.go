@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPrometheusBuckets are the upper bounds, in seconds, of the latency
+// histogram buckets of a PrometheusReporter.
+var DefaultPrometheusBuckets = []float64{0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusReporter is a Reporter which keeps request counts, latency
+// histograms and error counts per API path, and serves them in the Prometheus
+// text exposition format as an http.Handler:
+//
+//	maps_client_requests_total{api, http_code}
+//	maps_client_request_duration_seconds{api}
+//	maps_client_errors_total{api, status}
+//
+// The status of an error is the API status, such as OVER_QUERY_LIMIT, or
+// CANCELLED, DEADLINE_EXCEEDED or UNKNOWN for errors without one.
+type PrometheusReporter struct {
+	buckets  []float64
+	now      func() time.Time
+	mu       sync.Mutex
+	requests map[[2]string]uint64
+	errors   map[[2]string]uint64
+	latency  map[string]*histogram
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewPrometheusReporter returns a PrometheusReporter with latency histogram
+// buckets, in seconds, or DefaultPrometheusBuckets if there are none.
+func NewPrometheusReporter(buckets ...float64) *PrometheusReporter {
+	if len(buckets) == 0 {
+		buckets = DefaultPrometheusBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &PrometheusReporter{
+		buckets:  buckets,
+		now:      time.Now,
+		requests: make(map[[2]string]uint64),
+		errors:   make(map[[2]string]uint64),
+		latency:  make(map[string]*histogram),
+	}
+}
+
+func (p *PrometheusReporter) NewRequest(name string) Request {
+	return &prometheusRequest{reporter: p, name: name, start: p.now()}
+}
+
+type prometheusRequest struct {
+	reporter *PrometheusReporter
+	name     string
+	start    time.Time
+}
+
+func (r *prometheusRequest) EndRequest(ctx context.Context, err error, httpResp *http.Response, metro string) {
+	p := r.reporter
+	seconds := p.now().Sub(r.start).Seconds()
+	httpCodeStr := ""
+	if httpResp != nil {
+		httpCodeStr = strconv.Itoa(httpResp.StatusCode)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests[[2]string{r.name, httpCodeStr}]++
+	if err != nil {
+		p.errors[[2]string{r.name, errorStatus(err)}]++
+	}
+	h, ok := p.latency[r.name]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.latency[r.name] = h
+	}
+	for i, bound := range p.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// errorStatus returns the API status of err, such as OVER_QUERY_LIMIT.
+func errorStatus(err error) string {
+	var statusErr interface{ Status() string }
+	switch {
+	case errors.As(err, &statusErr) && statusErr.Status() != "":
+		return statusErr.Status()
+	case errors.Is(err, context.Canceled):
+		return "CANCELLED"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "DEADLINE_EXCEEDED"
+	}
+	return "UNKNOWN"
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (p *PrometheusReporter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text exposition format.
+func (p *PrometheusReporter) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var b strings.Builder
+
+	b.WriteString("# HELP maps_client_requests_total Requests made to the Google Maps APIs.\n")
+	b.WriteString("# TYPE maps_client_requests_total counter\n")
+	for _, k := range sortedKeys(p.requests) {
+		fmt.Fprintf(&b, "maps_client_requests_total{api=%q,http_code=%q} %d\n", k[0], k[1], p.requests[k])
+	}
+
+	b.WriteString("# HELP maps_client_errors_total Requests to the Google Maps APIs which failed, by status.\n")
+	b.WriteString("# TYPE maps_client_errors_total counter\n")
+	for _, k := range sortedKeys(p.errors) {
+		fmt.Fprintf(&b, "maps_client_errors_total{api=%q,status=%q} %d\n", k[0], k[1], p.errors[k])
+	}
+
+	b.WriteString("# HELP maps_client_request_duration_seconds Latency of requests to the Google Maps APIs.\n")
+	b.WriteString("# TYPE maps_client_request_duration_seconds histogram\n")
+	var names []string
+	for name := range p.latency {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		h := p.latency[name]
+		for i, bound := range p.buckets {
+			fmt.Fprintf(&b, "maps_client_request_duration_seconds_bucket{api=%q,le=%q} %d\n", name, strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(&b, "maps_client_request_duration_seconds_bucket{api=%q,le=\"+Inf\"} %d\n", name, h.count)
+		fmt.Fprintf(&b, "maps_client_request_duration_seconds_sum{api=%q} %s\n", name, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "maps_client_request_duration_seconds_count{api=%q} %d\n", name, h.count)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func sortedKeys(m map[[2]string]uint64) [][2]string {
+	keys := make([][2]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	return keys
+}
@@ -0,0 +1,49 @@
+package metrics_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"googlemaps.github.io/maps"
+	"googlemaps.github.io/maps/metrics"
+)
+
+func TestClientWithPrometheus(t *testing.T) {
+	server := mockServer([]int{200, 200}, `{"results" : [], "status" : "OK"}`)
+	defer server.Close()
+	reporter := metrics.NewPrometheusReporter()
+	c, err := maps.NewClient(
+		maps.WithAPIKey("AIza-Maps-API-Key"),
+		maps.WithBaseURL(server.URL),
+		maps.WithMetricReporter(reporter))
+	if err != nil {
+		t.Errorf("Unable to create client with PrometheusReporter")
+	}
+	r := &maps.ElevationRequest{Locations: []maps.LatLng{{Lat: 39.73915360, Lng: -104.9847034}}}
+	if _, err = c.Elevation(context.Background(), r); err != nil {
+		t.Errorf("r.Get returned non nil error, was %+v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = c.Elevation(ctx, r); err == nil {
+		t.Errorf("expected an error with a cancelled context")
+	}
+
+	w := httptest.NewRecorder()
+	reporter.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := ioutil.ReadAll(w.Body)
+	for _, want := range []string{
+		`maps_client_requests_total{api="/maps/api/elevation/json",http_code=""} 1`,
+		`maps_client_requests_total{api="/maps/api/elevation/json",http_code="200"} 1`,
+		`maps_client_errors_total{api="/maps/api/elevation/json",status="CANCELLED"} 1`,
+		`maps_client_request_duration_seconds_bucket{api="/maps/api/elevation/json",le="+Inf"} 2`,
+		`maps_client_request_duration_seconds_count{api="/maps/api/elevation/json"} 2`,
+	} {
+		if !strings.Contains(string(body), want+"\n") {
+			t.Errorf("expected %s in metrics:\n%s", want, body)
+		}
+	}
+}
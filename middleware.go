@@ -0,0 +1,68 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"net/http"
+)
+
+const contextEndpoint = contextKey("ENDPOINT")
+
+// RoundTripFunc is a function which sends an HTTP request, like an
+// http.RoundTripper.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the sending of every request made by a client, for example
+// to log requests, rewrite their headers or inject faults. It is called with
+// the next step of the chain and returns the function which sends requests in
+// its place.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware configures a Maps API client to send its requests through
+// middlewares, the first of which is outermost. Middlewares see requests after
+// they are signed and the client's headers are set, and are called for each
+// attempt of a retried request. The endpoint of a request is returned by
+// EndpointFromContext(req.Context()).
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// EndpointFromContext returns the endpoint of the request made with ctx, as
+// set for the requests seen by a Middleware.
+func EndpointFromContext(ctx context.Context) (Endpoint, bool) {
+	endpoint, ok := ctx.Value(contextEndpoint).(Endpoint)
+	return endpoint, ok
+}
+
+// withMiddlewares returns rt wrapped in the client's middlewares.
+func (c *Client) withMiddlewares(rt http.RoundTripper) http.RoundTripper {
+	if len(c.middlewares) == 0 {
+		return rt
+	}
+	next := RoundTripFunc(rt.RoundTrip)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "rewritten", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"results": [], "status": "OK"}`)
+	}))
+	defer server.Close()
+
+	var calls []string
+	logging := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				endpoint, _ := EndpointFromContext(req.Context())
+				calls = append(calls, fmt.Sprintf("%s %s", name, endpoint))
+				return next(req)
+			}
+		}
+	}
+	rewrite := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "original", req.Header.Get("Authorization"))
+			req.Header.Set("Authorization", "rewritten")
+			return next(req)
+		}
+	}
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithHeader("Authorization", "original"),
+		WithMiddleware(logging("outer")), WithMiddleware(logging("inner"), rewrite))
+
+	_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+	require.NoError(t, err)
+	_, err = c.Elevation(WithRoundTripper(context.Background(), http.DefaultTransport), &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"outer " + string(EndpointGeocoding), "inner " + string(EndpointGeocoding),
+		"outer " + string(EndpointElevation), "inner " + string(EndpointElevation),
+	}, calls)
+}
+
+func TestWithMiddlewareFault(t *testing.T) {
+	server := mockServerForQuery("address=Sydney&key=AIzaNotReallyAnAPIKey", 200, `{"results": [], "status": "OK"}`)
+	defer server.s.Close()
+	fault := errors.New("injected")
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithMiddleware(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, fault
+		}
+	}))
+
+	_, err := c.Geocode(context.Background(), &GeocodingRequest{Address: "Sydney"})
+	assert.True(t, errors.Is(err, fault), "unexpected error %v", err)
+	assert.Equal(t, 0, server.successful)
+}
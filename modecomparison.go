@@ -0,0 +1,126 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ModeComparison is the result of CompareModes: the Distance Matrix of the same
+// origins and destinations for each of several modes.
+type ModeComparison struct {
+	// Modes are the compared modes, in the order requested.
+	Modes []Mode
+	// Responses are the Distance Matrix responses of each mode. A mode whose
+	// request failed has no response.
+	Responses map[Mode]*DistanceMatrixResponse
+	// Errors are the errors of the modes whose request failed.
+	Errors map[Mode]error
+}
+
+// CompareModes makes a Distance Matrix request for r with each of modes
+// concurrently. The Mode of r is ignored, and its transit options are only
+// sent with ModeTransit. A failed request does not stop the others; an error is
+// returned only if every request fails.
+func (c *Client) CompareModes(ctx context.Context, r *DistanceMatrixRequest, modes ...Mode) (*ModeComparison, error) {
+	if len(modes) == 0 {
+		return nil, errors.New("maps: modes missing")
+	}
+
+	comparison := &ModeComparison{
+		Modes:     modes,
+		Responses: make(map[Mode]*DistanceMatrixResponse),
+		Errors:    make(map[Mode]error),
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, mode := range modes {
+		req := *r
+		req.Mode = mode
+		if mode != TravelModeTransit {
+			req.TransitMode = nil
+			req.TransitRoutingPreference = ""
+		}
+		wg.Add(1)
+		go func(mode Mode, req *DistanceMatrixRequest) {
+			defer wg.Done()
+			resp, err := c.DistanceMatrix(ctx, req)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				comparison.Errors[mode] = err
+				return
+			}
+			comparison.Responses[mode] = resp
+		}(mode, &req)
+	}
+	wg.Wait()
+
+	if len(comparison.Responses) == 0 {
+		return nil, comparison.Errors[modes[0]]
+	}
+	return comparison, nil
+}
+
+// Element returns the element of mode for the origin and destination at the
+// given indexes of the request, or nil if there is none.
+func (m *ModeComparison) Element(mode Mode, origin, destination int) *DistanceMatrixElement {
+	resp := m.Responses[mode]
+	if resp == nil || origin < 0 || origin >= len(resp.Rows) {
+		return nil
+	}
+	elements := resp.Rows[origin].Elements
+	if destination < 0 || destination >= len(elements) {
+		return nil
+	}
+	return elements[destination]
+}
+
+// Durations returns the duration of each mode with a route between the origin
+// and destination at the given indexes of the request. The duration in traffic
+// is used where the API returned one.
+func (m *ModeComparison) Durations(origin, destination int) map[Mode]time.Duration {
+	durations := make(map[Mode]time.Duration)
+	for _, mode := range m.Modes {
+		e := m.Element(mode, origin, destination)
+		if e == nil || e.Status != ElementStatusOK {
+			continue
+		}
+		durations[mode] = e.Duration
+		if e.DurationInTraffic > 0 {
+			durations[mode] = e.DurationInTraffic
+		}
+	}
+	return durations
+}
+
+// Fastest returns the mode with the shortest duration between the origin and
+// destination at the given indexes of the request, and that duration. Ties go
+// to the mode requested first. It returns false if no mode has a route.
+func (m *ModeComparison) Fastest(origin, destination int) (Mode, time.Duration, bool) {
+	durations := m.Durations(origin, destination)
+	var fastest Mode
+	found := false
+	for _, mode := range m.Modes {
+		d, ok := durations[mode]
+		if ok && (!found || d < durations[fastest]) {
+			fastest, found = mode, true
+		}
+	}
+	return fastest, durations[fastest], found
+}
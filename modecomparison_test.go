@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareModes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("mode") != "transit" && q.Get("transit_mode") != "" {
+			t.Errorf("transit_mode sent with mode %s", q.Get("mode"))
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		switch q.Get("mode") {
+		case "walking":
+			fmt.Fprintln(w, `{"status": "OK", "rows": [{"elements": [{"status": "OK", "duration": {"value": 1800}}, {"status": "ZERO_RESULTS"}]}]}`)
+		case "transit":
+			fmt.Fprintln(w, `{"status": "OK", "rows": [{"elements": [{"status": "OK", "duration": {"value": 1200}}, {"status": "OK", "duration": {"value": 600}}]}]}`)
+		default:
+			fmt.Fprintln(w, `{"status": "INVALID_REQUEST"}`)
+		}
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &DistanceMatrixRequest{
+		Origins:      []string{"Sydney"},
+		Destinations: []string{"Parramatta", "Manly"},
+		TransitMode:  []TransitMode{TransitModeTrain},
+	}
+
+	comparison, err := c.CompareModes(context.Background(), r, TravelModeWalking, TravelModeTransit, TravelModeDriving)
+	require.NoError(t, err)
+	assert.Len(t, comparison.Responses, 2)
+	assert.Contains(t, comparison.Errors, TravelModeDriving)
+	assert.Equal(t, map[Mode]time.Duration{TravelModeWalking: 30 * time.Minute, TravelModeTransit: 20 * time.Minute}, comparison.Durations(0, 0))
+	assert.Equal(t, map[Mode]time.Duration{TravelModeTransit: 10 * time.Minute}, comparison.Durations(0, 1))
+
+	mode, d, ok := comparison.Fastest(0, 0)
+	assert.True(t, ok)
+	assert.Equal(t, TravelModeTransit, mode)
+	assert.Equal(t, 20*time.Minute, d)
+	_, _, ok = comparison.Fastest(1, 0)
+	assert.False(t, ok)
+
+	_, err = c.CompareModes(context.Background(), r, TravelModeDriving)
+	assert.Error(t, err)
+	_, err = c.CompareModes(context.Background(), r)
+	assert.Error(t, err)
+}
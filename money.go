@@ -0,0 +1,172 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const nanosPerUnit = 1000000000
+
+// Money is an amount of money in a currency, represented exactly as whole
+// units and nanos (10^-9) of a unit, as in the Google APIs' Money type. Units
+// and Nanos have the same sign.
+type Money struct {
+	// CurrencyCode is the ISO 4217 currency code, for example "USD".
+	CurrencyCode string `json:"currencyCode"`
+	// Units is the whole units of the amount. For example, 1 is one US dollar.
+	Units int64 `json:"units,string,omitempty"`
+	// Nanos is the number of nano units of the amount, from -999,999,999 to
+	// 999,999,999. For example, 10000000 is one US cent.
+	Nanos int32 `json:"nanos,omitempty"`
+}
+
+// ParseMoney returns the Money of a decimal amount in currency, for example
+// ParseMoney("USD", "-12.34").
+func ParseMoney(currency, amount string) (Money, error) {
+	s := amount
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	whole, fraction := s, ""
+	i := strings.IndexByte(s, '.')
+	if i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" || (i >= 0 && fraction == "") || len(fraction) > 9 {
+		return Money{}, fmt.Errorf("maps: invalid amount %q", amount)
+	}
+	units, err := strconv.ParseUint(whole, 10, 63)
+	if err != nil {
+		return Money{}, fmt.Errorf("maps: invalid amount %q", amount)
+	}
+	var nanos uint64
+	if fraction != "" {
+		nanos, err = strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+		if err != nil {
+			return Money{}, fmt.Errorf("maps: invalid amount %q", amount)
+		}
+	}
+	m := Money{CurrencyCode: currency, Units: int64(units), Nanos: int32(nanos)}
+	if negative {
+		m.Units, m.Nanos = -m.Units, -m.Nanos
+	}
+	return m, nil
+}
+
+// Money returns the fare as Money, rounded to the nearest nano, so that a fare
+// of 2.3 is exactly 2 units and 300000000 nanos. Values too large for Money,
+// including infinities, are clamped, and NaN is zero. Use MoneyErr to detect
+// these.
+func (f *Fare) Money() Money {
+	m, err := f.MoneyErr()
+	if err == nil {
+		return m
+	}
+	m = Money{CurrencyCode: f.Currency}
+	if math.IsNaN(f.Value) {
+		return m
+	}
+	m.Units, m.Nanos = math.MaxInt64, nanosPerUnit-1
+	if f.Value < 0 {
+		m.Units, m.Nanos = -m.Units, -m.Nanos
+	}
+	return m
+}
+
+// MoneyErr returns the fare as Money, rounded to the nearest nano. It returns
+// an error if the value is NaN, infinite or too large for Money.
+func (f *Fare) MoneyErr() (Money, error) {
+	if math.IsNaN(f.Value) || math.IsInf(f.Value, 0) {
+		return Money{}, fmt.Errorf("maps: invalid fare value %v", f.Value)
+	}
+	// 2^63 is the smallest float64 too large for Units.
+	if math.Abs(f.Value) >= math.Exp2(63) {
+		return Money{}, fmt.Errorf("maps: fare value %v overflows", f.Value)
+	}
+	return ParseMoney(f.Currency, strconv.FormatFloat(f.Value, 'f', 9, 64))
+}
+
+// Add returns m + o. It returns an error if the currencies differ or the result
+// overflows.
+func (m Money) Add(o Money) (Money, error) {
+	if m.CurrencyCode != o.CurrencyCode {
+		return Money{}, fmt.Errorf("maps: cannot add %s to %s", o.CurrencyCode, m.CurrencyCode)
+	}
+	units := m.Units + o.Units
+	if (o.Units > 0 && units < m.Units) || (o.Units < 0 && units > m.Units) {
+		return Money{}, fmt.Errorf("maps: %s + %s overflows", m, o)
+	}
+	// Carry whole units out of nanos, then give units and nanos the same sign.
+	nanos := int64(m.Nanos) + int64(o.Nanos)
+	carry := nanos / nanosPerUnit
+	if (carry > 0 && units == math.MaxInt64) || (carry < 0 && units == math.MinInt64) {
+		return Money{}, fmt.Errorf("maps: %s + %s overflows", m, o)
+	}
+	units += carry
+	nanos -= carry * nanosPerUnit
+	if units > 0 && nanos < 0 {
+		units--
+		nanos += nanosPerUnit
+	} else if units < 0 && nanos > 0 {
+		units++
+		nanos -= nanosPerUnit
+	}
+	return Money{CurrencyCode: m.CurrencyCode, Units: units, Nanos: int32(nanos)}, nil
+}
+
+// Sub returns m - o. It returns an error if the currencies differ or the result
+// overflows.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Units == math.MinInt64 {
+		return Money{}, fmt.Errorf("maps: %s - %s overflows", m, o)
+	}
+	return m.Add(Money{CurrencyCode: o.CurrencyCode, Units: -o.Units, Nanos: -o.Nanos})
+}
+
+// Decimal returns the amount of m as a decimal without trailing zeros, for
+// example "-12.3".
+func (m Money) Decimal() string {
+	units, nanos := m.Units, int64(m.Nanos)
+	sign := ""
+	if units < 0 || nanos < 0 {
+		sign = "-"
+	}
+	whole := strconv.FormatUint(absInt64(units), 10)
+	if nanos == 0 {
+		return sign + whole
+	}
+	if nanos < 0 {
+		nanos = -nanos
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	return sign + whole + "." + fraction
+}
+
+// String returns the amount and currency of m, for example "12.3 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.CurrencyCode
+}
+
+// absInt64 returns the absolute value of v, which is representable as a uint64
+// even for math.MinInt64.
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
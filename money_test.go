@@ -0,0 +1,125 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount   string
+		expected Money
+	}{
+		{"12", Money{CurrencyCode: "USD", Units: 12}},
+		{"12.34", Money{CurrencyCode: "USD", Units: 12, Nanos: 340000000}},
+		{"-12.34", Money{CurrencyCode: "USD", Units: -12, Nanos: -340000000}},
+		{"-0.5", Money{CurrencyCode: "USD", Nanos: -500000000}},
+		{"0.000000001", Money{CurrencyCode: "USD", Nanos: 1}},
+	}
+	for _, test := range tests {
+		m, err := ParseMoney("USD", test.amount)
+		assert.NoError(t, err, test.amount)
+		assert.Equal(t, test.expected, m, test.amount)
+	}
+
+	for _, amount := range []string{"", "-", ".5", "1.", "1.2.3", "1.0000000001", "+1", "1.-5", "abc", "99999999999999999999"} {
+		_, err := ParseMoney("USD", amount)
+		assert.Error(t, err, amount)
+	}
+}
+
+func TestFareMoney(t *testing.T) {
+	fare := &Fare{Currency: "USD", Value: 2.3, Text: "$2.30"}
+	assert.Equal(t, Money{CurrencyCode: "USD", Units: 2, Nanos: 300000000}, fare.Money())
+
+	fare = &Fare{Currency: "USD", Value: 2.0000000001}
+	assert.Equal(t, Money{CurrencyCode: "USD", Units: 2}, fare.Money())
+
+	fare = &Fare{Currency: "USD", Value: -0.0000000006}
+	assert.Equal(t, Money{CurrencyCode: "USD", Nanos: -1}, fare.Money())
+
+	fare = &Fare{Currency: "USD", Value: -1e30}
+	assert.Equal(t, Money{CurrencyCode: "USD", Units: -math.MaxInt64, Nanos: -999999999}, fare.Money())
+	_, err := fare.MoneyErr()
+	assert.Error(t, err)
+
+	fare = &Fare{Currency: "USD", Value: math.Inf(1)}
+	assert.Equal(t, Money{CurrencyCode: "USD", Units: math.MaxInt64, Nanos: 999999999}, fare.Money())
+	_, err = fare.MoneyErr()
+	assert.Error(t, err)
+
+	fare = &Fare{Currency: "USD", Value: math.NaN()}
+	assert.Equal(t, Money{CurrencyCode: "USD"}, fare.Money())
+	_, err = fare.MoneyErr()
+	assert.Error(t, err)
+}
+
+func TestMoneyAdd(t *testing.T) {
+	usd := func(units int64, nanos int32) Money {
+		return Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+	}
+	tests := []struct {
+		a, b, expected Money
+	}{
+		{usd(1, 500000000), usd(2, 600000000), usd(4, 100000000)},
+		{usd(1, 0), usd(-2, -500000000), usd(-1, -500000000)},
+		{usd(2, 100000000), usd(-1, -200000000), usd(0, 900000000)},
+		{usd(-1, -600000000), usd(-1, -600000000), usd(-3, -200000000)},
+		{usd(1, 250000000), usd(-1, -250000000), usd(0, 0)},
+	}
+	for _, test := range tests {
+		m, err := test.a.Add(test.b)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, m, "%s + %s", test.a, test.b)
+	}
+
+	m, err := usd(5, 0).Sub(usd(0, 10000000))
+	assert.NoError(t, err)
+	assert.Equal(t, usd(4, 990000000), m)
+
+	_, err = usd(1, 0).Add(Money{CurrencyCode: "EUR", Units: 1})
+	assert.Error(t, err)
+	_, err = usd(math.MaxInt64, 0).Add(usd(1, 0))
+	assert.Error(t, err)
+	_, err = usd(math.MaxInt64, 600000000).Add(usd(0, 600000000))
+	assert.Error(t, err)
+	_, err = usd(math.MinInt64, 0).Sub(usd(1, 0))
+	assert.Error(t, err)
+	_, err = usd(0, 0).Sub(usd(math.MinInt64, 0))
+	assert.Error(t, err)
+}
+
+func TestMoneyString(t *testing.T) {
+	assert.Equal(t, "12.3 USD", Money{CurrencyCode: "USD", Units: 12, Nanos: 300000000}.String())
+	assert.Equal(t, "-0.05", Money{CurrencyCode: "USD", Nanos: -50000000}.Decimal())
+	assert.Equal(t, "7", Money{CurrencyCode: "JPY", Units: 7}.Decimal())
+	assert.Equal(t, "-9223372036854775808", Money{Units: math.MinInt64}.Decimal())
+}
+
+func TestMoneyJSON(t *testing.T) {
+	m := Money{CurrencyCode: "USD", Units: 12, Nanos: 340000000}
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"currencyCode":"USD","units":"12","nanos":340000000}`, string(data))
+
+	var decoded Money
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, m, decoded)
+}
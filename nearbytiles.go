@@ -0,0 +1,195 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+)
+
+// MaxNearbySearchRadius is the largest Radius of a Nearby Search, in meters.
+const MaxNearbySearchRadius = 50000
+
+// NearbySearchTile is one circle of a tiled Nearby Search.
+type NearbySearchTile struct {
+	// Center is the Location searched around.
+	Center LatLng
+	// Radius is the Radius of the search, in meters.
+	Radius uint
+	// Results are the results of the tile, including those also found by
+	// other tiles.
+	Results []PlacesSearchResult
+	// Complete reports whether every result of the tile was fetched. Tiles
+	// with more than MaxPlacesSearchResults results, or more pages than the
+	// pager's cap, are incomplete and should be searched again with a smaller
+	// radius.
+	Complete bool
+	// Err is the error searching the tile, if any.
+	Err error
+}
+
+// TiledNearbySearch is the result of Client.NearbySearchTiled.
+type TiledNearbySearch struct {
+	// Results are the results of all tiles, de-duplicated by place ID, in the
+	// order of the tiles.
+	Results []PlacesSearchResult
+	// Tiles are the tiles searched, in rows from south to north and west to
+	// east within each row.
+	Tiles []*NearbySearchTile
+}
+
+// Complete reports whether every tile is complete.
+func (s *TiledNearbySearch) Complete() bool {
+	for _, tile := range s.Tiles {
+		if !tile.Complete {
+			return false
+		}
+	}
+	return true
+}
+
+// NearbySearchTiled runs the Nearby Search r over bounds, an area too large
+// for a single search, by splitting it into circles of r.Radius meters which
+// together cover it. A zero r.Radius uses MaxNearbySearchRadius. The circles
+// are searched with at most concurrency requests at a time, fetching every
+// page of each; r.Location is ignored. Failed tiles do not stop the rest of
+// the search.
+func (c *Client) NearbySearchTiled(ctx context.Context, r *NearbySearchRequest, bounds LatLngBounds, concurrency int) (*TiledNearbySearch, error) {
+	if concurrency < 1 {
+		return nil, errors.New("maps: concurrency must be at least 1")
+	}
+	if r.Radius > MaxNearbySearchRadius {
+		return nil, errors.New("maps: Radius greater than MaxNearbySearchRadius")
+	}
+	if r.RankBy == RankByDistance {
+		return nil, errors.New("maps: RankByDistance cannot be tiled")
+	}
+	if r.PageToken != "" {
+		return nil, errors.New("maps: PageToken cannot be tiled")
+	}
+	if bounds.NorthEast.Lat < bounds.SouthWest.Lat {
+		return nil, errors.New("maps: bounds NorthEast is south of SouthWest")
+	}
+
+	radius := r.Radius
+	if radius == 0 {
+		radius = MaxNearbySearchRadius
+	}
+	var tiles []*NearbySearchTile
+	for _, center := range tileCenters(bounds, float64(radius)) {
+		tiles = append(tiles, &NearbySearchTile{Center: center, Radius: radius})
+	}
+
+	indexes := make(chan int)
+	limit := newAdaptiveConcurrency(concurrency)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(tiles); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				c.searchTile(ctx, r, tiles[i], limit)
+			}
+		}()
+	}
+	for i := range tiles {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	search := &TiledNearbySearch{Tiles: tiles}
+	seen := make(map[string]bool)
+	for _, tile := range tiles {
+		for _, result := range tile.Results {
+			if result.PlaceID != "" && seen[result.PlaceID] {
+				continue
+			}
+			seen[result.PlaceID] = true
+			search.Results = append(search.Results, result)
+		}
+	}
+	return search, nil
+}
+
+// searchTile fetches every page of the Nearby Search r around tile.
+func (c *Client) searchTile(ctx context.Context, r *NearbySearchRequest, tile *NearbySearchTile, limit *adaptiveConcurrency) {
+	req := *r
+	req.Location = &LatLng{Lat: tile.Center.Lat, Lng: tile.Center.Lng}
+	req.Radius = tile.Radius
+	p := c.NearbySearchPager(&req)
+	for p.HasNext() {
+		var resp PlacesSearchResponse
+		err := limit.do(ctx, c, func() error {
+			var err error
+			resp, err = p.Next(ctx)
+			return err
+		})
+		if err != nil {
+			tile.Err = err
+			tile.Complete = false
+			return
+		}
+		tile.Results = append(tile.Results, resp.Results...)
+		tile.Complete = !resp.HasMore()
+	}
+}
+
+// tileCenters returns the centers of circles of radius meters which cover
+// bounds. Each circle circumscribes a cell of a grid no wider or taller than
+// radius·√2, with cells in rows from south to north.
+func tileCenters(bounds LatLngBounds, radius float64) []LatLng {
+	south, north := bounds.SouthWest.Lat, bounds.NorthEast.Lat
+	west, east := bounds.SouthWest.Lng, bounds.NorthEast.Lng
+	if east < west {
+		// The bounds cross the antimeridian.
+		east += 360
+	}
+	side := radius * math.Sqrt2 / earthRadius * 180 / math.Pi
+
+	var centers []LatLng
+	rows := int(math.Ceil((north - south) / side))
+	if rows < 1 {
+		rows = 1
+	}
+	rowHeight := (north - south) / float64(rows)
+	for i := 0; i < rows; i++ {
+		rowSouth := south + float64(i)*rowHeight
+		rowNorth := rowSouth + rowHeight
+		// Cells are widest in meters at the edge of the row nearest the equator.
+		widest := 0.0
+		if rowSouth > 0 {
+			widest = rowSouth
+		} else if rowNorth < 0 {
+			widest = -rowNorth
+		}
+		cellSide := side / math.Cos(widest*math.Pi/180)
+		cols := int(math.Ceil((east - west) / cellSide))
+		if cols < 1 {
+			cols = 1
+		}
+		colWidth := (east - west) / float64(cols)
+		for j := 0; j < cols; j++ {
+			lng := west + (float64(j)+0.5)*colWidth
+			if lng > 180 {
+				lng -= 360
+			}
+			centers = append(centers, LatLng{Lat: rowSouth + rowHeight/2, Lng: lng})
+		}
+	}
+	return centers
+}
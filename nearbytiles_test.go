@@ -0,0 +1,110 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTileCentersCoverBounds(t *testing.T) {
+	for _, bounds := range []LatLngBounds{
+		{SouthWest: LatLng{Lat: -34.5, Lng: 150.5}, NorthEast: LatLng{Lat: -33, Lng: 151.5}},
+		{SouthWest: LatLng{Lat: 59, Lng: 10}, NorthEast: LatLng{Lat: 61, Lng: 12}},
+		{SouthWest: LatLng{Lat: -1, Lng: 179}, NorthEast: LatLng{Lat: 1, Lng: -179}},
+	} {
+		const radius = 30000
+		centers := tileCenters(bounds, radius)
+		width := bounds.NorthEast.Lng - bounds.SouthWest.Lng
+		if width < 0 {
+			width += 360
+		}
+		for i := 0; i <= 20; i++ {
+			for j := 0; j <= 20; j++ {
+				lng := bounds.SouthWest.Lng + width*float64(j)/20
+				if lng > 180 {
+					lng -= 360
+				}
+				p := LatLng{
+					Lat: bounds.SouthWest.Lat + (bounds.NorthEast.Lat-bounds.SouthWest.Lat)*float64(i)/20,
+					Lng: lng,
+				}
+				nearest := -1.0
+				for _, c := range centers {
+					if d := p.Distance(&c); nearest < 0 || d < nearest {
+						nearest = d
+					}
+				}
+				assert.True(t, nearest <= radius*1.001, "%v is %.0fm from the nearest tile of %v", p, nearest, bounds)
+			}
+		}
+	}
+}
+
+func TestNearbySearchTiled(t *testing.T) {
+	var mu sync.Mutex
+	var locations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		locations = append(locations, q.Get("location"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		assert.Equal(t, "20000", q.Get("radius"))
+		switch {
+		case q.Get("pagetoken") != "":
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"place_id": "deep"}], "next_page_token": "more"}`)
+		case q.Get("location") == "-33.9,151.1":
+			// A dense tile, with more results than the API returns.
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"place_id": "shared"}, {"place_id": "dense"}], "next_page_token": "more"}`)
+		default:
+			fmt.Fprintf(w, `{"status": "OK", "results": [{"place_id": "shared"}, {"place_id": %q}]}`+"\n", q.Get("location"))
+		}
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(newFakeClock()))
+
+	bounds := LatLngBounds{SouthWest: LatLng{Lat: -34, Lng: 151}, NorthEast: LatLng{Lat: -33.8, Lng: 151.2}}
+	search, err := c.NearbySearchTiled(context.Background(), &NearbySearchRequest{Keyword: "cafe", Radius: 20000}, bounds, 2)
+	require.NoError(t, err)
+	require.Len(t, search.Tiles, 1)
+	tile := search.Tiles[0]
+	assert.Equal(t, LatLng{Lat: -33.9, Lng: 151.1}, tile.Center)
+	assert.False(t, tile.Complete)
+	assert.False(t, search.Complete())
+	assert.Len(t, tile.Results, 4)
+	assert.Len(t, search.Results, 3)
+	assert.Len(t, locations, MaxPlacesSearchPages)
+
+	bounds = LatLngBounds{SouthWest: LatLng{Lat: -34.5, Lng: 150.5}, NorthEast: LatLng{Lat: -34, Lng: 151}}
+	search, err = c.NearbySearchTiled(context.Background(), &NearbySearchRequest{Keyword: "cafe", Radius: 20000}, bounds, 2)
+	require.NoError(t, err)
+	assert.True(t, search.Complete())
+	assert.True(t, len(search.Tiles) > 1)
+	assert.Len(t, search.Results, len(search.Tiles)+1)
+	assert.Equal(t, "shared", search.Results[0].PlaceID)
+
+	_, err = c.NearbySearchTiled(context.Background(), &NearbySearchRequest{Radius: 60000}, bounds, 2)
+	assert.Error(t, err)
+	_, err = c.NearbySearchTiled(context.Background(), &NearbySearchRequest{RankBy: RankByDistance}, bounds, 2)
+	assert.Error(t, err)
+}
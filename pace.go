@@ -0,0 +1,88 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"time"
+)
+
+// LegPace describes the average speeds over a Leg and its steps.
+type LegPace struct {
+	// Speed is the average speed over the leg in meters per second, based on
+	// Duration.
+	Speed float64
+	// SpeedInTraffic is the average speed over the leg in meters per second,
+	// based on DurationInTraffic. It is 0 if the leg has no DurationInTraffic.
+	SpeedInTraffic float64
+	// TrafficDelay is the time by which DurationInTraffic exceeds Duration.
+	TrafficDelay time.Duration
+	// Congested reports whether DurationInTraffic exceeds Duration by more than
+	// the threshold passed to Pace.
+	Congested bool
+	// Steps describes the pace of each step of the leg.
+	Steps []StepPace
+}
+
+// StepPace describes the average speed over a Step.
+type StepPace struct {
+	// Step is the step described.
+	Step *Step
+	// Speed is the average speed over the step in meters per second.
+	Speed float64
+	// Slow reports whether Speed is below the average speed of the leg by more
+	// than the threshold passed to Pace.
+	Slow bool
+}
+
+// Pace returns the average speeds over leg and its steps. The threshold is a
+// fraction: with a threshold of 0.25, the leg is congested if its duration in
+// traffic is more than 25% longer than its duration, and a step is slow if it is
+// more than 25% slower than the leg as a whole. The API does not return
+// durations in traffic for steps, nor for legs of routes with stopovers. It
+// decodes the steps of a leg requested with DirectionsRequest.LazySteps, and
+// has no step paces if they cannot be decoded.
+func (leg *Leg) Pace(threshold float64) LegPace {
+	p := LegPace{
+		Speed:          averageSpeed(leg.Meters, leg.Duration),
+		SpeedInTraffic: averageSpeed(leg.Meters, leg.DurationInTraffic),
+	}
+	if leg.DurationInTraffic > 0 && leg.Duration > 0 {
+		p.TrafficDelay = leg.DurationInTraffic - leg.Duration
+		p.Congested = float64(leg.DurationInTraffic) > float64(leg.Duration)*(1+threshold)
+	}
+	steps, _ := leg.DecodeSteps()
+	for _, step := range steps {
+		speed := averageSpeed(step.Meters, step.Duration)
+		p.Steps = append(p.Steps, StepPace{
+			Step:  step,
+			Speed: speed,
+			Slow:  step.Duration > 0 && speed < p.Speed*(1-threshold),
+		})
+	}
+	return p
+}
+
+// AverageSpeed returns the average speed over step in meters per second, or 0
+// if the step has no duration.
+func (step *Step) AverageSpeed() float64 {
+	return averageSpeed(step.Meters, step.Duration)
+}
+
+func averageSpeed(meters int, d time.Duration) float64 {
+	if d <= 0 {
+		return 0
+	}
+	return float64(meters) / d.Seconds()
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLegPace(t *testing.T) {
+	fast := &Step{Distance: Distance{Meters: 1500}, Duration: 60 * time.Second}
+	slow := &Step{Distance: Distance{Meters: 300}, Duration: 60 * time.Second}
+	unknown := &Step{Distance: Distance{Meters: 200}}
+	leg := &Leg{
+		Steps:             []*Step{fast, slow, unknown},
+		Distance:          Distance{Meters: 2000},
+		Duration:          200 * time.Second,
+		DurationInTraffic: 400 * time.Second,
+	}
+
+	expected := LegPace{
+		Speed:          10,
+		SpeedInTraffic: 5,
+		TrafficDelay:   200 * time.Second,
+		Congested:      true,
+		Steps: []StepPace{
+			{Step: fast, Speed: 25},
+			{Step: slow, Speed: 5, Slow: true},
+			{Step: unknown},
+		},
+	}
+	if pace := leg.Pace(0.25); !reflect.DeepEqual(pace, expected) {
+		t.Errorf("expected %+v, was %+v", expected, pace)
+	}
+
+	leg.DurationInTraffic = 240 * time.Second
+	if pace := leg.Pace(0.25); pace.Congested {
+		t.Errorf("expected leg 20%% slower in traffic not to be congested")
+	}
+
+	leg.DurationInTraffic = 0
+	if pace := leg.Pace(0.25); pace.Congested || pace.SpeedInTraffic != 0 || pace.TrafficDelay != 0 {
+		t.Errorf("expected no traffic information, was %+v", pace)
+	}
+
+	if speed := fast.AverageSpeed(); speed != 25 {
+		t.Errorf("expected 25, was %v", speed)
+	}
+}
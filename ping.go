@@ -0,0 +1,34 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"time"
+)
+
+// Ping makes a lightweight Time Zone API request to verify that the Maps API is
+// reachable and accepts the client's credentials. It returns a *StatusError
+// such as REQUEST_DENIED for an invalid or restricted key.
+//
+// Call Ping once at startup so that a misconfigured deployment fails fast,
+// rather than on its first user request. For a readiness probe, cache the
+// result rather than calling Ping on every probe, as each call is a billed
+// request. A Client is safe for concurrent use, so the pinged client can be
+// shared by all handlers.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Timezone(ctx, &TimezoneRequest{Location: &LatLng{}, Timestamp: time.Unix(0, 0)})
+	return err
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPing(t *testing.T) {
+	server := mockServerForQuery("key=AIzaNotReallyAnAPIKey&location=0%2C0&timestamp=0", 200, `{"status": "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	assert.NoError(t, c.Ping(context.Background()))
+	assert.Equal(t, 1, server.successful)
+
+	denied := mockServer(200, `{"status": "REQUEST_DENIED", "errorMessage": "The provided API key is invalid."}`)
+	defer denied.Close()
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(denied.URL))
+	assert.Equal(t, &StatusError{status: "REQUEST_DENIED", message: "The provided API key is invalid.", httpStatus: 200}, c.Ping(context.Background()))
+}
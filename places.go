@@ -16,6 +16,7 @@ package maps
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"image"
@@ -24,11 +25,13 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
-	// Included for image/jpeg's decoder
+	// Included for the image/jpeg and image/png decoders
 	_ "image/jpeg"
+	_ "image/png"
 )
 
 var placesNearbySearchAPI = &apiConfig{
@@ -36,12 +39,20 @@ var placesNearbySearchAPI = &apiConfig{
 	path:             "/maps/api/place/nearbysearch/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	acceptsLanguage:  true,
+	acceptsRegion:    true,
 }
 
 // NearbySearch lets you search for places within a specified area. You can refine
 // your search request by supplying keywords or specifying the type of place you are
 // searching for.
 func (c *Client) NearbySearch(ctx context.Context, r *NearbySearchRequest) (PlacesSearchResponse, error) {
+	if err := c.validateRequest(r); err != nil {
+		return PlacesSearchResponse{}, err
+	}
+	if r.RankBy != "" && r.RankBy != RankByProminence && r.RankBy != RankByDistance {
+		return PlacesSearchResponse{}, fmt.Errorf("maps: RankBy %q invalid, use RankByProminence or RankByDistance", r.RankBy)
+	}
 
 	if r.PageToken == "" {
 		if r.Location == nil {
@@ -78,7 +89,18 @@ func (c *Client) NearbySearch(ctx context.Context, r *NearbySearchRequest) (Plac
 		return PlacesSearchResponse{}, err
 	}
 
-	return PlacesSearchResponse{response.Results, response.HTMLAttributions, response.NextPageToken}, nil
+	resp := PlacesSearchResponse{
+		Results:          response.Results,
+		HTMLAttributions: response.HTMLAttributions,
+		NextPageToken:    response.NextPageToken,
+	}
+	if r.MaxDistance > 0 && r.Location != nil {
+		resp.Results, resp.NextPageToken = withinDistance(resp.Results, resp.NextPageToken, r)
+	}
+	if r.OpenNowPostFilter {
+		resp.Results, resp.OpenStatusUnknown = filterOpenAt(resp.Results, c.clock.Now())
+	}
+	return resp, nil
 
 }
 
@@ -101,6 +123,10 @@ func (r *NearbySearchRequest) params() url.Values {
 		q.Set("language", r.Language)
 	}
 
+	if r.Region != "" {
+		q.Set("region", r.Region)
+	}
+
 	if r.MinPrice != "" {
 		q.Set("minprice", string(r.MinPrice))
 	}
@@ -113,7 +139,7 @@ func (r *NearbySearchRequest) params() url.Values {
 		q.Set("name", r.Name)
 	}
 
-	if r.OpenNow {
+	if r.OpenNow && !r.OpenNowPostFilter {
 		q.Set("opennow", "true")
 	}
 
@@ -148,6 +174,10 @@ type NearbySearchRequest struct {
 	Keyword string
 	// Language specifies the language in which to return results. Optional.
 	Language string
+	// Region is the region code, specified as a ccTLD (country code top-level domain)
+	// two-character value. This parameter will only influence, not fully restrict,
+	// search results. Optional.
+	Region string
 	// MinPrice restricts results to only those places within the specified price level.
 	// Valid values are in the range from 0 (most affordable) to 4 (most expensive),
 	// inclusive.
@@ -163,8 +193,22 @@ type NearbySearchRequest struct {
 	// query is sent. Places that do not specify opening hours in the Google Places
 	// database will not be returned if you include this parameter in your query.
 	OpenNow bool
+	// OpenNowPostFilter returns only those places that are open for business at
+	// the time the response is received, filtering the results by their opening
+	// hours instead of sending OpenNow to the API. Opening periods are compared
+	// with the time in the place's UTC offset. Places whose opening hours or UTC
+	// offset are unknown are returned in PlacesSearchResponse.OpenStatusUnknown
+	// rather than dropped. This is not sent to the API. Optional.
+	OpenNowPostFilter bool
 	// RankBy specifies the order in which results are listed.
 	RankBy
+	// MaxDistance drops results further than this many meters from Location,
+	// computed client-side. With RankByDistance, results are ordered by distance
+	// so NextPageToken is also cleared once a result is too far away, stopping
+	// pagination at the edge of the service area. Keep Location and RankBy set
+	// alongside PageToken when requesting later pages. This is not sent to the
+	// API. Optional.
+	MaxDistance float64
 	// Type restricts the results to places matching the specified type.
 	Type PlaceType
 	// PageToken returns the next 20 results from a previously run search. Setting a
@@ -173,15 +217,34 @@ type NearbySearchRequest struct {
 	PageToken string
 }
 
+// withinDistance returns the results no further than r.MaxDistance from
+// r.Location, and the next page token if later pages may still have results
+// within that distance.
+func withinDistance(results []PlacesSearchResult, nextPageToken string, r *NearbySearchRequest) ([]PlacesSearchResult, string) {
+	var within []PlacesSearchResult
+	for _, result := range results {
+		if r.Location.Distance(&result.Geometry.Location) <= r.MaxDistance {
+			within = append(within, result)
+		} else if r.RankBy == RankByDistance {
+			nextPageToken = ""
+		}
+	}
+	return within, nextPageToken
+}
+
 var placesTextSearchAPI = &apiConfig{
 	host:            "https://maps.googleapis.com",
 	path:            "/maps/api/place/textsearch/json",
 	acceptsClientID: true,
+	acceptsLanguage: true,
+	acceptsRegion:   true,
 }
 
 // TextSearch issues the Places API Text Search request and retrieves the Response
 func (c *Client) TextSearch(ctx context.Context, r *TextSearchRequest) (PlacesSearchResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return PlacesSearchResponse{}, err
+	}
 	if r.Query == "" && r.PageToken == "" && r.Type == "" {
 		return PlacesSearchResponse{}, errors.New("maps: Query, PageToken and Type are all missing")
 	}
@@ -205,7 +268,11 @@ func (c *Client) TextSearch(ctx context.Context, r *TextSearchRequest) (PlacesSe
 		return PlacesSearchResponse{}, err
 	}
 
-	return PlacesSearchResponse{response.Results, response.HTMLAttributions, response.NextPageToken}, nil
+	return PlacesSearchResponse{
+		Results:          response.Results,
+		HTMLAttributions: response.HTMLAttributions,
+		NextPageToken:    response.NextPageToken,
+	}, nil
 }
 
 func (r *TextSearchRequest) params() url.Values {
@@ -305,66 +372,64 @@ type PlacesSearchResponse struct {
 	// NextPageToken contains a token that can be used to return up to 20 additional
 	// results.
 	NextPageToken string
+	// OpenStatusUnknown contains the places without opening hours, or with
+	// opening periods but no UTC offset, which were removed from Results by
+	// NearbySearchRequest.OpenNowPostFilter.
+	OpenStatusUnknown []PlacesSearchResult
 }
 
-// PlacesSearchResult is an individual Places API search result
-type PlacesSearchResult struct {
-	// FormattedAddress is the human-readable address of this place
-	FormattedAddress string `json:"formatted_address,omitempty"`
-	// Geometry contains geometry information about the result, generally including the
-	// location (geocode) of the place and (optionally) the viewport identifying its
-	// general area of coverage.
-	Geometry AddressGeometry `json:"geometry,omitempty"`
-	// Name contains the human-readable name for the returned result. For establishment
-	// results, this is usually the business name.
-	Name string `json:"name,omitempty"`
-	// Icon contains the URL of a recommended icon which may be displayed to the user
-	// when indicating this result.
-	Icon string `json:"icon,omitempty"`
-	// PlaceID is a textual identifier that uniquely identifies a place.
-	PlaceID string `json:"place_id,omitempty"`
-	// Rating contains the place's rating, from 1.0 to 5.0, based on aggregated user
-	// reviews.
-	Rating float32 `json:"rating,omitempty"`
-	// UserRatingsTotal contains total number of the place's ratings
-	UserRatingsTotal int `json:"user_ratings_total,omitempty"`
-	// Types contains an array of feature types describing the given result.
-	Types []string `json:"types,omitempty"`
-	// OpeningHours may contain whether the place is open now or not.
-	OpeningHours *OpeningHours `json:"opening_hours,omitempty"`
-	// Photos is an array of photo objects, each containing a reference to an image.
-	Photos []Photo `json:"photos,omitempty"`
-	// PriceLevel is the price level of the place, on a scale of 0 to 4.
-	PriceLevel int `json:"price_level,omitempty"`
-	// Vicinity contains a feature name of a nearby location.
-	Vicinity string `json:"vicinity,omitempty"`
-	// PermanentlyClosed is a boolean flag indicating whether the place has permanently
-	// shut down.
-	PermanentlyClosed bool `json:"permanently_closed,omitempty"`
-	// BusinessStatus is a string indicating the operational status of the
-	// place, if it is a business.
-	BusinessStatus string `json:"business_status,omitempty"`
-	// ID is an identifier.
-	ID string `json:"id,omitempty"`
+// filterOpenAt splits results into the places open at t and the places whose
+// opening hours are unknown. Places known to be closed are dropped. Places with
+// opening periods but no UTC offset are unknown, as their local time is.
+func filterOpenAt(results []PlacesSearchResult, t time.Time) (open, unknown []PlacesSearchResult) {
+	for _, result := range results {
+		hours := result.OpeningHours
+		switch {
+		case hours == nil:
+			unknown = append(unknown, result)
+		case hours.OpenNow != nil:
+			if *hours.OpenNow {
+				open = append(open, result)
+			}
+		case len(hours.Periods) > 0 && result.UTCOffset != nil:
+			// Periods are in the place's local time.
+			local := t.In(time.FixedZone("", *result.UTCOffset*60))
+			if hours.IsOpenAt(local) {
+				open = append(open, result)
+			}
+		default:
+			unknown = append(unknown, result)
+		}
+	}
+	return open, unknown
 }
 
 var placeDetailsAPI = &apiConfig{
 	host:            "https://maps.googleapis.com",
 	path:            "/maps/api/place/details/json",
 	acceptsClientID: true,
+	acceptsLanguage: true,
+	acceptsRegion:   true,
+	cacheable:       true,
 }
 
 // PlaceDetails issues the Places API Place Details request and retrieves the response
 func (c *Client) PlaceDetails(ctx context.Context, r *PlaceDetailsRequest) (PlaceDetailsResult, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return PlaceDetailsResult{}, err
+	}
 	if r.PlaceID == "" {
 		return PlaceDetailsResult{}, errors.New("maps: PlaceID missing")
 	}
+	if !validReviewsSort(r.ReviewsSort) {
+		return PlaceDetailsResult{}, fmt.Errorf("maps: unknown ReviewsSort %q", r.ReviewsSort)
+	}
 
 	var response struct {
 		Result           PlaceDetailsResult `json:"result,omitempty"`
 		HTMLAttributions []string           `json:"html_attributions,omitempty"`
 		commonResponse
+		validatorsResponse
 	}
 
 	if err := c.getJSON(ctx, placeDetailsAPI, r, &response); err != nil {
@@ -376,6 +441,8 @@ func (c *Client) PlaceDetails(ctx context.Context, r *PlaceDetailsRequest) (Plac
 	}
 
 	response.Result.HTMLAttributions = response.HTMLAttributions
+	response.Result.CacheValidators = response.validators
+	response.Result.normalizeClosure()
 	return response.Result, nil
 }
 
@@ -411,6 +478,17 @@ func (r *PlaceDetailsRequest) params() url.Values {
 	return q
 }
 
+// Sorting methods of the reviews of a Place Details request.
+const (
+	ReviewsSortMostRelevant = "most_relevant"
+	ReviewsSortNewest       = "newest"
+)
+
+// validReviewsSort reports whether sort is a ReviewsSort, or unset.
+func validReviewsSort(sort string) bool {
+	return sort == "" || sort == ReviewsSortMostRelevant || sort == ReviewsSortNewest
+}
+
 // PlaceDetailsRequest is the functional options struct for PlaceDetails
 type PlaceDetailsRequest struct {
 	// PlaceID is a textual identifier that uniquely identifies a place, returned from a
@@ -442,7 +520,7 @@ type PlaceDetailsRequest struct {
 	// preferred language.
 	ReviewsNoTranslations bool
 	// ReviewsSort specifies the sorting method to use when returning reviews.
-	// Can be set to most_relevant (default) or newest.
+	// Can be set to ReviewsSortMostRelevant (default) or ReviewsSortNewest.
 	//
 	//For most_relevant (default), reviews are sorted by relevance; the service
 	// will bias the results to return reviews originally written in the
@@ -452,131 +530,34 @@ type PlaceDetailsRequest struct {
 	// Google recommends that you display how the reviews are being sorted to the
 	// end user.
 	ReviewsSort string
+	// IfChanged makes the request conditional on the result having changed
+	// since it had these CacheValidators, usually those of a previous result.
+	// If it has not changed, PlaceDetails returns ErrNotModified. Optional.
+	IfChanged *CacheValidators
 }
 
-// PlaceDetailsResult is an individual Places API Place Details result
-type PlaceDetailsResult struct {
-	// AddressComponents is an array of separate address components used to compose a
-	// given address.
-	AddressComponents []AddressComponent `json:"address_components,omitempty"`
-	// FormattedAddress is the human-readable address of this place.
-	FormattedAddress string `json:"formatted_address,omitempty"`
-	// AdrAddress is the address in the "adr" microformat.
-	AdrAddress string `json:"adr_address,omitempty"`
-	// BusinessStatus is a string indicating the operational status of the
-	// place, if it is a business.
-	BusinessStatus string `json:"business_status,omitempty"`
-	// CurbsidePickup specifies if the business supports curbside pickup.
-	CurbsidePickup bool `json:"curbside_pickup,omitempty"`
-	// Delivery specifies if the business supports delivery.
-	Delivery bool `json:"delivery,omitempty"`
-	// DineIn specifies if the business supports seating options.
-	DineIn bool `json:"dine_in,omitempty"`
-	// EditorialSummary contains a summary of the place. A summary is comprised
-	// of a textual overview, and also includes the language code for these if
-	// applicable. Summary text must be presented as-is and can not be modified
-	// or altered.
-	EditorialSummary *PlaceEditorialSummary `json:"editorial_summary,omitempty"`
-	// FormattedPhoneNumber contains the place's phone number in its local format. For
-	// example, the formatted_phone_number for Google's Sydney, Australia office is
-	// (02) 9374 4000.
-	FormattedPhoneNumber string `json:"formatted_phone_number,omitempty"`
-	// InternationalPhoneNumber contains the place's phone number in international
-	// format. International format includes the country code, and is prefixed with the
-	// plus (+) sign. For example, the international_phone_number for Google's Sydney,
-	// Australia office is +61 2 9374 4000.
-	InternationalPhoneNumber string `json:"international_phone_number,omitempty"`
-	// Geometry contains geometry information about the result, generally including the
-	// location (geocode) of the place and (optionally) the viewport identifying its
-	// general area of coverage.
-	Geometry AddressGeometry `json:"geometry,omitempty"`
-	// Icon contains the URL of a recommended icon which may be displayed to the user
-	// when indicating this result.
-	Icon string `json:"icon,omitempty"`
-	// Name contains the human-readable name for the returned result. For establishment
-	// results, this is usually the business name.
-	Name string `json:"name,omitempty"`
-	// OpeningHours may contain whether the place is open now or not.
-	OpeningHours *OpeningHours `json:"opening_hours,omitempty"`
-	// CurrentOpeningHours may contain the hours of operation for the next seven
-	// days (including today). The time period starts at midnight on the date of
-	// the request and ends at 11:59 pm six days later. This field includes the
-	// special_days subfield of all hours, set for dates that have exceptional
-	// hours.
-	CurrentOpeningHours *OpeningHours `json:"current_opening_hours,omitempty"`
-	// SecondaryOpeningHours may contain an array of entries for the next seven
-	// days including information about secondary hours of a business. Secondary
-	// hours are different from a business's main hours. For example, a
-	// restaurant can specify drive through hours or delivery hours as its
-	// secondary hours. This field populates the type subfield, which draws from
-	// a predefined list of opening hours types (such as DRIVE_THROUGH, PICKUP,
-	// or TAKEOUT) based on the types of the place. This field includes the
-	// special_days subfield of all hours, set for dates that have exceptional
-	// hours.
-	SecondaryOpeningHours []OpeningHours `json:"secondary_opening_hours,omitempty"`
-	// PermanentlyClosed is a boolean flag indicating whether the place has permanently
-	// shut down (value true). If the place is not permanently closed, the flag is
-	// absent from the response.
-	//
-	// Deprecated: Use BusinessStatus instead.
-	PermanentlyClosed bool `json:"permanently_closed,omitempty"`
-	// Photos is an array of photo objects, each containing a reference to an image.
-	Photos []Photo `json:"photos,omitempty"`
-	// PlaceID is a textual identifier that uniquely identifies a place.
-	PlaceID string `json:"place_id,omitempty"`
-	// PriceLevel is the price level of the place, on a scale of 0 to 4.
-	PriceLevel int `json:"price_level,omitempty"`
-	// Rating contains the place's rating, from 1.0 to 5.0, based on aggregated user
-	// reviews.
-	Rating float32 `json:"rating,omitempty"`
-	// Reservable specifies if the place supports reservations.
-	Reservable bool `json:"reservable,omitempty"`
-	// Reviews is an array of up to five reviews. If a language parameter was specified
-	// in the Place Details request, the Places Service will bias the results to prefer
-	// reviews written in that language.
-	Reviews []PlaceReview `json:"reviews,omitempty"`
-	// ServesBeer specifies if the place serves beer.
-	ServesBeer bool `json:"serves_beer,omitempty"`
-	// ServesBreakfast specifies if the place serves breakfast.
-	ServesBreakfast bool `json:"serves_breakfast,omitempty"`
-	// ServesBrunch specifies if the place serves brunch.
-	ServesBrunch bool `json:"serves_brunch,omitempty"`
-	// ServesDinner specifies if the place serves dinner.
-	ServesDinner bool `json:"serves_dinner,omitempty"`
-	// ServesLunch specifies if the place serves lunch.
-	ServesLunch bool `json:"serves_lunch,omitempty"`
-	// ServesVegetarianFood specifies if the place serves vegetarian food.
-	ServesVegetarianFood bool `json:"serves_vegetarian_food,omitempty"`
-	// ServesWine specifies if the place serves wine.
-	ServesWine bool `json:"serves_wine,omitempty"`
-	// Takeout specifies if the business supports takeout.
-	Takeout bool `json:"takeout,omitempty"`
-	// Types contains an array of feature types describing the given result.
-	Types []string `json:"types,omitempty"`
-	// URL contains the URL of the official Google page for this place. This will be the
-	// establishment's Google+ page if the Google+ page exists, otherwise it will be the
-	// Google-owned page that contains the best available information about the place.
-	// Applications must link to or embed this page on any screen that shows detailed
-	// results about the place to the user.
-	URL string `json:"url,omitempty"`
-	// UserRatingsTotal contains total number of the place's ratings
-	UserRatingsTotal int `json:"user_ratings_total,omitempty"`
-	// UTCOffset contains the number of minutes this place’s current timezone is offset
-	// from UTC. For example, for places in Sydney, Australia during daylight saving
-	// time this would be 660 (+11 hours from UTC), and for places in California outside
-	// of daylight saving time this would be -480 (-8 hours from UTC).
-	UTCOffset *int `json:"utc_offset,omitempty"`
-	// Vicinity contains a feature name of a nearby location.
-	Vicinity string `json:"vicinity,omitempty"`
-	// Website lists the authoritative website for this place, such as a business'
-	// homepage.
-	Website string `json:"website,omitempty"`
-	// WheelchairAccessibleEntrance specifies if the place has an entrance that
-	// is wheelchair-accessible.
-	WheelchairAccessibleEntrance bool `json:"wheelchair_accessible_entrance,omitempty"`
-	// HTMLAttributions contain a set of attributions about this listing which must be
-	// displayed to the user.
-	HTMLAttributions []string `json:"html_attributions,omitempty"`
+func (r *PlaceDetailsRequest) header() http.Header {
+	return r.IfChanged.header()
+}
+
+// Closure returns the business status of the place. A place with only the
+// deprecated PermanentlyClosed flag set is reported as
+// BusinessStatusClosedPermanently. Closure returns "" if the place has no
+// business status.
+func (r *PlaceDetailsResult) Closure() string {
+	if r.BusinessStatus == "" && r.PermanentlyClosed {
+		return BusinessStatusClosedPermanently
+	}
+	return r.BusinessStatus
+}
+
+// normalizeClosure populates whichever of BusinessStatus and the deprecated
+// PermanentlyClosed flag is absent from the response.
+func (r *PlaceDetailsResult) normalizeClosure() {
+	r.BusinessStatus = r.Closure()
+	if r.BusinessStatus == BusinessStatusClosedPermanently {
+		r.PermanentlyClosed = true
+	}
 }
 
 // PlaceReview is a review of a Place
@@ -606,9 +587,43 @@ type PlaceReview struct {
 	// reviews are considered optional. Therefore, this field may by empty. Note that
 	// this field may include simple HTML markup.
 	Text string `json:"text,omitempty"`
+	// OriginalLanguage is the language of the review before it was translated
+	// into Language, or of the review if it was not translated.
+	OriginalLanguage string `json:"original_language,omitempty"`
+	// Translated indicates that Text has been translated from
+	// OriginalLanguage.
+	Translated bool `json:"translated,omitempty"`
+	// PublishTime is the time that the review was submitted.
+	PublishTime time.Time `json:"-"`
 	// Time the time that the review was submitted, measured in the number of seconds
 	// since since midnight, January 1, 1970 UTC.
-	Time int `json:"time,omitempty"` // TODO(samthor): convert this to a real time.Time
+	//
+	// Deprecated: Use PublishTime.
+	Time int `json:"time,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for PlaceReview, setting
+// PublishTime from the time of the review.
+func (r *PlaceReview) UnmarshalJSON(data []byte) error {
+	type review PlaceReview
+	if err := unmarshalJSON(data, (*review)(r)); err != nil {
+		return err
+	}
+	r.PublishTime = time.Time{}
+	if r.Time != 0 {
+		r.PublishTime = time.Unix(int64(r.Time), 0).UTC()
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for PlaceReview, encoding PublishTime
+// as the time of the review if Time is not set.
+func (r PlaceReview) MarshalJSON() ([]byte, error) {
+	type review PlaceReview
+	if r.Time == 0 && !r.PublishTime.IsZero() {
+		r.Time = int(r.PublishTime.Unix())
+	}
+	return json.Marshal(review(r))
 }
 
 // PlaceReviewAspect provides a rating of a single attribute of the establishment.
@@ -625,12 +640,15 @@ var placesQueryAutocompleteAPI = &apiConfig{
 	host:            "https://maps.googleapis.com",
 	path:            "/maps/api/place/queryautocomplete/json",
 	acceptsClientID: true,
+	acceptsLanguage: true,
 }
 
 // QueryAutocomplete issues the Places API Query Autocomplete request and retrieves
 // the response
 func (c *Client) QueryAutocomplete(ctx context.Context, r *QueryAutocompleteRequest) (AutocompleteResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return AutocompleteResponse{}, err
+	}
 	if r.Input == "" {
 		return AutocompleteResponse{}, errors.New("maps: Input missing")
 	}
@@ -755,16 +773,38 @@ var placesPlaceAutocompleteAPI = &apiConfig{
 	host:            "https://maps.googleapis.com",
 	path:            "/maps/api/place/autocomplete/json",
 	acceptsClientID: true,
+	acceptsLanguage: true,
 }
 
 // PlaceAutocomplete issues the Places API Place Autocomplete request and retrieves
 // the response
 func (c *Client) PlaceAutocomplete(ctx context.Context, r *PlaceAutocompleteRequest) (AutocompleteResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return AutocompleteResponse{}, err
+	}
 	if r.Input == "" {
 		return AutocompleteResponse{}, errors.New("maps: Input missing")
 	}
 
+	if r.LocationBias != nil || r.LocationRestriction != nil {
+		if r.Location != nil || r.Radius > 0 || r.StrictBounds {
+			return AutocompleteResponse{}, errors.New("maps: LocationBias or LocationRestriction specified with Location, Radius or StrictBounds")
+		}
+		if r.LocationBias != nil && r.LocationRestriction != nil {
+			return AutocompleteResponse{}, errors.New("maps: LocationBias and LocationRestriction both specified")
+		}
+		if r.LocationBias != nil {
+			if err := r.LocationBias.validate(); err != nil {
+				return AutocompleteResponse{}, err
+			}
+		}
+		if r.LocationRestriction != nil {
+			if err := r.LocationRestriction.validate(); err != nil {
+				return AutocompleteResponse{}, err
+			}
+		}
+	}
+
 	var response struct {
 		Predictions []AutocompletePrediction `json:"predictions,omitempty"`
 		commonResponse
@@ -818,6 +858,14 @@ func (r *PlaceAutocompleteRequest) params() url.Values {
 		q.Set("strictbounds", "true")
 	}
 
+	if r.LocationBias != nil {
+		q.Set("locationbias", r.LocationBias.param())
+	}
+
+	if r.LocationRestriction != nil {
+		q.Set("locationrestriction", r.LocationRestriction.param())
+	}
+
 	var cf []string
 	for c, f := range r.Components {
 		fc := make([]string, len(f))
@@ -872,6 +920,12 @@ type PlaceAutocompleteRequest struct {
 	// StrictBounds return only those places that are strictly within the region defined
 	// by location and radius.
 	StrictBounds bool
+	// LocationBias biases results towards an area. It cannot be combined with
+	// Location, Radius or LocationRestriction. Optional.
+	LocationBias *LocationBias
+	// LocationRestriction restricts results to an area. It cannot be combined
+	// with Location, Radius or LocationBias. Optional.
+	LocationRestriction *LocationRestriction
 	// SessionToken is a token that means you will get charged by autocomplete session
 	// instead of by character for Autocomplete
 	SessionToken PlaceAutocompleteSessionToken
@@ -885,7 +939,9 @@ var placesPhotoAPI = &apiConfig{
 
 // PlacePhoto issues the Places API Photo request and retrieves the response
 func (c *Client) PlacePhoto(ctx context.Context, r *PlacePhotoRequest) (PlacePhotoResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return PlacePhotoResponse{}, err
+	}
 	if r.PhotoReference == "" {
 		return PlacePhotoResponse{}, errors.New("maps: PhotoReference missing")
 	}
@@ -944,16 +1000,29 @@ type PlacePhotoResponse struct {
 	Data io.ReadCloser
 }
 
-// Image will read and close  response.Data and return it as an image.
+// Image will read and close  response.Data and return it as an image. JPEG and
+// PNG images are supported. WebP images require a decoder to be registered,
+// such as by importing golang.org/x/image/webp.
 func (resp *PlacePhotoResponse) Image() (image.Image, error) {
 	defer resp.Data.Close()
-	if resp.ContentType != "image/jpeg" {
+	if !strings.HasPrefix(resp.ContentType, "image/") {
 		return nil, errors.New("Image of unknown format: " + resp.ContentType)
 	}
 	img, _, err := image.Decode(resp.Data)
+	if err == image.ErrFormat {
+		return nil, fmt.Errorf("maps: no decoder registered for %v", resp.ContentType)
+	}
 	return img, err
 }
 
+// SaveTo will copy response.Data to w without decoding it, and close it, so
+// that the photo can be stored in its original format.
+func (resp *PlacePhotoResponse) SaveTo(w io.Writer) error {
+	defer resp.Data.Close()
+	_, err := io.Copy(w, resp.Data)
+	return err
+}
+
 // FindPlaceFromTextInputType is the different types of inputs.
 type FindPlaceFromTextInputType string
 
@@ -1006,22 +1075,37 @@ type FindPlaceFromTextRequest struct {
 	// Language specifies the language in which to return results. Optional.
 	Language string
 
+	// Bias is the location bias to apply to this request. Optional.
+	Bias *LocationBias
+
 	// LocationBias is the type of location bias to apply to this request
+	//
+	// Deprecated: Use Bias.
 	LocationBias FindPlaceFromTextLocationBiasType
 
 	// LocationBiasPoint is the point for LocationBias type Point
+	//
+	// Deprecated: Use Bias.
 	LocationBiasPoint *LatLng
 
 	// LocationBiasCenter is the center for LocationBias type Circle
+	//
+	// Deprecated: Use Bias.
 	LocationBiasCenter *LatLng
 
 	// LocationBiasRadius is the radius for LocationBias type Circle
+	//
+	// Deprecated: Use Bias.
 	LocationBiasRadius int
 
 	// LocationBiasSouthWest is the South West boundary for LocationBias type Rectangle
+	//
+	// Deprecated: Use Bias.
 	LocationBiasSouthWest *LatLng
 
 	// LocationBiasSouthWest is the North East boundary for LocationBias type Rectangle
+	//
+	// Deprecated: Use Bias.
 	LocationBiasNorthEast *LatLng
 }
 
@@ -1040,7 +1124,9 @@ func (r *FindPlaceFromTextRequest) params() url.Values {
 		q.Set("language", r.Language)
 	}
 
-	if r.LocationBias != "" {
+	if r.Bias != nil {
+		q.Set("locationbias", r.Bias.param())
+	} else if r.LocationBias != "" {
 		switch r.LocationBias {
 		case FindPlaceFromTextLocationBiasIP:
 			q.Set("locationbias", "ipbias")
@@ -1066,12 +1152,15 @@ var findPlaceFromTextAPI = &apiConfig{
 	host:            "https://maps.googleapis.com",
 	path:            "/maps/api/place/findplacefromtext/json",
 	acceptsClientID: false,
+	acceptsLanguage: true,
 }
 
 // FindPlaceFromText takes a text input, and returns a place. The text input
 // can be any kind of Places data, for example, a name, address, or phone number.
 func (c *Client) FindPlaceFromText(ctx context.Context, r *FindPlaceFromTextRequest) (FindPlaceFromTextResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return FindPlaceFromTextResponse{}, err
+	}
 	if r.Input == "" {
 		return FindPlaceFromTextResponse{}, errors.New("maps: Input required")
 	}
@@ -1080,6 +1169,15 @@ func (c *Client) FindPlaceFromText(ctx context.Context, r *FindPlaceFromTextRequ
 		return FindPlaceFromTextResponse{}, errors.New("maps: InputType required")
 	}
 
+	if r.Bias != nil {
+		if r.LocationBias != "" {
+			return FindPlaceFromTextResponse{}, errors.New("maps: Bias and LocationBias both specified")
+		}
+		if err := r.Bias.validate(); err != nil {
+			return FindPlaceFromTextResponse{}, err
+		}
+	}
+
 	if r.LocationBias != "" {
 		switch r.LocationBias {
 		case FindPlaceFromTextLocationBiasPoint:
@@ -15,8 +15,15 @@
 package maps
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -141,8 +148,7 @@ func TestTextSearchPizzaInNewYork(t *testing.T) {
 		t.Errorf("expected %+v, was %+v", openNow, *result.OpeningHours.OpenNow)
 	}
 
-	// Find a way of mapping int -> PriceLevel
-	priceLevel := 2
+	priceLevel := PriceLevelModerate
 	if priceLevel != result.PriceLevel {
 		t.Errorf("expected %+v, was %+v", priceLevel, result.PriceLevel)
 	}
@@ -173,7 +179,7 @@ func TestNearbySearchMinimalRequestURL(t *testing.T) {
 }
 
 func TestNearbySearchMaximalRequestURL(t *testing.T) {
-	expectedQuery := "key=AIzaNotReallyAnAPIKey&keyword=foo&language=es&location=1%2C2&maxprice=3&minprice=0&name=name&opennow=true&pagetoken=NextPageToken&radius=10000&rankby=prominence&type=airport"
+	expectedQuery := "key=AIzaNotReallyAnAPIKey&keyword=foo&language=es&location=1%2C2&maxprice=3&minprice=0&name=name&opennow=true&pagetoken=NextPageToken&radius=10000&rankby=prominence&region=es&type=airport"
 
 	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
 	defer server.s.Close()
@@ -185,6 +191,7 @@ func TestNearbySearchMaximalRequestURL(t *testing.T) {
 		Radius:    10000,
 		Keyword:   "foo",
 		Language:  "es",
+		Region:    "es",
 		MinPrice:  PriceLevelFree,
 		MaxPrice:  PriceLevelExpensive,
 		Name:      "name",
@@ -867,6 +874,144 @@ func TestPlaceDetails(t *testing.T) {
 
 }
 
+func TestPlaceDetailsClosure(t *testing.T) {
+	tests := []struct {
+		result                string
+		wantStatus            string
+		wantPermanentlyClosed bool
+	}{
+		{`{"business_status": "OPERATIONAL"}`, BusinessStatusOperational, false},
+		{`{"business_status": "CLOSED_PERMANENTLY"}`, BusinessStatusClosedPermanently, true},
+		{`{"permanently_closed": true}`, BusinessStatusClosedPermanently, true},
+		{`{"business_status": "CLOSED_TEMPORARILY"}`, BusinessStatusClosedTemporarily, false},
+		{`{}`, "", false},
+	}
+
+	for _, test := range tests {
+		server := mockServer(200, `{"status": "OK", "result": `+test.result+`}`)
+		c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+		resp, err := c.PlaceDetails(context.Background(), &PlaceDetailsRequest{PlaceID: "ChIJ02qnq0KuEmsRHUJF4zo1x4I"})
+		server.Close()
+		if err != nil {
+			t.Fatalf("r.Get returned non nil error: %v", err)
+		}
+		if resp.Closure() != test.wantStatus || resp.BusinessStatus != test.wantStatus {
+			t.Errorf("%s: expected business status %q, was %q", test.result, test.wantStatus, resp.Closure())
+		}
+		if resp.PermanentlyClosed != test.wantPermanentlyClosed {
+			t.Errorf("%s: expected PermanentlyClosed %v, was %v", test.result, test.wantPermanentlyClosed, resp.PermanentlyClosed)
+		}
+	}
+}
+
+func TestPlaceDetailsAtmosphere(t *testing.T) {
+	server := mockServer(200, `{
+    "result": {
+        "curbside_pickup": true,
+        "delivery": false,
+        "editorial_summary": {"language": "en", "overview": "Upscale, modern Australian fine dining."},
+        "reservable": true,
+        "serves_breakfast": false,
+        "serves_brunch": true,
+        "serves_lunch": true,
+        "serves_vegetarian_food": true,
+        "takeout": true,
+        "current_opening_hours": {
+            "open_now": true,
+            "special_days": [{"date": "2026-12-25", "exceptional_hours": true}, {"date": "2026-12-26"}]
+        }
+    },
+    "status": "OK"
+}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	resp, err := c.PlaceDetails(context.Background(), &PlaceDetailsRequest{PlaceID: "ChIJ02qnq0KuEmsRHUJF4zo1x4I"})
+	if err != nil {
+		t.Fatalf("r.Get returned non nil error: %v", err)
+	}
+	if !resp.CurbsidePickup || resp.Delivery || !resp.Reservable || resp.ServesBreakfast ||
+		!resp.ServesBrunch || !resp.ServesLunch || !resp.ServesVegetarianFood || !resp.Takeout {
+		t.Errorf("unexpected services %+v", resp)
+	}
+	if resp.EditorialSummary == nil || resp.EditorialSummary.Overview != "Upscale, modern Australian fine dining." {
+		t.Errorf("unexpected editorial summary %+v", resp.EditorialSummary)
+	}
+	expected := []SpecialDay{{Date: "2026-12-25", ExceptionalHours: true}, {Date: "2026-12-26"}}
+	if !reflect.DeepEqual(resp.CurrentOpeningHours.SpecialDays, expected) {
+		t.Errorf("expected special days %+v, was %+v", expected, resp.CurrentOpeningHours.SpecialDays)
+	}
+}
+
+func TestPlaceReviewTime(t *testing.T) {
+	var review PlaceReview
+	err := json.Unmarshal([]byte(`{
+        "author_name": "Jane",
+        "language": "en",
+        "original_language": "fr",
+        "text": "Excellent",
+        "time": 1700000000,
+        "translated": true
+    }`), &review)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Unix(1700000000, 0).UTC(); !review.PublishTime.Equal(want) || review.Time != 1700000000 {
+		t.Errorf("expected publish time %v, was %v (%d)", want, review.PublishTime, review.Time)
+	}
+	if review.OriginalLanguage != "fr" || !review.Translated {
+		t.Errorf("expected translation from fr, was %q, %v", review.OriginalLanguage, review.Translated)
+	}
+
+	data, err := json.Marshal(PlaceReview{AuthorName: "Jane", PublishTime: time.Unix(1600000000, 0)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	review = PlaceReview{}
+	if err := json.Unmarshal(data, &review); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if review.Time != 1600000000 || review.PublishTime.Unix() != 1600000000 || review.AuthorName != "Jane" {
+		t.Errorf("unexpected round trip of %s: %+v", data, review)
+	}
+}
+
+func TestPlaceDetailsIfChanged(t *testing.T) {
+	lastModified := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("If-Modified-Since") == "Thu, 01 Oct 2026 12:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Thu, 01 Oct 2026 12:00:00 GMT")
+		fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "ChIJ02qnq0KuEmsRHUJF4zo1x4I"}}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &PlaceDetailsRequest{PlaceID: "ChIJ02qnq0KuEmsRHUJF4zo1x4I"}
+
+	resp, err := c.PlaceDetails(context.Background(), r)
+	if err != nil {
+		t.Fatalf("r.Get returned non nil error: %v", err)
+	}
+	want := &CacheValidators{ETag: `"v1"`, LastModified: lastModified}
+	if !reflect.DeepEqual(resp.CacheValidators, want) {
+		t.Errorf("expected CacheValidators %+v, was %+v", want, resp.CacheValidators)
+	}
+
+	r.IfChanged = resp.CacheValidators
+	if _, err := c.PlaceDetails(context.Background(), r); err != ErrNotModified {
+		t.Errorf("expected ErrNotModified, was %v", err)
+	}
+
+	r.IfChanged = &CacheValidators{ETag: `"v0"`}
+	if _, err := c.PlaceDetails(context.Background(), r); err != nil {
+		t.Errorf("r.Get returned non nil error: %v", err)
+	}
+}
+
 func TestPlaceDetailsMissingPlaceID(t *testing.T) {
 	c, _ := NewClient(WithAPIKey(apiKey))
 	r := &PlaceDetailsRequest{}
@@ -882,6 +1027,42 @@ func TestPlaceDetailsMissingPlaceID(t *testing.T) {
 	}
 }
 
+func TestPlaceDetailsReviewsRequestURL(t *testing.T) {
+	expectedQuery := "key=AIzaNotReallyAnAPIKey&placeid=ChIJN1t_tDeuEmsRUsoyG83frY4&reviews_no_translations=true&reviews_sort=newest"
+	server := mockServerForQuery(expectedQuery, 200, `{"status":"OK"}"`)
+	defer server.s.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	r := &PlaceDetailsRequest{
+		PlaceID:               "ChIJN1t_tDeuEmsRUsoyG83frY4",
+		ReviewsNoTranslations: true,
+		ReviewsSort:           ReviewsSortNewest,
+	}
+
+	_, err := c.PlaceDetails(context.Background(), r)
+
+	if err != nil {
+		t.Errorf("Unexpected error in constructing request URL: %+v", err)
+	} else if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+}
+
+func TestPlaceDetailsInvalidReviewsSort(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &PlaceDetailsRequest{PlaceID: "ChIJN1t_tDeuEmsRUsoyG83frY4", ReviewsSort: "oldest"}
+
+	_, err := c.PlaceDetails(context.Background(), r)
+
+	if err == nil || err.Error() != `maps: unknown ReviewsSort "oldest"` {
+		t.Errorf("Wrong error returned \"%v\"", err)
+	}
+	if err := r.Validate(); err == nil {
+		t.Errorf("Validate should return error for ReviewsSort")
+	}
+}
+
 func TestPlacePhotoMissingPhotoReference(t *testing.T) {
 	c, _ := NewClient(WithAPIKey(apiKey))
 	r := &PlacePhotoRequest{}
@@ -915,6 +1096,54 @@ func TestPlacePhotoMissingWidthAndHeight(t *testing.T) {
 	}
 }
 
+func TestPlacePhotoFormats(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 2, 1))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	contentType, body := "image/png", encoded.Bytes()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write(body)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &PlacePhotoRequest{PhotoReference: "ThisIsNotAPhotoReference", MaxWidth: 400}
+
+	resp, err := c.PlacePhoto(context.Background(), r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img, err := resp.Image()
+	if err != nil {
+		t.Fatalf("unexpected error decoding PNG: %v", err)
+	}
+	if img.Bounds().Dx() != 2 {
+		t.Errorf("expected width 2, was %d", img.Bounds().Dx())
+	}
+
+	contentType, body = "image/webp", []byte("RIFF\x1a\x00\x00\x00WEBPVP8 ")
+	resp, err = c.PlacePhoto(context.Background(), r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var saved bytes.Buffer
+	if err := resp.SaveTo(&saved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(saved.Bytes(), body) {
+		t.Errorf("expected %q, was %q", body, saved.Bytes())
+	}
+
+	resp, err = c.PlacePhoto(context.Background(), r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := resp.Image(); err == nil || err.Error() != "maps: no decoder registered for image/webp" {
+		t.Errorf("Wrong error returned \"%v\"", err)
+	}
+}
+
 func TestTextSearchWithPermanentlyClosed(t *testing.T) {
 	response := `
 	{
@@ -1176,6 +1405,190 @@ func TestNearbySearchZeroResults(t *testing.T) {
 	}
 }
 
+func TestNearbySearchOpenNowPostFilter(t *testing.T) {
+	response := `{
+		"status": "OK",
+		"results": [
+			{"place_id": "open", "opening_hours": {"open_now": true}},
+			{"place_id": "closed", "opening_hours": {"open_now": false}},
+			{"place_id": "unknown"},
+			{"place_id": "empty", "opening_hours": {}}
+		]
+	}`
+	expectedQuery := "key=AIzaNotReallyAnAPIKey&location=1%2C2&radius=1000"
+	server := mockServerForQuery(expectedQuery, 200, response)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	r := &NearbySearchRequest{
+		Location:          &LatLng{1.0, 2.0},
+		Radius:            1000,
+		OpenNow:           true,
+		OpenNowPostFilter: true,
+	}
+
+	resp, err := c.NearbySearch(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if server.successful != 1 {
+		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
+	}
+
+	placeIDs := func(results []PlacesSearchResult) []string {
+		var ids []string
+		for _, result := range results {
+			ids = append(ids, result.PlaceID)
+		}
+		return ids
+	}
+	if ids := placeIDs(resp.Results); !reflect.DeepEqual(ids, []string{"open"}) {
+		t.Errorf("expected open places [open], was %v", ids)
+	}
+	if ids := placeIDs(resp.OpenStatusUnknown); !reflect.DeepEqual(ids, []string{"unknown", "empty"}) {
+		t.Errorf("expected unknown places [unknown empty], was %v", ids)
+	}
+}
+
+func TestOpeningHoursIsOpenAt(t *testing.T) {
+	weekdays := &OpeningHours{Periods: []OpeningHoursPeriod{
+		{Open: OpeningHoursOpenClose{Day: time.Monday, Time: "0900"}, Close: OpeningHoursOpenClose{Day: time.Monday, Time: "1700"}},
+		// Open overnight into Saturday, and from Saturday into Sunday.
+		{Open: OpeningHoursOpenClose{Day: time.Friday, Time: "1800"}, Close: OpeningHoursOpenClose{Day: time.Saturday, Time: "0200"}},
+		{Open: OpeningHoursOpenClose{Day: time.Saturday, Time: "2200"}, Close: OpeningHoursOpenClose{Day: time.Sunday, Time: "0100"}},
+	}}
+	always := &OpeningHours{Periods: []OpeningHoursPeriod{
+		{Open: OpeningHoursOpenClose{Day: time.Sunday, Time: "0000"}},
+	}}
+
+	// January 5, 2026 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		hours *OpeningHours
+		t     time.Time
+		open  bool
+	}{
+		{weekdays, at(5, 8, 59), false},
+		{weekdays, at(5, 9, 0), true},
+		{weekdays, at(5, 16, 59), true},
+		{weekdays, at(5, 17, 0), false},
+		{weekdays, at(9, 23, 0), true},
+		{weekdays, at(10, 1, 59), true},
+		{weekdays, at(10, 2, 0), false},
+		{weekdays, at(11, 0, 30), true},
+		{weekdays, at(11, 1, 0), false},
+		{always, at(7, 3, 0), true},
+		{&OpeningHours{}, at(5, 12, 0), false},
+	}
+	for _, test := range tests {
+		if open := test.hours.IsOpenAt(test.t); open != test.open {
+			t.Errorf("%v: expected open %v, was %v", test.t, test.open, open)
+		}
+	}
+
+	// Periods are compared with the time in the place's UTC offset: 16:00 UTC
+	// is 08:00 in California, before the place opens, and 17:00 UTC is 09:00.
+	offset := -480
+	periods := []PlacesSearchResult{{PlaceID: "weekdays", OpeningHours: weekdays, UTCOffset: &offset}}
+	open, unknown := filterOpenAt(periods, at(5, 16, 0))
+	if len(open) != 0 || len(unknown) != 0 {
+		t.Errorf("expected place to be closed, was open %v unknown %v", open, unknown)
+	}
+	open, unknown = filterOpenAt(periods, at(5, 17, 0))
+	if len(open) != 1 || len(unknown) != 0 {
+		t.Errorf("expected place to be open, was open %v unknown %v", open, unknown)
+	}
+
+	// Without a UTC offset the place's local time is unknown.
+	periods[0].UTCOffset = nil
+	open, unknown = filterOpenAt(periods, at(5, 12, 0))
+	if len(open) != 0 || len(unknown) != 1 {
+		t.Errorf("expected place to be unknown, was open %v unknown %v", open, unknown)
+	}
+}
+
+// undecodedFields returns the paths of all fields present in raw which are
+// missing from decoded, where both are the generic JSON decoding of a value.
+func undecodedFields(path string, raw, decoded interface{}) []string {
+	var missing []string
+	switch r := raw.(type) {
+	case map[string]interface{}:
+		d, _ := decoded.(map[string]interface{})
+		for k, v := range r {
+			dv, ok := d[k]
+			if !ok {
+				missing = append(missing, path+"."+k)
+				continue
+			}
+			missing = append(missing, undecodedFields(path+"."+k, v, dv)...)
+		}
+	case []interface{}:
+		d, _ := decoded.([]interface{})
+		for i, v := range r {
+			if i >= len(d) {
+				missing = append(missing, fmt.Sprintf("%s[%d]", path, i))
+				continue
+			}
+			missing = append(missing, undecodedFields(fmt.Sprintf("%s[%d]", path, i), v, d[i])...)
+		}
+	}
+	return missing
+}
+
+func TestNearbySearchFixtureFieldsDecoded(t *testing.T) {
+	response, err := ioutil.ReadFile("testdata/places_nearbysearch.json")
+	if err != nil {
+		t.Fatalf("Unable to read fixture: %v", err)
+	}
+
+	server := mockServer(200, string(response))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &NearbySearchRequest{
+		Location: &LatLng{-33.8587, 151.2140},
+		Radius:   1500,
+	}
+
+	resp, err := c.NearbySearch(context.Background(), r)
+	if err != nil {
+		t.Fatalf("r.Get returned non nil error: %v", err)
+	}
+
+	var raw struct {
+		Results []interface{} `json:"results"`
+	}
+	if err := json.Unmarshal(response, &raw); err != nil {
+		t.Fatalf("Unable to decode fixture: %v", err)
+	}
+	if len(raw.Results) != len(resp.Results) {
+		t.Fatalf("expected %d results, was %d", len(raw.Results), len(resp.Results))
+	}
+
+	for i, result := range resp.Results {
+		b, err := json.Marshal(result)
+		if err != nil {
+			t.Fatalf("Unable to encode result: %v", err)
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("Unable to decode result: %v", err)
+		}
+		if missing := undecodedFields(fmt.Sprintf("results[%d]", i), raw.Results[i], decoded); len(missing) > 0 {
+			t.Errorf("fields not decoded into PlacesSearchResult: %v", missing)
+		}
+	}
+
+	result := resp.Results[0]
+	if result.Reference != "ChIJi6C1MxquEmsR9-c-3O48ykI" {
+		t.Errorf("expected %+v, was %+v", "ChIJi6C1MxquEmsR9-c-3O48ykI", result.Reference)
+	}
+	plusCode := &AddressPlusCode{GlobalCode: "4RRH46R6+G2", CompoundCode: "46R6+G2 The Rocks, New South Wales"}
+	if !reflect.DeepEqual(plusCode, result.PlusCode) {
+		t.Errorf("expected %+v, was %+v", plusCode, result.PlusCode)
+	}
+}
+
 func TestFindPlaceFromTextZeroResults(t *testing.T) {
 	server := mockServer(200, `{"candidates" : [], "status" : "ZERO_RESULTS"}`)
 	defer server.Close()
@@ -1233,3 +1646,118 @@ func TestQueryAutocompleteZeroResults(t *testing.T) {
 		t.Errorf("Unexpected predictions for ZERO_RESULTS status")
 	}
 }
+
+func TestNearbySearchMaxDistance(t *testing.T) {
+	response := `{
+		"status": "OK",
+		"next_page_token": "token",
+		"results": [
+			{"place_id": "near", "geometry": {"location": {"lat": 1.0, "lng": 2.001}}},
+			{"place_id": "far", "geometry": {"location": {"lat": 1.0, "lng": 2.1}}}
+		]
+	}`
+	server := mockServer(200, response)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &NearbySearchRequest{
+		Location:    &LatLng{1.0, 2.0},
+		Keyword:     "cafe",
+		RankBy:      RankByDistance,
+		MaxDistance: 1000,
+	}
+
+	resp, err := c.NearbySearch(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].PlaceID != "near" {
+		t.Errorf("expected only the near place, was %+v", resp.Results)
+	}
+	if resp.NextPageToken != "" {
+		t.Errorf("expected no next page token, was %q", resp.NextPageToken)
+	}
+
+	r.MaxDistance = 20000
+	resp, err = c.NearbySearch(context.Background(), r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(resp.Results) != 2 || resp.NextPageToken != "token" {
+		t.Errorf("expected both places and a next page token, was %+v", resp)
+	}
+}
+
+func TestNearbySearchInvalidRankBy(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &NearbySearchRequest{
+		Location: &LatLng{1.0, 2.0},
+		Radius:   1000,
+		RankBy:   RankBy("rating"),
+	}
+
+	_, err := c.NearbySearch(context.Background(), r)
+	if err == nil || err.Error() != `maps: RankBy "rating" invalid, use RankByProminence or RankByDistance` {
+		t.Errorf("Unexpected error for invalid RankBy: %v", err)
+	}
+}
+
+func TestParseRankBy(t *testing.T) {
+	for s, want := range map[string]RankBy{"prominence": RankByProminence, "Distance": RankByDistance} {
+		if got, err := ParseRankBy(s); err != nil || got != want {
+			t.Errorf("ParseRankBy(%q) = %q, %v, want %q", s, got, err, want)
+		}
+	}
+	if _, err := ParseRankBy("rating"); err == nil {
+		t.Errorf("Expected an error parsing rating")
+	}
+}
+
+func TestParsePriceLevel(t *testing.T) {
+	for _, s := range []string{"2", "moderate", "PRICE_LEVEL_MODERATE"} {
+		p, err := ParsePriceLevel(s)
+		if err != nil || p != PriceLevelModerate {
+			t.Errorf("ParsePriceLevel(%q) = %q, %v, want PriceLevelModerate", s, p, err)
+		}
+	}
+	if _, err := ParsePriceLevel("5"); err == nil {
+		t.Error("expected error for price level 5")
+	}
+	if i := PriceLevelVeryExpensive.Int(); i != 4 {
+		t.Errorf("expected 4, was %d", i)
+	}
+	if i := PriceLevel("").Int(); i != -1 {
+		t.Errorf("expected -1 for unknown price level, was %d", i)
+	}
+}
+
+func TestPriceLevelJSON(t *testing.T) {
+	var results []PlacesSearchResult
+	err := json.Unmarshal([]byte(`[{"price_level": 0}, {"price_level": 3}, {}]`), &results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []PriceLevel{PriceLevelFree, PriceLevelExpensive, ""}
+	for i, r := range results {
+		if r.PriceLevel != expected[i] {
+			t.Errorf("result %d: expected %q, was %q", i, expected[i], r.PriceLevel)
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Known   PriceLevel `json:"known"`
+		Unknown PriceLevel `json:"unknown"`
+		Omitted PriceLevel `json:"omitted,omitempty"`
+	}{Known: PriceLevelModerate})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"known":2,"unknown":null}` {
+		t.Errorf("unexpected JSON %s", data)
+	}
+	if err := json.Unmarshal([]byte(`{"price_level": 7}`), &results[0]); err != nil || results[0].PriceLevel.Int() != -1 {
+		t.Errorf("expected unknown price level 7, was %q, %v", results[0].PriceLevel, err)
+	}
+	if err := json.Unmarshal([]byte(`{"price_level": {}}`), &results[0]); err == nil {
+		t.Error("expected error for price level object")
+	}
+}
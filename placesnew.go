@@ -0,0 +1,641 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// More information about Google Places API (New) is available on
+// https://developers.google.com/maps/documentation/places/web-service/op-overview
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var placeDetailsNewAPI = &apiConfig{
+	host:             "https://places.googleapis.com",
+	path:             "/v1/places/",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// PlaceDetailsNew makes a Places API (New) Place Details request. Unlike
+// PlaceDetails, it returns the new Place schema, including fuel, EV charging,
+// parking, accessibility and payment options.
+func (c *Client) PlaceDetailsNew(ctx context.Context, r *PlaceDetailsNewRequest) (*Place, error) {
+	if r.PlaceID == "" {
+		return nil, errors.New("maps: PlaceID missing")
+	}
+	if o := callOptionsFromContext(ctx); len(r.FieldMask) == 0 && (o == nil || len(o.fieldMask) == 0) {
+		return nil, errors.New("maps: FieldMask missing")
+	}
+
+	var response struct {
+		Place
+		googleAPIResponse
+	}
+	if err := c.getJSON(ctx, placeDetailsNewAPI, r, &response); err != nil {
+		return nil, err
+	}
+	if err := response.StatusError(); err != nil {
+		return nil, err
+	}
+	return &response.Place, nil
+}
+
+var placePhotoNewAPI = &apiConfig{
+	host:             "https://places.googleapis.com",
+	path:             "/v1/",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// maxPhotoNewPx is the largest MaxWidthPx and MaxHeightPx of a photo request.
+const maxPhotoNewPx = 4800
+
+// PlacePhotoNew makes a Places API (New) Place Photo request for the photo
+// named r.Name, as returned in PhotoInfo.Name. It returns the photo itself, or
+// only its URI if r.SkipHTTPRedirect is set.
+func (c *Client) PlacePhotoNew(ctx context.Context, r *PlacePhotoNewRequest) (*PlacePhotoNewResponse, error) {
+	parts := strings.Split(r.Name, "/")
+	if len(parts) != 4 || parts[0] != "places" || parts[1] == "" || parts[2] != "photos" || parts[3] == "" {
+		return nil, fmt.Errorf("maps: invalid photo Name %q", r.Name)
+	}
+	if r.MaxWidthPx == 0 && r.MaxHeightPx == 0 {
+		return nil, errors.New("maps: both MaxHeightPx & MaxWidthPx missing")
+	}
+	if r.MaxWidthPx < 0 || r.MaxWidthPx > maxPhotoNewPx || r.MaxHeightPx < 0 || r.MaxHeightPx > maxPhotoNewPx {
+		return nil, fmt.Errorf("maps: MaxHeightPx & MaxWidthPx must be at most %d", maxPhotoNewPx)
+	}
+
+	if r.SkipHTTPRedirect {
+		var response struct {
+			PlacePhotoNewResponse
+			googleAPIResponse
+		}
+		if err := c.getJSON(ctx, placePhotoNewAPI, r, &response); err != nil {
+			return nil, err
+		}
+		if err := response.StatusError(); err != nil {
+			return nil, err
+		}
+		return &response.PlacePhotoNewResponse, nil
+	}
+
+	resp, err := c.getBinary(ctx, placePhotoNewAPI, r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.statusCode != http.StatusOK {
+		defer resp.data.Close()
+		b, err := ioutil.ReadAll(resp.data)
+		if err != nil {
+			return nil, err
+		}
+		return nil, httpStatusError("Places API", resp, b)
+	}
+	return &PlacePhotoNewResponse{Name: r.Name, ContentType: resp.contentType, Data: resp.data}, nil
+}
+
+// PlacePhotoNewRequest is the request structure for the Places API (New)
+// Place Photo method.
+type PlacePhotoNewRequest struct {
+	// Name is the resource name of the photo, in the form
+	// places/{place}/photos/{photo}. Required.
+	Name string
+	// MaxWidthPx is the maximum width of the photo, from 1 to 4800. One of
+	// MaxWidthPx and MaxHeightPx is required.
+	MaxWidthPx int
+	// MaxHeightPx is the maximum height of the photo, from 1 to 4800. One of
+	// MaxWidthPx and MaxHeightPx is required.
+	MaxHeightPx int
+	// SkipHTTPRedirect returns the URI of the photo instead of the photo.
+	// Optional.
+	SkipHTTPRedirect bool
+}
+
+func (r *PlacePhotoNewRequest) resourcePath() string {
+	parts := strings.Split(r.Name, "/")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+	return strings.Join(parts, "/") + "/media"
+}
+
+func (r *PlacePhotoNewRequest) params() url.Values {
+	q := make(url.Values)
+	if r.MaxWidthPx > 0 {
+		q.Set("maxWidthPx", strconv.Itoa(r.MaxWidthPx))
+	}
+	if r.MaxHeightPx > 0 {
+		q.Set("maxHeightPx", strconv.Itoa(r.MaxHeightPx))
+	}
+	if r.SkipHTTPRedirect {
+		q.Set("skipHttpRedirect", "true")
+	}
+	return q
+}
+
+// PlacePhotoNewResponse is a response to the Places API (New) Place Photo
+// request. Either PhotoURI or Data is set.
+type PlacePhotoNewResponse struct {
+	// Name is the resource name of the photo.
+	Name string `json:"name"`
+	// PhotoURI is a short-lived URI of the photo, set if the request skipped
+	// the HTTP redirect.
+	PhotoURI string `json:"photoUri"`
+	// ContentType is the server reported type of the Image.
+	ContentType string `json:"-"`
+	// Data is the server returned image data, set if the request did not skip
+	// the HTTP redirect. You must close this after you are finished.
+	Data io.ReadCloser `json:"-"`
+}
+
+// Image will read and close response.Data and return it as an image. JPEG and
+// PNG images are supported. WebP images require a decoder to be registered,
+// such as by importing golang.org/x/image/webp.
+func (resp *PlacePhotoNewResponse) Image() (image.Image, error) {
+	if resp.Data == nil {
+		return nil, errors.New("maps: photo data missing, request it without SkipHTTPRedirect")
+	}
+	defer resp.Data.Close()
+	if !strings.HasPrefix(resp.ContentType, "image/") {
+		return nil, errors.New("Image of unknown format: " + resp.ContentType)
+	}
+	img, _, err := image.Decode(resp.Data)
+	if err == image.ErrFormat {
+		return nil, fmt.Errorf("maps: no decoder registered for %v", resp.ContentType)
+	}
+	return img, err
+}
+
+// PlaceDetailsNewRequest is the request structure for the Places API (New)
+// Place Details method.
+type PlaceDetailsNewRequest struct {
+	// PlaceID is the ID of the place. Required.
+	PlaceID string
+	// FieldMask lists the fields of the Place to return. Required, unless set
+	// with CallFieldMask.
+	FieldMask PlaceFieldMask
+	// LanguageCode is the language of the returned text. Optional.
+	LanguageCode string
+	// RegionCode is the ccTLD region code used to format the response. Optional.
+	RegionCode string
+	// SessionToken is a token that marks this request as part of a Place
+	// Autocomplete session. Optional.
+	SessionToken PlaceAutocompleteSessionToken
+}
+
+func (r *PlaceDetailsNewRequest) resourcePath() string {
+	return url.PathEscape(r.PlaceID)
+}
+
+func (r *PlaceDetailsNewRequest) params() url.Values {
+	q := make(url.Values)
+	if r.LanguageCode != "" {
+		q.Set("languageCode", r.LanguageCode)
+	}
+	if r.RegionCode != "" {
+		q.Set("regionCode", r.RegionCode)
+	}
+	if st := uuid.UUID(r.SessionToken).String(); st != "00000000-0000-0000-0000-000000000000" {
+		q.Set("sessionToken", st)
+	}
+	return q
+}
+
+func (r *PlaceDetailsNewRequest) header() http.Header {
+	h := http.Header{}
+	if len(r.FieldMask) > 0 {
+		h.Set("X-Goog-FieldMask", r.FieldMask.String())
+	}
+	return h
+}
+
+// PlaceField is a field of a Place, for use in a PlaceFieldMask.
+type PlaceField string
+
+// Fields of a Place. Fields are billed at the SKU of the most expensive field
+// requested.
+const (
+	PlaceFieldAll                          = PlaceField("*")
+	PlaceFieldID                           = PlaceField("id")
+	PlaceFieldName                         = PlaceField("name")
+	PlaceFieldDisplayName                  = PlaceField("displayName")
+	PlaceFieldTypes                        = PlaceField("types")
+	PlaceFieldPrimaryType                  = PlaceField("primaryType")
+	PlaceFieldPrimaryTypeDisplayName       = PlaceField("primaryTypeDisplayName")
+	PlaceFieldNationalPhoneNumber          = PlaceField("nationalPhoneNumber")
+	PlaceFieldInternationalPhoneNumber     = PlaceField("internationalPhoneNumber")
+	PlaceFieldFormattedAddress             = PlaceField("formattedAddress")
+	PlaceFieldShortFormattedAddress        = PlaceField("shortFormattedAddress")
+	PlaceFieldAddressComponents            = PlaceField("addressComponents")
+	PlaceFieldAdrFormatAddress             = PlaceField("adrFormatAddress")
+	PlaceFieldPlusCode                     = PlaceField("plusCode")
+	PlaceFieldLocation                     = PlaceField("location")
+	PlaceFieldViewport                     = PlaceField("viewport")
+	PlaceFieldRating                       = PlaceField("rating")
+	PlaceFieldUserRatingCount              = PlaceField("userRatingCount")
+	PlaceFieldGoogleMapsURI                = PlaceField("googleMapsUri")
+	PlaceFieldWebsiteURI                   = PlaceField("websiteUri")
+	PlaceFieldReviews                      = PlaceField("reviews")
+	PlaceFieldPhotos                       = PlaceField("photos")
+	PlaceFieldRegularOpeningHours          = PlaceField("regularOpeningHours")
+	PlaceFieldCurrentOpeningHours          = PlaceField("currentOpeningHours")
+	PlaceFieldRegularSecondaryOpeningHours = PlaceField("regularSecondaryOpeningHours")
+	PlaceFieldCurrentSecondaryOpeningHours = PlaceField("currentSecondaryOpeningHours")
+	PlaceFieldUTCOffsetMinutes             = PlaceField("utcOffsetMinutes")
+	PlaceFieldBusinessStatus               = PlaceField("businessStatus")
+	PlaceFieldPriceLevel                   = PlaceField("priceLevel")
+	PlaceFieldIconMaskBaseURI              = PlaceField("iconMaskBaseUri")
+	PlaceFieldIconBackgroundColor          = PlaceField("iconBackgroundColor")
+	PlaceFieldEditorialSummary             = PlaceField("editorialSummary")
+	PlaceFieldTakeout                      = PlaceField("takeout")
+	PlaceFieldDelivery                     = PlaceField("delivery")
+	PlaceFieldDineIn                       = PlaceField("dineIn")
+	PlaceFieldCurbsidePickup               = PlaceField("curbsidePickup")
+	PlaceFieldReservable                   = PlaceField("reservable")
+	PlaceFieldServesBreakfast              = PlaceField("servesBreakfast")
+	PlaceFieldServesLunch                  = PlaceField("servesLunch")
+	PlaceFieldServesDinner                 = PlaceField("servesDinner")
+	PlaceFieldServesBrunch                 = PlaceField("servesBrunch")
+	PlaceFieldServesBeer                   = PlaceField("servesBeer")
+	PlaceFieldServesWine                   = PlaceField("servesWine")
+	PlaceFieldServesCocktails              = PlaceField("servesCocktails")
+	PlaceFieldServesCoffee                 = PlaceField("servesCoffee")
+	PlaceFieldServesDessert                = PlaceField("servesDessert")
+	PlaceFieldServesVegetarianFood         = PlaceField("servesVegetarianFood")
+	PlaceFieldOutdoorSeating               = PlaceField("outdoorSeating")
+	PlaceFieldLiveMusic                    = PlaceField("liveMusic")
+	PlaceFieldMenuForChildren              = PlaceField("menuForChildren")
+	PlaceFieldGoodForChildren              = PlaceField("goodForChildren")
+	PlaceFieldGoodForGroups                = PlaceField("goodForGroups")
+	PlaceFieldGoodForWatchingSports        = PlaceField("goodForWatchingSports")
+	PlaceFieldAllowsDogs                   = PlaceField("allowsDogs")
+	PlaceFieldRestroom                     = PlaceField("restroom")
+	PlaceFieldPaymentOptions               = PlaceField("paymentOptions")
+	PlaceFieldParkingOptions               = PlaceField("parkingOptions")
+	PlaceFieldAccessibilityOptions         = PlaceField("accessibilityOptions")
+	PlaceFieldFuelOptions                  = PlaceField("fuelOptions")
+	PlaceFieldEVChargeOptions              = PlaceField("evChargeOptions")
+)
+
+// PlaceFieldMask is a set of Place fields to return.
+type PlaceFieldMask []PlaceField
+
+// NewPlaceFieldMask returns a PlaceFieldMask of fields.
+func NewPlaceFieldMask(fields ...PlaceField) PlaceFieldMask {
+	return PlaceFieldMask(fields)
+}
+
+// With returns m with fields added, omitting fields already in m.
+func (m PlaceFieldMask) With(fields ...PlaceField) PlaceFieldMask {
+	result := append(PlaceFieldMask(nil), m...)
+	for _, f := range fields {
+		if !result.Has(f) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// Has reports whether m contains field.
+func (m PlaceFieldMask) Has(field PlaceField) bool {
+	for _, f := range m {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns m in the X-Goog-FieldMask header format.
+func (m PlaceFieldMask) String() string {
+	fields := make([]string, len(m))
+	for i, f := range m {
+		fields[i] = string(f)
+	}
+	return strings.Join(fields, ",")
+}
+
+// Place is a place returned by the Places API (New). Only the fields selected
+// by the request's field mask are set. Amenities which the API has no data for
+// are nil.
+type Place struct {
+	// Name is the resource name of the place, in the form places/{place_id}.
+	Name string `json:"name,omitempty"`
+	// ID is the place ID of the place.
+	ID string `json:"id,omitempty"`
+	// DisplayName is the localized name of the place.
+	DisplayName *LocalizedText `json:"displayName,omitempty"`
+	// Types are the types of the place.
+	Types []string `json:"types,omitempty"`
+	// PrimaryType is the primary type of the place.
+	PrimaryType string `json:"primaryType,omitempty"`
+	// PrimaryTypeDisplayName is the localized name of the primary type.
+	PrimaryTypeDisplayName *LocalizedText `json:"primaryTypeDisplayName,omitempty"`
+	// NationalPhoneNumber is the phone number in national format.
+	NationalPhoneNumber string `json:"nationalPhoneNumber,omitempty"`
+	// InternationalPhoneNumber is the phone number in international format.
+	InternationalPhoneNumber string `json:"internationalPhoneNumber,omitempty"`
+	// FormattedAddress is the full, human-readable address of the place.
+	FormattedAddress string `json:"formattedAddress,omitempty"`
+	// ShortFormattedAddress is a short, human-readable address of the place.
+	ShortFormattedAddress string `json:"shortFormattedAddress,omitempty"`
+	// AddressComponents are the components of the address of the place.
+	AddressComponents []PlaceAddressComponent `json:"addressComponents,omitempty"`
+	// AdrFormatAddress is the address in adr microformat.
+	AdrFormatAddress string `json:"adrFormatAddress,omitempty"`
+	// PlusCode is the plus code of the place.
+	PlusCode *PlacePlusCode `json:"plusCode,omitempty"`
+	// Location is the location of the place.
+	Location *LatLng `json:"location,omitempty"`
+	// Viewport is a viewport suitable for displaying the place.
+	Viewport *Viewport `json:"viewport,omitempty"`
+	// Rating is the rating of the place, from 1.0 to 5.0.
+	Rating float64 `json:"rating,omitempty"`
+	// UserRatingCount is the number of ratings of the place.
+	UserRatingCount int `json:"userRatingCount,omitempty"`
+	// GoogleMapsURI is the URL of the place on Google Maps.
+	GoogleMapsURI string `json:"googleMapsUri,omitempty"`
+	// WebsiteURI is the authoritative website of the place.
+	WebsiteURI string `json:"websiteUri,omitempty"`
+	// Reviews are up to five reviews of the place.
+	Reviews []Review `json:"reviews,omitempty"`
+	// Photos are up to ten photos of the place.
+	Photos []PhotoInfo `json:"photos,omitempty"`
+	// RegularOpeningHours are the regular opening hours of the place.
+	RegularOpeningHours *PlaceOpeningHours `json:"regularOpeningHours,omitempty"`
+	// CurrentOpeningHours are the opening hours of the next seven days,
+	// including exceptional hours.
+	CurrentOpeningHours *PlaceOpeningHours `json:"currentOpeningHours,omitempty"`
+	// RegularSecondaryOpeningHours are the regular opening hours of services of
+	// the place, such as its drive through.
+	RegularSecondaryOpeningHours []PlaceOpeningHours `json:"regularSecondaryOpeningHours,omitempty"`
+	// CurrentSecondaryOpeningHours are the opening hours of services of the
+	// place for the next seven days.
+	CurrentSecondaryOpeningHours []PlaceOpeningHours `json:"currentSecondaryOpeningHours,omitempty"`
+	// UTCOffsetMinutes is the current offset of the time zone of the place
+	// from UTC, in minutes.
+	UTCOffsetMinutes *int `json:"utcOffsetMinutes,omitempty"`
+	// BusinessStatus is the business status of the place, such as
+	// "OPERATIONAL".
+	BusinessStatus string `json:"businessStatus,omitempty"`
+	// PriceLevel is the price level of the place, decoded from names such as
+	// "PRICE_LEVEL_MODERATE", or "" if it is not known.
+	PriceLevel PriceLevel `json:"priceLevel,omitempty"`
+	// IconMaskBaseURI is the base URL of the icon mask of the place, without
+	// its ".svg" or ".png" extension.
+	IconMaskBaseURI string `json:"iconMaskBaseUri,omitempty"`
+	// IconBackgroundColor is the background color of the icon of the place,
+	// as a hex color.
+	IconBackgroundColor string `json:"iconBackgroundColor,omitempty"`
+	// EditorialSummary is a summary of the place.
+	EditorialSummary *LocalizedText `json:"editorialSummary,omitempty"`
+
+	// Amenities and services of the place.
+	Takeout               *bool `json:"takeout,omitempty"`
+	Delivery              *bool `json:"delivery,omitempty"`
+	DineIn                *bool `json:"dineIn,omitempty"`
+	CurbsidePickup        *bool `json:"curbsidePickup,omitempty"`
+	Reservable            *bool `json:"reservable,omitempty"`
+	ServesBreakfast       *bool `json:"servesBreakfast,omitempty"`
+	ServesLunch           *bool `json:"servesLunch,omitempty"`
+	ServesDinner          *bool `json:"servesDinner,omitempty"`
+	ServesBrunch          *bool `json:"servesBrunch,omitempty"`
+	ServesBeer            *bool `json:"servesBeer,omitempty"`
+	ServesWine            *bool `json:"servesWine,omitempty"`
+	ServesCocktails       *bool `json:"servesCocktails,omitempty"`
+	ServesCoffee          *bool `json:"servesCoffee,omitempty"`
+	ServesDessert         *bool `json:"servesDessert,omitempty"`
+	ServesVegetarianFood  *bool `json:"servesVegetarianFood,omitempty"`
+	OutdoorSeating        *bool `json:"outdoorSeating,omitempty"`
+	LiveMusic             *bool `json:"liveMusic,omitempty"`
+	MenuForChildren       *bool `json:"menuForChildren,omitempty"`
+	GoodForChildren       *bool `json:"goodForChildren,omitempty"`
+	GoodForGroups         *bool `json:"goodForGroups,omitempty"`
+	GoodForWatchingSports *bool `json:"goodForWatchingSports,omitempty"`
+	AllowsDogs            *bool `json:"allowsDogs,omitempty"`
+	Restroom              *bool `json:"restroom,omitempty"`
+
+	// PaymentOptions are the payment methods accepted by the place.
+	PaymentOptions *PaymentOptions `json:"paymentOptions,omitempty"`
+	// ParkingOptions are the parking options of the place.
+	ParkingOptions *ParkingOptions `json:"parkingOptions,omitempty"`
+	// AccessibilityOptions are the accessibility options of the place.
+	AccessibilityOptions *AccessibilityOptions `json:"accessibilityOptions,omitempty"`
+	// FuelOptions are the fuel prices of a gas station.
+	FuelOptions *FuelOptions `json:"fuelOptions,omitempty"`
+	// EVChargeOptions are the EV chargers of the place.
+	EVChargeOptions *EVChargeOptions `json:"evChargeOptions,omitempty"`
+}
+
+// PlaceAddressComponent is a component of the address of a Place.
+type PlaceAddressComponent struct {
+	// LongText is the full text of the component, such as "Australia".
+	LongText string `json:"longText,omitempty"`
+	// ShortText is the abbreviated text of the component, such as "AU".
+	ShortText string `json:"shortText,omitempty"`
+	// Types are the types of the component.
+	Types []string `json:"types,omitempty"`
+	// LanguageCode is the language of the component.
+	LanguageCode string `json:"languageCode,omitempty"`
+}
+
+// PlacePlusCode is the plus code of a Place.
+type PlacePlusCode struct {
+	// GlobalCode is the global plus code, such as "9FWM33GV+HQ".
+	GlobalCode string `json:"globalCode,omitempty"`
+	// CompoundCode is the local plus code with a locality, such as
+	// "33GV+HQ, Ramberg, Norway".
+	CompoundCode string `json:"compoundCode,omitempty"`
+}
+
+// Review is a review of a Place.
+type Review struct {
+	// Name is the resource name of the review.
+	Name string `json:"name,omitempty"`
+	// RelativePublishTimeDescription describes when the review was published,
+	// such as "a month ago".
+	RelativePublishTimeDescription string `json:"relativePublishTimeDescription,omitempty"`
+	// Rating is the rating of the review, from 1.0 to 5.0.
+	Rating float64 `json:"rating,omitempty"`
+	// Text is the localized text of the review.
+	Text *LocalizedText `json:"text,omitempty"`
+	// OriginalText is the text of the review in its original language.
+	OriginalText *LocalizedText `json:"originalText,omitempty"`
+	// AuthorAttribution is the author of the review.
+	AuthorAttribution AuthorAttribution `json:"authorAttribution"`
+	// PublishTime is the time the review was published.
+	PublishTime time.Time `json:"publishTime"`
+}
+
+// PhotoInfo is a photo of a Place. Name is used to request the photo itself.
+type PhotoInfo struct {
+	// Name is the resource name of the photo.
+	Name string `json:"name,omitempty"`
+	// WidthPx is the maximum width of the photo in pixels.
+	WidthPx int `json:"widthPx,omitempty"`
+	// HeightPx is the maximum height of the photo in pixels.
+	HeightPx int `json:"heightPx,omitempty"`
+	// AuthorAttributions are the authors of the photo, which must be displayed
+	// with it.
+	AuthorAttributions []AuthorAttribution `json:"authorAttributions,omitempty"`
+}
+
+// AuthorAttribution is the author of a review or photo.
+type AuthorAttribution struct {
+	// DisplayName is the name of the author.
+	DisplayName string `json:"displayName,omitempty"`
+	// URI is the URL of the author.
+	URI string `json:"uri,omitempty"`
+	// PhotoURI is the URL of the profile photo of the author.
+	PhotoURI string `json:"photoUri,omitempty"`
+}
+
+// PlaceOpeningHours are the opening hours of a Place.
+type PlaceOpeningHours struct {
+	// OpenNow reports whether the place is open now. It is only set for
+	// current opening hours.
+	OpenNow *bool `json:"openNow,omitempty"`
+	// Periods are the periods the place is open.
+	Periods []PlaceOpeningHoursPeriod `json:"periods,omitempty"`
+	// WeekdayDescriptions are the localized opening hours of each day of the
+	// week, such as "Monday: 9:00 AM – 5:00 PM".
+	WeekdayDescriptions []string `json:"weekdayDescriptions,omitempty"`
+	// SecondaryHoursType is the type of secondary opening hours, such as
+	// "DRIVE_THROUGH".
+	SecondaryHoursType string `json:"secondaryHoursType,omitempty"`
+	// SpecialDays are the days within the period of current opening hours
+	// which have exceptional hours.
+	SpecialDays []PlaceSpecialDay `json:"specialDays,omitempty"`
+	// NextOpenTime is the next time the place opens.
+	NextOpenTime *time.Time `json:"nextOpenTime,omitempty"`
+	// NextCloseTime is the next time the place closes.
+	NextCloseTime *time.Time `json:"nextCloseTime,omitempty"`
+}
+
+// PlaceOpeningHoursPeriod is a period a Place is open. Close is nil for places
+// that are always open.
+type PlaceOpeningHoursPeriod struct {
+	Open  *PlaceOpeningHoursPoint `json:"open,omitempty"`
+	Close *PlaceOpeningHoursPoint `json:"close,omitempty"`
+}
+
+// PlaceOpeningHoursPoint is the time a period of opening hours starts or ends.
+type PlaceOpeningHoursPoint struct {
+	// Day is the day of the week, from 0 for Sunday to 6 for Saturday.
+	Day int `json:"day"`
+	// Hour is the hour of the day, from 0 to 23.
+	Hour int `json:"hour"`
+	// Minute is the minute of the hour, from 0 to 59.
+	Minute int `json:"minute"`
+	// Date is the date of the point, for current opening hours.
+	Date *PlaceDate `json:"date,omitempty"`
+	// Truncated reports whether the point is outside the range of current
+	// opening hours.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// PlaceSpecialDay is a day with exceptional opening hours.
+type PlaceSpecialDay struct {
+	Date PlaceDate `json:"date"`
+}
+
+// PlaceDate is a calendar date.
+type PlaceDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// PaymentOptions are the payment methods accepted by a Place.
+type PaymentOptions struct {
+	AcceptsCreditCards *bool `json:"acceptsCreditCards,omitempty"`
+	AcceptsDebitCards  *bool `json:"acceptsDebitCards,omitempty"`
+	AcceptsCashOnly    *bool `json:"acceptsCashOnly,omitempty"`
+	AcceptsNFC         *bool `json:"acceptsNfc,omitempty"`
+}
+
+// ParkingOptions are the parking options of a Place.
+type ParkingOptions struct {
+	FreeParkingLot    *bool `json:"freeParkingLot,omitempty"`
+	PaidParkingLot    *bool `json:"paidParkingLot,omitempty"`
+	FreeStreetParking *bool `json:"freeStreetParking,omitempty"`
+	PaidStreetParking *bool `json:"paidStreetParking,omitempty"`
+	ValetParking      *bool `json:"valetParking,omitempty"`
+	FreeGarageParking *bool `json:"freeGarageParking,omitempty"`
+	PaidGarageParking *bool `json:"paidGarageParking,omitempty"`
+}
+
+// AccessibilityOptions are the accessibility options of a Place.
+type AccessibilityOptions struct {
+	WheelchairAccessibleParking  *bool `json:"wheelchairAccessibleParking,omitempty"`
+	WheelchairAccessibleEntrance *bool `json:"wheelchairAccessibleEntrance,omitempty"`
+	WheelchairAccessibleRestroom *bool `json:"wheelchairAccessibleRestroom,omitempty"`
+	WheelchairAccessibleSeating  *bool `json:"wheelchairAccessibleSeating,omitempty"`
+}
+
+// FuelOptions are the fuel prices of a gas station.
+type FuelOptions struct {
+	// FuelPrices are the latest known price of each fuel type the station has.
+	FuelPrices []FuelPrice `json:"fuelPrices,omitempty"`
+}
+
+// FuelPrice is the price of a fuel type.
+type FuelPrice struct {
+	// Type is the fuel type, such as "REGULAR_UNLEADED" or "DIESEL".
+	Type string `json:"type"`
+	// Price is the price of the fuel.
+	Price Money `json:"price"`
+	// UpdateTime is the time the price was last updated.
+	UpdateTime time.Time `json:"updateTime"`
+}
+
+// EVChargeOptions are the EV chargers of a Place.
+type EVChargeOptions struct {
+	// ConnectorCount is the number of connectors. A charger may have several
+	// connectors which cannot be used at once.
+	ConnectorCount int `json:"connectorCount,omitempty"`
+	// ConnectorAggregation groups the connectors by type and charge rate.
+	ConnectorAggregation []ConnectorAggregation `json:"connectorAggregation,omitempty"`
+}
+
+// ConnectorAggregation is a group of EV connectors of the same type and charge
+// rate.
+type ConnectorAggregation struct {
+	// Type is the connector type, such as "EV_CONNECTOR_TYPE_CCS_COMBO_1".
+	Type string `json:"type"`
+	// MaxChargeRateKw is the maximum charge rate of the connectors in kW.
+	MaxChargeRateKw float64 `json:"maxChargeRateKw"`
+	// Count is the number of connectors.
+	Count int `json:"count"`
+	// AvailableCount is the number of connectors currently available.
+	AvailableCount *int `json:"availableCount,omitempty"`
+	// OutOfServiceCount is the number of connectors currently out of service.
+	OutOfServiceCount *int `json:"outOfServiceCount,omitempty"`
+	// AvailabilityLastUpdateTime is the time the availability was last
+	// updated.
+	AvailabilityLastUpdateTime *time.Time `json:"availabilityLastUpdateTime,omitempty"`
+}
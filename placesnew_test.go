@@ -0,0 +1,183 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceDetailsNew(t *testing.T) {
+	response := `{
+  "id": "ChIJj61dQgK6j4AR4GeTYWZsKWw",
+  "displayName": {"text": "Googleplex", "languageCode": "en"},
+  "location": {"latitude": 37.4220656, "longitude": -122.0840897},
+  "priceLevel": "PRICE_LEVEL_MODERATE",
+  "viewport": {
+    "low": {"latitude": 37.42, "longitude": -122.09},
+    "high": {"latitude": 37.43, "longitude": -122.08}
+  },
+  "regularOpeningHours": {
+    "openNow": true,
+    "periods": [{"open": {"day": 1, "hour": 8, "minute": 0}, "close": {"day": 1, "hour": 17, "minute": 30}}],
+    "weekdayDescriptions": ["Monday: 8:00 AM – 5:30 PM"]
+  },
+  "accessibilityOptions": {"wheelchairAccessibleEntrance": true, "wheelchairAccessibleRestroom": false},
+  "paymentOptions": {"acceptsNfc": true},
+  "parkingOptions": {"freeParkingLot": true},
+  "fuelOptions": {
+    "fuelPrices": [{"type": "DIESEL", "price": {"currencyCode": "USD", "units": "5", "nanos": 190000000}, "updateTime": "2026-10-15T18:52:18Z"}]
+  },
+  "evChargeOptions": {
+    "connectorCount": 4,
+    "connectorAggregation": [{"type": "EV_CONNECTOR_TYPE_CCS_COMBO_1", "maxChargeRateKw": 150, "count": 4, "availableCount": 2}]
+  }
+}`
+
+	var req *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, response)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	mask := NewPlaceFieldMask(PlaceFieldID, PlaceFieldDisplayName).With(PlaceFieldLocation, PlaceFieldID, PlaceFieldEVChargeOptions)
+	place, err := c.PlaceDetailsNew(context.Background(), &PlaceDetailsNewRequest{
+		PlaceID:      "ChIJj61dQgK6j4AR4GeTYWZsKWw",
+		FieldMask:    mask,
+		LanguageCode: "en",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/v1/places/ChIJj61dQgK6j4AR4GeTYWZsKWw", req.URL.Path)
+	assert.Equal(t, "en", req.URL.Query().Get("languageCode"))
+	assert.Equal(t, "id,displayName,location,evChargeOptions", req.Header.Get("X-Goog-FieldMask"))
+
+	yes, no := true, false
+	available := 2
+	assert.Equal(t, "ChIJj61dQgK6j4AR4GeTYWZsKWw", place.ID)
+	assert.Equal(t, &LocalizedText{Text: "Googleplex", LanguageCode: "en"}, place.DisplayName)
+	assert.Equal(t, &LatLng{Lat: 37.4220656, Lng: -122.0840897}, place.Location)
+	assert.Equal(t, LatLng{Lat: 37.43, Lng: -122.08}, place.Viewport.High)
+	assert.Equal(t, PriceLevelModerate, place.PriceLevel)
+	assert.Equal(t, &yes, place.RegularOpeningHours.OpenNow)
+	assert.Equal(t, &PlaceOpeningHoursPoint{Day: 1, Hour: 17, Minute: 30}, place.RegularOpeningHours.Periods[0].Close)
+	assert.Equal(t, &AccessibilityOptions{WheelchairAccessibleEntrance: &yes, WheelchairAccessibleRestroom: &no}, place.AccessibilityOptions)
+	assert.Equal(t, &PaymentOptions{AcceptsNFC: &yes}, place.PaymentOptions)
+	assert.Equal(t, &ParkingOptions{FreeParkingLot: &yes}, place.ParkingOptions)
+	assert.Equal(t, []FuelPrice{{
+		Type:       "DIESEL",
+		Price:      Money{CurrencyCode: "USD", Units: 5, Nanos: 190000000},
+		UpdateTime: time.Date(2026, 10, 15, 18, 52, 18, 0, time.UTC),
+	}}, place.FuelOptions.FuelPrices)
+	assert.Equal(t, &EVChargeOptions{
+		ConnectorCount:       4,
+		ConnectorAggregation: []ConnectorAggregation{{Type: "EV_CONNECTOR_TYPE_CCS_COMBO_1", MaxChargeRateKw: 150, Count: 4, AvailableCount: &available}},
+	}, place.EVChargeOptions)
+	assert.Nil(t, place.Takeout)
+}
+
+func TestPlaceDetailsNewError(t *testing.T) {
+	server := mockServer(404, `{"error": {"code": 404, "message": "Not found.", "status": "NOT_FOUND"}}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	_, err := c.PlaceDetailsNew(context.Background(), &PlaceDetailsNewRequest{PlaceID: "ChIJ", FieldMask: NewPlaceFieldMask(PlaceFieldAll)})
+	assert.Equal(t, &StatusError{status: "NOT_FOUND", message: "Not found.", httpStatus: 404}, err)
+}
+
+func TestPlaceDetailsNewMissingFields(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	_, err := c.PlaceDetailsNew(context.Background(), &PlaceDetailsNewRequest{FieldMask: NewPlaceFieldMask(PlaceFieldID)})
+	assert.EqualError(t, err, "maps: PlaceID missing")
+	_, err = c.PlaceDetailsNew(context.Background(), &PlaceDetailsNewRequest{PlaceID: "ChIJ"})
+	assert.EqualError(t, err, "maps: FieldMask missing")
+}
+
+func TestPlacePhotoNew(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/photo.jpg" {
+			w.Header().Set("Content-Type", "image/jpeg")
+			fmt.Fprint(w, "JPEG")
+			return
+		}
+		assert.Equal(t, "/v1/places/ChIJ/photos/AUc7/media", r.URL.Path)
+		assert.Equal(t, "400", r.URL.Query().Get("maxWidthPx"))
+		if r.URL.Query().Get("skipHttpRedirect") == "true" {
+			fmt.Fprint(w, `{"name": "places/ChIJ/photos/AUc7/media", "photoUri": "https://lh3.googleusercontent.com/p/AUc7=w400"}`)
+			return
+		}
+		http.Redirect(w, r, "/photo.jpg", http.StatusFound)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &PlacePhotoNewRequest{Name: "places/ChIJ/photos/AUc7", MaxWidthPx: 400}
+
+	resp, err := c.PlacePhotoNew(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", resp.ContentType)
+	var data bytes.Buffer
+	_, err = data.ReadFrom(resp.Data)
+	require.NoError(t, err)
+	resp.Data.Close()
+	assert.Equal(t, "JPEG", data.String())
+
+	resp, err = c.PlacePhotoNew(context.Background(), r)
+	require.NoError(t, err)
+	_, err = resp.Image()
+	assert.EqualError(t, err, "maps: no decoder registered for image/jpeg")
+
+	r.SkipHTTPRedirect = true
+	resp, err = c.PlacePhotoNew(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, "https://lh3.googleusercontent.com/p/AUc7=w400", resp.PhotoURI)
+	assert.Nil(t, resp.Data)
+	_, err = resp.Image()
+	assert.Error(t, err)
+}
+
+func TestPlacePhotoNewInvalid(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	for _, r := range []*PlacePhotoNewRequest{
+		{Name: "AUc7", MaxWidthPx: 400},
+		{Name: "places/ChIJ/photos/", MaxWidthPx: 400},
+		{Name: "places/ChIJ/photos/AUc7"},
+		{Name: "places/ChIJ/photos/AUc7", MaxHeightPx: 5000},
+	} {
+		_, err := c.PlacePhotoNew(context.Background(), r)
+		assert.Error(t, err, r.Name)
+	}
+}
+
+func TestPlacePhotoNewError(t *testing.T) {
+	server := mockServer(404, `{"error": {"code": 404, "message": "Not found.", "status": "NOT_FOUND"}}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &PlacePhotoNewRequest{Name: "places/ChIJ/photos/AUc7", MaxWidthPx: 400}
+	_, err := c.PlacePhotoNew(context.Background(), r)
+	assert.Error(t, err)
+	r.SkipHTTPRedirect = true
+	_, err = c.PlacePhotoNew(context.Background(), r)
+	assert.Equal(t, &StatusError{status: "NOT_FOUND", message: "Not found.", httpStatus: 404}, err)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// MaxPlacesSearchResults is the most results a Text Search or Nearby Search
+	// returns across all its pages, however many places match.
+	MaxPlacesSearchResults = 60
+	// MaxPlacesSearchPages is the most pages a Text Search or Nearby Search
+	// returns, of up to 20 results each.
+	MaxPlacesSearchPages = 3
+
+	placesSearchPageSize = 20
+	// nextPageTokenDelay is the time before a next page token becomes valid.
+	nextPageTokenDelay = 2 * time.Second
+	// pageTokenAttempts is the number of times a page is requested while the
+	// API reports that its page token is not yet valid.
+	pageTokenAttempts = 3
+)
+
+// HasMore reports whether more results can be requested with NextPageToken.
+// No more than MaxPlacesSearchResults are returned in total.
+func (r *PlacesSearchResponse) HasMore() bool {
+	return r.NextPageToken != ""
+}
+
+// PlacesSearchPager requests the pages of a Text Search or Nearby Search in
+// turn. Each page is a billed request.
+type PlacesSearchPager struct {
+	// MaxPages caps the number of pages requested, to limit billing. Values
+	// above MaxPlacesSearchPages have no effect. Default is
+	// MaxPlacesSearchPages.
+	MaxPages int
+	// Pages is the number of pages fetched so far.
+	Pages int
+	// TotalFetched is the number of results fetched so far.
+	TotalFetched int
+
+	client *Client
+	fetch  func(ctx context.Context, pageToken string) (PlacesSearchResponse, error)
+	token  string
+	done   bool
+}
+
+// TextSearchPager returns a pager for the pages of the Text Search r.
+func (c *Client) TextSearchPager(r *TextSearchRequest) *PlacesSearchPager {
+	return &PlacesSearchPager{client: c, fetch: func(ctx context.Context, pageToken string) (PlacesSearchResponse, error) {
+		req := *r
+		req.PageToken = pageToken
+		return c.TextSearch(ctx, &req)
+	}}
+}
+
+// NearbySearchPager returns a pager for the pages of the Nearby Search r.
+func (c *Client) NearbySearchPager(r *NearbySearchRequest) *PlacesSearchPager {
+	return &PlacesSearchPager{client: c, fetch: func(ctx context.Context, pageToken string) (PlacesSearchResponse, error) {
+		req := *r
+		req.PageToken = pageToken
+		return c.NearbySearch(ctx, &req)
+	}}
+}
+
+func (p *PlacesSearchPager) maxPages() int {
+	if p.MaxPages <= 0 || p.MaxPages > MaxPlacesSearchPages {
+		return MaxPlacesSearchPages
+	}
+	return p.MaxPages
+}
+
+// HasNext reports whether Next can fetch another page.
+func (p *PlacesSearchPager) HasNext() bool {
+	return !p.done && p.Pages < p.maxPages()
+}
+
+// MaxRemaining returns the most results that further pages could return. The
+// API does not report the number of matching places, so this is an upper
+// bound rather than an estimate of how many there are.
+func (p *PlacesSearchPager) MaxRemaining() int {
+	if !p.HasNext() {
+		return 0
+	}
+	remaining := (p.maxPages() - p.Pages) * placesSearchPageSize
+	if left := MaxPlacesSearchResults - p.TotalFetched; remaining > left {
+		remaining = left
+	}
+	return remaining
+}
+
+// Next fetches the next page. Pages after the first wait until their page
+// token is valid, which takes a couple of seconds, and are requested again if
+// the API reports INVALID_REQUEST because the token is not valid yet.
+func (p *PlacesSearchPager) Next(ctx context.Context) (PlacesSearchResponse, error) {
+	if !p.HasNext() {
+		return PlacesSearchResponse{}, errors.New("maps: no more pages")
+	}
+	resp, err := p.fetchPage(ctx)
+	for attempt := 1; attempt < pageTokenAttempts && p.token != "" && isInvalidRequest(err); attempt++ {
+		resp, err = p.fetchPage(ctx)
+	}
+	if err != nil {
+		return PlacesSearchResponse{}, err
+	}
+	p.Pages++
+	p.TotalFetched += len(resp.Results)
+	p.token = resp.NextPageToken
+	p.done = !resp.HasMore()
+	return resp, nil
+}
+
+// fetchPage fetches the page of the current token, first waiting until it is
+// valid if it is not the first page.
+func (p *PlacesSearchPager) fetchPage(ctx context.Context) (PlacesSearchResponse, error) {
+	if p.token != "" {
+		if err := p.client.sleep(ctx, nextPageTokenDelay); err != nil {
+			return PlacesSearchResponse{}, err
+		}
+	}
+	return p.fetch(ctx, p.token)
+}
+
+// isInvalidRequest reports whether err is an INVALID_REQUEST status, which a
+// Places search reports for a page token which is not valid yet.
+func isInvalidRequest(err error) bool {
+	var se *StatusError
+	return errors.As(err, &se) && se.Status() == "INVALID_REQUEST"
+}
+
+// All fetches the remaining pages and returns their results.
+func (p *PlacesSearchPager) All(ctx context.Context) ([]PlacesSearchResult, error) {
+	var results []PlacesSearchResult
+	for p.HasNext() {
+		resp, err := p.Next(ctx)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, resp.Results...)
+	}
+	return results, nil
+}
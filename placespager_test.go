@@ -0,0 +1,137 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPagesServer returns a server for a search with pages of 20, 20 and 5
+// results, and a pointer to the page tokens requested.
+func mockPagesServer() (*httptest.Server, *[]string) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("pagetoken")
+		tokens = append(tokens, token)
+		results := strings.TrimSuffix(strings.Repeat(`{"name": "Cafe"},`, 20), ",")
+		next := ""
+		switch token {
+		case "":
+			next = "page2"
+		case "page2":
+			next = "page3"
+		case "page3":
+			results = strings.TrimSuffix(strings.Repeat(`{"name": "Cafe"},`, 5), ",")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintf(w, `{"status": "OK", "results": [%s], "next_page_token": %q}`, results, next)
+	}))
+	return server, &tokens
+}
+
+func TestTextSearchPager(t *testing.T) {
+	server, tokens := mockPagesServer()
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock))
+
+	p := c.TextSearchPager(&TextSearchRequest{Query: "cafe"})
+	assert.Equal(t, MaxPlacesSearchResults, p.MaxRemaining())
+	resp, err := p.Next(context.Background())
+	require.NoError(t, err)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 20, p.TotalFetched)
+	assert.Equal(t, 40, p.MaxRemaining())
+
+	results, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, results, 25)
+	assert.Equal(t, 45, p.TotalFetched)
+	assert.Equal(t, 3, p.Pages)
+	assert.False(t, p.HasNext())
+	assert.Equal(t, 0, p.MaxRemaining())
+	assert.Equal(t, []string{"", "page2", "page3"}, *tokens)
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, clock.Waits())
+
+	_, err = p.Next(context.Background())
+	assert.Error(t, err)
+}
+
+func TestNearbySearchPagerMaxPages(t *testing.T) {
+	server, tokens := mockPagesServer()
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(newFakeClock()))
+
+	p := c.NearbySearchPager(&NearbySearchRequest{Location: &LatLng{Lat: 1, Lng: 2}, Radius: 1000})
+	p.MaxPages = 2
+	assert.Equal(t, 40, p.MaxRemaining())
+	results, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, results, 40)
+	assert.False(t, p.HasNext())
+	assert.Equal(t, []string{"", "page2"}, *tokens)
+}
+
+func TestPagerRetriesPageTokenNotReady(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		switch r.URL.Query().Get("pagetoken") {
+		case "":
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"name": "Cafe"}], "next_page_token": "page2"}`)
+		case "page2":
+			attempts++
+			if attempts < 2 {
+				fmt.Fprintln(w, `{"status": "INVALID_REQUEST"}`)
+				return
+			}
+			fmt.Fprintln(w, `{"status": "OK", "results": [{"name": "Bar"}]}`)
+		}
+	}))
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock))
+
+	results, err := c.TextSearchPager(&TextSearchRequest{Query: "cafe"}).All(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, clock.Waits())
+
+	// Pages are only requested again a few times.
+	attempts = -10
+	p := c.TextSearchPager(&TextSearchRequest{Query: "cafe"})
+	_, err = p.All(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, -10+pageTokenAttempts, attempts)
+	assert.Equal(t, 1, p.Pages)
+}
+
+func TestIsInvalidRequest(t *testing.T) {
+	err := &StatusError{status: "INVALID_REQUEST"}
+	assert.True(t, isInvalidRequest(err))
+	assert.True(t, isInvalidRequest(fmt.Errorf("page 2: %w", err)))
+	assert.False(t, isInvalidRequest(&StatusError{status: "OVER_QUERY_LIMIT"}))
+	assert.False(t, isInvalidRequest(nil))
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package placewatch periodically re-fetches a set of places with the Place
+// Details API, and reports the changes it detects, so that applications can
+// keep stored place data up to date.
+package placewatch // import "googlemaps.github.io/maps/placewatch"
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"googlemaps.github.io/maps"
+)
+
+// Change is a change to a watched place.
+type Change struct {
+	// PlaceID is the place ID being watched.
+	PlaceID string
+	// Changes are the fields which changed.
+	Changes []maps.FieldChange
+	// Place is the current Place Details result.
+	Place maps.PlaceDetailsResult
+}
+
+// Watcher re-fetches places every Interval and calls OnChange when they change.
+// Requests are subject to the rate limit of Client. When the API supplies cache
+// validators, places are re-fetched with conditional requests, which return no
+// result if the place has not changed.
+type Watcher struct {
+	// Client makes the Place Details requests. Required.
+	Client *maps.Client
+	// PlaceIDs are the IDs of the places to watch. Required.
+	PlaceIDs []string
+	// Fields are the fields requested for each place. Optional; all fields are
+	// requested if empty.
+	Fields []maps.PlaceDetailsFieldMask
+	// Interval is the time between fetches of each place. Required.
+	Interval time.Duration
+	// Jitter is the maximum random delay added to each fetch, so that the
+	// places are not all fetched at once. Optional.
+	Jitter time.Duration
+	// Snapshots are the stored results of places by place ID, which their first
+	// fetch is compared with. Places without a snapshot only report changes
+	// after their first fetch. Optional.
+	Snapshots map[string]maps.PlaceDetailsResult
+	// OnChange is called with each detected change. Required.
+	OnChange func(Change)
+	// OnError is called when fetching a place fails. The place is fetched again
+	// after Interval. Optional.
+	OnError func(placeID string, err error)
+}
+
+// Run watches the places until ctx is done, and returns the error of ctx.
+// OnChange and OnError are called from a single goroutine.
+func (w *Watcher) Run(ctx context.Context) error {
+	if w.Client == nil || w.OnChange == nil {
+		return errors.New("placewatch: Client and OnChange are required")
+	}
+	if w.Interval <= 0 {
+		return errors.New("placewatch: Interval must be positive")
+	}
+
+	snapshots := make(map[string]maps.PlaceDetailsResult, len(w.Snapshots))
+	for id, place := range w.Snapshots {
+		snapshots[id] = place
+	}
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	type fetched struct {
+		placeID string
+		place   maps.PlaceDetailsResult
+		err     error
+	}
+	results := make(chan fetched)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// Each place is fetched by its own goroutine, so that its jitter does not
+	// delay the others. Results are handled in this goroutine.
+	for _, id := range w.PlaceIDs {
+		wg.Add(1)
+		go func(id string, random *rand.Rand) {
+			defer wg.Done()
+			delay := w.jitter(random)
+			validators := w.Snapshots[id].CacheValidators
+			for {
+				if !wait(ctx, delay) {
+					return
+				}
+				delay = w.Interval + w.jitter(random)
+				place, err := w.Client.PlaceDetails(ctx, &maps.PlaceDetailsRequest{PlaceID: id, Fields: w.Fields, IfChanged: validators})
+				if err == maps.ErrNotModified {
+					continue
+				}
+				if err == nil {
+					validators = place.CacheValidators
+				}
+				select {
+				case results <- fetched{id, place, err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(id, rand.New(rand.NewSource(random.Int63())))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r := <-results:
+			if r.err != nil {
+				if w.OnError != nil && ctx.Err() == nil {
+					w.OnError(r.placeID, r.err)
+				}
+				continue
+			}
+			old, ok := snapshots[r.placeID]
+			snapshots[r.placeID] = r.place
+			if !ok {
+				continue
+			}
+			if changes := maps.DiffPlaces(old, r.place); len(changes) > 0 {
+				w.OnChange(Change{PlaceID: r.placeID, Changes: changes, Place: r.place})
+			}
+		}
+	}
+}
+
+// jitter returns a random delay of less than w.Jitter.
+func (w *Watcher) jitter(random *rand.Rand) time.Duration {
+	if w.Jitter <= 0 {
+		return 0
+	}
+	return time.Duration(random.Int63n(int64(w.Jitter)))
+}
+
+// wait waits for d, and reports whether ctx is still running.
+func wait(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
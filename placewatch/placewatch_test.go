@@ -0,0 +1,184 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placewatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"googlemaps.github.io/maps"
+)
+
+func TestWatcher(t *testing.T) {
+	// The name of place "a" changes on its third fetch; place "b" fails.
+	var mu sync.Mutex
+	fetches := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Query().Get("placeid")
+		mu.Lock()
+		fetches[id]++
+		n := fetches[id]
+		mu.Unlock()
+		switch {
+		case id == "b":
+			fmt.Fprintln(w, `{"status": "NOT_FOUND"}`)
+		case n < 3:
+			fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "a", "name": "Cafe"}}`)
+		default:
+			fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "a", "name": "Cafe & Bar"}}`)
+		}
+	}))
+	defer server.Close()
+
+	c, err := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var changes []Change
+	errs := map[string]int{}
+	w := &Watcher{
+		Client:   c,
+		PlaceIDs: []string{"a", "b"},
+		Interval: time.Millisecond,
+		Jitter:   time.Millisecond,
+		OnChange: func(change Change) {
+			changes = append(changes, change)
+			cancel()
+		},
+		OnError: func(placeID string, err error) {
+			errs[placeID]++
+		},
+	}
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, was %v", err)
+	}
+
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, was %+v", changes)
+	}
+	want := maps.FieldChange{Field: "name", Old: "Cafe", New: "Cafe & Bar"}
+	if changes[0].PlaceID != "a" || len(changes[0].Changes) != 1 || changes[0].Changes[0] != want {
+		t.Errorf("expected change %+v of a, was %+v", want, changes[0])
+	}
+	if errs["b"] == 0 || errs["a"] != 0 {
+		t.Errorf("expected errors for b only, was %v", errs)
+	}
+}
+
+func TestWatcherSnapshots(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "a", "name": "Cafe", "business_status": "CLOSED_PERMANENTLY"}}`)
+	}))
+	defer server.Close()
+	c, _ := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var change Change
+	w := &Watcher{
+		Client:    c,
+		PlaceIDs:  []string{"a"},
+		Interval:  time.Hour,
+		Snapshots: map[string]maps.PlaceDetailsResult{"a": {PlaceID: "a", Name: "Cafe", BusinessStatus: maps.BusinessStatusOperational}},
+		OnChange: func(c Change) {
+			change = c
+			cancel()
+		},
+	}
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, was %v", err)
+	}
+	if len(change.Changes) != 1 || change.Changes[0].Field != "business_status" {
+		t.Errorf("expected business_status change, was %+v", change)
+	}
+}
+
+func TestWatcherConditionalFetch(t *testing.T) {
+	// Place "a" is unchanged until its fourth fetch.
+	var mu sync.Mutex
+	fetches, conditional := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetches++
+		n := fetches
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional++
+		}
+		mu.Unlock()
+		if n < 4 {
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "a", "name": "Cafe"}}`)
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		fmt.Fprintln(w, `{"status": "OK", "result": {"place_id": "a", "name": "Cafe & Bar"}}`)
+	}))
+	defer server.Close()
+	c, _ := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var changes []Change
+	w := &Watcher{
+		Client:   c,
+		PlaceIDs: []string{"a"},
+		Interval: time.Millisecond,
+		OnChange: func(change Change) {
+			changes = append(changes, change)
+			cancel()
+		},
+		OnError: func(placeID string, err error) {
+			t.Errorf("unexpected error for %s: %v", placeID, err)
+		},
+	}
+	if err := w.Run(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, was %v", err)
+	}
+	if len(changes) != 1 || changes[0].Changes[0].Field != "name" {
+		t.Fatalf("expected name change, was %+v", changes)
+	}
+	if v := changes[0].Place.CacheValidators; v == nil || v.ETag != `"v2"` {
+		t.Errorf("expected ETag \"v2\", was %+v", v)
+	}
+	if conditional != 3 {
+		t.Errorf("expected 3 conditional fetches, was %d", conditional)
+	}
+}
+
+func TestWatcherRequiredFields(t *testing.T) {
+	c, _ := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"))
+	tests := []*Watcher{
+		{PlaceIDs: []string{"a"}, Interval: time.Second, OnChange: func(Change) {}},
+		{Client: c, PlaceIDs: []string{"a"}, Interval: time.Second},
+		{Client: c, PlaceIDs: []string{"a"}, OnChange: func(Change) {}},
+	}
+	for _, w := range tests {
+		if err := w.Run(context.Background()); err == nil {
+			t.Errorf("%+v: expected error", w)
+		}
+	}
+}
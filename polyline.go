@@ -16,6 +16,7 @@ package maps
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 )
 
@@ -33,6 +34,39 @@ func DecodePolyline(poly string) ([]LatLng, error) {
 	return p.Decode()
 }
 
+// DecodePolylineErr converts a polyline encoded string to an array of LatLng
+// objects like DecodePolyline, but returns an error if poly is malformed: if
+// it has characters outside the encoding, is truncated, or decodes to
+// coordinates out of range.
+func DecodePolylineErr(poly string) ([]LatLng, error) {
+	var lat, lng int64
+	path := make([]LatLng, 0, len(poly)/2)
+	for i := 0; i < len(poly); {
+		dlat, n, err := decodeIntAt(poly, i)
+		if err != nil {
+			return nil, err
+		}
+		i += n
+		if i == len(poly) {
+			return nil, fmt.Errorf("maps: malformed polyline at offset %d: missing longitude", i)
+		}
+		dlng, n, err := decodeIntAt(poly, i)
+		if err != nil {
+			return nil, err
+		}
+		lat, lng = lat+dlat, lng+dlng
+		if lat < -90e5 || lat > 90e5 || lng < -180e5 || lng > 180e5 {
+			return nil, fmt.Errorf("maps: malformed polyline at offset %d: coordinates out of range", i)
+		}
+		i += n
+		path = append(path, LatLng{
+			Lat: float64(lat) * 1e-5,
+			Lng: float64(lng) * 1e-5,
+		})
+	}
+	return path, nil
+}
+
 // Decode converts this encoded Polyline to an array of LatLng objects.
 func (p *Polyline) Decode() ([]LatLng, error) {
 	input := bytes.NewBufferString(p.Points)
@@ -103,6 +137,38 @@ func decodeInt(r io.ByteReader) (int64, error) {
 	}
 }
 
+// decodeIntAt decodes the int64 encoded at offset i of poly, returning it and
+// the length of its encoding. Values are at most 32 bits, so 7 characters.
+func decodeIntAt(poly string, i int) (int64, int, error) {
+	result := int64(0)
+	var shift uint8
+	for n := 0; ; n++ {
+		if i+n == len(poly) {
+			return 0, 0, fmt.Errorf("maps: malformed polyline at offset %d: truncated value", i+n)
+		}
+		if n == 7 {
+			return 0, 0, fmt.Errorf("maps: malformed polyline at offset %d: value too long", i)
+		}
+		raw := poly[i+n]
+		if raw < 63 || raw > 126 {
+			return 0, 0, fmt.Errorf("maps: malformed polyline at offset %d: invalid character %q", i+n, raw)
+		}
+
+		b := raw - 63
+		result += int64(b&0x1f) << shift
+		shift += 5
+
+		if b < 0x20 {
+			bit := result & 1
+			result >>= 1
+			if bit != 0 {
+				result = ^result
+			}
+			return result, n + 1, nil
+		}
+	}
+}
+
 // encodeInt writes an encoded int64 to the passed io.ByteWriter.
 func encodeInt(v int64, w io.ByteWriter) {
 	if v < 0 {
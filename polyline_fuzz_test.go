@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+// FuzzDecodePolylineErr checks that any input either fails to decode or
+// decodes as DecodePolyline does to coordinates in range, which encode and
+// decode again to the same path.
+func FuzzDecodePolylineErr(f *testing.F) {
+	for _, poly := range []string{"", routeWith0b, routeSydMel, "_p~iF~ps|U_ulLnnqC_mqNvxq`@", "_p~iF", "??", "~~~~~~~~~~~~~~~"} {
+		f.Add(poly)
+	}
+	f.Fuzz(func(t *testing.T, poly string) {
+		actual, err := DecodePolylineErr(poly)
+		if err != nil {
+			return
+		}
+		for _, ll := range actual {
+			if ll.Lat < -90 || ll.Lat > 90 || ll.Lng < -180 || ll.Lng > 180 {
+				t.Fatalf("DecodePolylineErr(%q) decoded %v out of range", poly, ll)
+			}
+		}
+		if expected, _ := DecodePolyline(poly); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("DecodePolylineErr(%q) was %v, DecodePolyline was %v", poly, actual, expected)
+		}
+		again, err := DecodePolylineErr(Encode(actual))
+		if err != nil {
+			t.Fatalf("DecodePolylineErr of re-encoded %q failed: %v", poly, err)
+		}
+		if len(again) != len(actual) {
+			t.Fatalf("DecodePolylineErr of re-encoded %q was %v, expected %v", poly, again, actual)
+		}
+		for i := range actual {
+			if !again[i].AlmostEqual(&actual[i], 2e-5) {
+				t.Fatalf("DecodePolylineErr of re-encoded %q was %v, expected %v", poly, again, actual)
+			}
+		}
+	})
+}
@@ -15,6 +15,7 @@
 package maps
 
 import (
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -91,3 +92,81 @@ func TestPolylineEncode(t *testing.T) {
 		t.Errorf("expected 2 equal encoding, was len %v, expected len %v", len(routeWith0b), len(encoded2))
 	}
 }
+
+func TestDecodePolylineErr(t *testing.T) {
+	for _, poly := range []string{"", routeSydMel, routeWith0b} {
+		expected, _ := DecodePolyline(poly)
+		actual, err := DecodePolylineErr(poly)
+		if err != nil {
+			t.Errorf("DecodePolylineErr(%q) failed: %v", poly, err)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("DecodePolylineErr(%q) was %v, expected %v", poly, actual, expected)
+		}
+	}
+
+	for poly, reason := range map[string]string{
+		"_p~iF":                          "missing longitude",
+		"_p~iF~ps|":                      "truncated value",
+		"_p~iF~ps|U_ulL":                 "missing longitude",
+		"_p~iF ~ps|U":                    "invalid character",
+		"_p~iF~ps|U\xff":                 "invalid character",
+		"~~~~~~~?~ps|U":                  "value too long",
+		"_gsia@?":                        "coordinates out of range",
+		routeSydMel[:len(routeSydMel)-1]: "truncated",
+	} {
+		if _, err := DecodePolylineErr(poly); err == nil {
+			t.Errorf("DecodePolylineErr(%q) should have failed: %s", poly, reason)
+		}
+	}
+}
+
+// TestDecodePolylineErrRandom decodes random input, which must either fail or
+// decode as DecodePolyline does to coordinates in range, and random paths,
+// which must decode to the path encoded.
+func TestDecodePolylineErrRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		b := make([]byte, r.Intn(32))
+		for j := range b {
+			if r.Intn(8) == 0 {
+				b[j] = byte(r.Intn(256))
+			} else {
+				b[j] = byte(63 + r.Intn(64))
+			}
+		}
+		poly := string(b)
+		actual, err := DecodePolylineErr(poly)
+		if err != nil {
+			continue
+		}
+		for _, ll := range actual {
+			if ll.Lat < -90 || ll.Lat > 90 || ll.Lng < -180 || ll.Lng > 180 {
+				t.Fatalf("DecodePolylineErr(%q) decoded %v out of range", poly, ll)
+			}
+		}
+		if expected, _ := DecodePolyline(poly); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("DecodePolylineErr(%q) was %v, DecodePolyline was %v", poly, actual, expected)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		path := make([]LatLng, 1+r.Intn(16))
+		for j := range path {
+			path[j] = LatLng{Lat: float64(r.Intn(180e5)-90e5) * 1e-5, Lng: float64(r.Intn(360e5)-180e5) * 1e-5}
+		}
+		poly := Encode(path)
+		actual, err := DecodePolylineErr(poly)
+		if err != nil {
+			t.Fatalf("DecodePolylineErr(%q) of %v failed: %v", poly, path, err)
+		}
+		if len(actual) != len(path) {
+			t.Fatalf("DecodePolylineErr(%q) was %v, expected %v", poly, actual, path)
+		}
+		for j := range path {
+			if !actual[j].AlmostEqual(&path[j], 2e-5) {
+				t.Fatalf("DecodePolylineErr(%q) was %v, expected %v", poly, actual, path)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Prediction is an autocomplete prediction from either Place Autocomplete or
+// Query Autocomplete, normalized so that both can be displayed the same way.
+type Prediction struct {
+	// Text is the full text of the prediction.
+	Text string `json:"text"`
+	// Matches are the locations of the entered term in Text.
+	Matches []AutocompleteMatchedSubstring `json:"matches,omitempty"`
+	// MainText is the main text of the prediction, such as the name of a place.
+	MainText string `json:"main_text"`
+	// MainTextMatches are the locations of the entered term in MainText.
+	MainTextMatches []AutocompleteMatchedSubstring `json:"main_text_matches,omitempty"`
+	// SecondaryText is the secondary text of the prediction, such as its
+	// locality.
+	SecondaryText string `json:"secondary_text,omitempty"`
+	// PlaceID is the ID of the predicted place. It is empty for query
+	// predictions.
+	PlaceID string `json:"place_id,omitempty"`
+	// Types are the types of the predicted place.
+	Types []string `json:"types,omitempty"`
+	// DistanceMeters is the straight-line distance from the request Origin.
+	DistanceMeters int `json:"distance_meters,omitempty"`
+	// IsQuery reports whether this is a query prediction, which completes a
+	// search term rather than identifying a place.
+	IsQuery bool `json:"is_query"`
+}
+
+// Prediction returns p normalized as a Prediction. When p has no structured
+// formatting, as is common for query predictions, the main and secondary text
+// are taken from its terms.
+func (p *AutocompletePrediction) Prediction() Prediction {
+	pred := Prediction{
+		Text:            p.Description,
+		Matches:         p.MatchedSubstrings,
+		MainText:        p.StructuredFormatting.MainText,
+		MainTextMatches: p.StructuredFormatting.MainTextMatchedSubstrings,
+		SecondaryText:   p.StructuredFormatting.SecondaryText,
+		PlaceID:         p.PlaceID,
+		Types:           p.Types,
+		DistanceMeters:  p.DistanceMeters,
+		IsQuery:         p.PlaceID == "",
+	}
+	if pred.MainText != "" {
+		return pred
+	}
+
+	if len(p.Terms) == 0 {
+		pred.MainText = p.Description
+		pred.MainTextMatches = p.MatchedSubstrings
+		return pred
+	}
+	pred.MainText = p.Terms[0].Value
+	var secondary []string
+	for _, term := range p.Terms[1:] {
+		secondary = append(secondary, term.Value)
+	}
+	pred.SecondaryText = strings.Join(secondary, ", ")
+
+	// Matches within the first term are also matches in the main text.
+	start, end := p.Terms[0].Offset, p.Terms[0].Offset+utf8.RuneCountInString(pred.MainText)
+	for _, m := range p.MatchedSubstrings {
+		if m.Offset >= start && m.Offset+m.Length <= end {
+			pred.MainTextMatches = append(pred.MainTextMatches, AutocompleteMatchedSubstring{Length: m.Length, Offset: m.Offset - start})
+		}
+	}
+	return pred
+}
+
+// NormalizedPredictions returns the predictions of r normalized as Predictions.
+func (r *AutocompleteResponse) NormalizedPredictions() []Prediction {
+	predictions := make([]Prediction, len(r.Predictions))
+	for i := range r.Predictions {
+		predictions[i] = r.Predictions[i].Prediction()
+	}
+	return predictions
+}
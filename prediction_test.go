@@ -0,0 +1,86 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutocompleteNormalizedPredictions(t *testing.T) {
+	response := `{
+		"predictions": [
+			{
+				"description": "pizza near Paris, France",
+				"matched_substrings": [{"length": 5, "offset": 0}, {"length": 5, "offset": 11}],
+				"terms": [
+					{"offset": 0, "value": "pizza"},
+					{"offset": 6, "value": "near"},
+					{"offset": 11, "value": "Paris"},
+					{"offset": 18, "value": "France"}
+				]
+			},
+			{
+				"description": "Pizza Hut, Rue de Rivoli, Paris, France",
+				"matched_substrings": [{"length": 5, "offset": 0}],
+				"place_id": "ChIJ0123",
+				"types": ["restaurant", "establishment"],
+				"structured_formatting": {
+					"main_text": "Pizza Hut",
+					"main_text_matched_substrings": [{"length": 5, "offset": 0}],
+					"secondary_text": "Rue de Rivoli, Paris, France"
+				}
+			},
+			{
+				"description": "pizza",
+				"matched_substrings": [{"length": 5, "offset": 0}]
+			}
+		]
+	}`
+	var resp AutocompleteResponse
+	if err := json.Unmarshal([]byte(response), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	predictions := resp.NormalizedPredictions()
+	assert.Equal(t, []Prediction{
+		{
+			Text:            "pizza near Paris, France",
+			Matches:         []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}, {Length: 5, Offset: 11}},
+			MainText:        "pizza",
+			MainTextMatches: []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}},
+			SecondaryText:   "near, Paris, France",
+			IsQuery:         true,
+		},
+		{
+			Text:            "Pizza Hut, Rue de Rivoli, Paris, France",
+			Matches:         []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}},
+			MainText:        "Pizza Hut",
+			MainTextMatches: []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}},
+			SecondaryText:   "Rue de Rivoli, Paris, France",
+			PlaceID:         "ChIJ0123",
+			Types:           []string{"restaurant", "establishment"},
+		},
+		{
+			Text:            "pizza",
+			Matches:         []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}},
+			MainText:        "pizza",
+			MainTextMatches: []AutocompleteMatchedSubstring{{Length: 5, Offset: 0}},
+			IsQuery:         true,
+		},
+	}, predictions)
+}
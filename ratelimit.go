@@ -0,0 +1,226 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is the quota state reported by the RateLimit headers of a
+// response.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is the time until the current window ends.
+	Reset time.Duration
+}
+
+// parseRateLimit returns the RateLimit of the separate RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers of h, or of a combined
+// RateLimit header such as "limit=100, remaining=0, reset=30". It returns nil
+// if h has none.
+func parseRateLimit(h http.Header) *RateLimit {
+	fields := map[string]string{
+		"limit":     h.Get("RateLimit-Limit"),
+		"remaining": h.Get("RateLimit-Remaining"),
+		"reset":     h.Get("RateLimit-Reset"),
+	}
+	for _, item := range strings.Split(h.Get("RateLimit"), ",") {
+		if i := strings.Index(item, "="); i >= 0 {
+			fields[strings.TrimSpace(item[:i])] = strings.TrimSpace(item[i+1:])
+		}
+	}
+
+	var r RateLimit
+	found := false
+	if n, err := strconv.Atoi(fields["limit"]); err == nil {
+		r.Limit, found = n, true
+	}
+	if n, err := strconv.Atoi(fields["remaining"]); err == nil {
+		r.Remaining, found = n, true
+	}
+	if n, err := strconv.Atoi(fields["reset"]); err == nil {
+		r.Reset, found = time.Duration(n)*time.Second, true
+	}
+	if !found {
+		return nil
+	}
+	return &r
+}
+
+// parseRetryAfter returns the delay of the Retry-After header of h, which is
+// either a number of seconds or an HTTP date, or 0 if h has none.
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if n, err := strconv.Atoi(v); err == nil && n > 0 {
+		return time.Duration(n) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// quota is the throttling state reported by the Retry-After and RateLimit
+// headers of a response.
+type quota struct {
+	retryAfter time.Duration
+	rateLimit  *RateLimit
+}
+
+// parseQuota returns the quota reported by the headers h of a response
+// received at now. A response with no requests remaining in its window is
+// retried after the window resets if there is no Retry-After header.
+func parseQuota(h http.Header, now time.Time) quota {
+	q := quota{rateLimit: parseRateLimit(h), retryAfter: parseRetryAfter(h, now)}
+	if q.retryAfter == 0 && q.rateLimit != nil && q.rateLimit.Remaining == 0 {
+		q.retryAfter = q.rateLimit.Reset
+	}
+	return q
+}
+
+// quotaResponse is implemented by responses that record the quota reported by
+// their headers.
+type quotaResponse interface {
+	setQuota(quota)
+}
+
+func (c *commonResponse) setQuota(q quota) {
+	c.quota = q
+}
+
+func (r *googleAPIResponse) setQuota(q quota) {
+	r.quota = q
+}
+
+// RetryAfter returns how long the API asked for requests to wait before being
+// retried, or 0 if it did not say.
+func (e *StatusError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// RateLimit returns the quota state reported by the API, or nil if it did not
+// report one.
+func (e *StatusError) RateLimit() *RateLimit {
+	return e.rateLimit
+}
+
+// statusError returns the StatusError of resp, or nil.
+func statusError(resp interface{}) *StatusError {
+	r, ok := resp.(interface{ StatusError() error })
+	if !ok {
+		return nil
+	}
+	var statusErr *StatusError
+	if errors.As(r.StatusError(), &statusErr) {
+		return statusErr
+	}
+	return nil
+}
+
+// retryAfter returns the RetryAfter of the StatusError of resp, if any.
+func retryAfter(resp interface{}) time.Duration {
+	if statusErr := statusError(resp); statusErr != nil {
+		return statusErr.retryAfter
+	}
+	return 0
+}
+
+// QuotaBackoff configures how the client holds back all its requests when an
+// API reports that the project is over its quota.
+type QuotaBackoff struct {
+	// Disabled stops requests being held back. Retried requests still wait
+	// as long as the API asks.
+	Disabled bool
+	// Pause is how long requests are held back after a response which is over
+	// the quota, such as OVER_QUERY_LIMIT or HTTP 429, but does not say how
+	// long to wait with a Retry-After or RateLimit header. Default is not to
+	// hold back requests after such responses.
+	Pause time.Duration
+	// MaxPause limits how long requests are held back. Default is no limit.
+	MaxPause time.Duration
+}
+
+// WithQuotaBackoff configures how the client holds back requests when an API
+// reports that the project is over its quota. By default, requests wait as
+// long as the API asks with a Retry-After or RateLimit header.
+func WithQuotaBackoff(backoff QuotaBackoff) ClientOption {
+	return func(c *Client) error {
+		if backoff.Pause < 0 || backoff.MaxPause < 0 {
+			return errors.New("maps: negative value in QuotaBackoff")
+		}
+		c.quotaBackoff = backoff
+		return nil
+	}
+}
+
+// backoffQuota holds back requests made by the client as configured by its
+// QuotaBackoff, after a response with the HTTP status code decoded into resp.
+func (c *Client) backoffQuota(code int, resp interface{}) {
+	if statusErr := statusError(resp); statusErr != nil {
+		c.backoffStatus(code, statusErr.retryAfter, statusErr.overQueryLimit())
+		return
+	}
+	c.backoffStatus(code, 0, false)
+}
+
+// backoffStatus holds back requests made by the client as configured by its
+// QuotaBackoff, after a response with the HTTP status code which asked to be
+// retried after retryAfter, and whether it reported being over the quota.
+func (c *Client) backoffStatus(code int, retryAfter time.Duration, overQueryLimit bool) {
+	b := c.quotaBackoff
+	if b.Disabled {
+		return
+	}
+	d := retryAfter
+	if d == 0 && (code == http.StatusTooManyRequests || overQueryLimit) {
+		d = b.Pause
+	}
+	if b.MaxPause > 0 && d > b.MaxPause {
+		d = b.MaxPause
+	}
+	c.throttle(d)
+}
+
+// throttle holds back requests made by the client for d.
+func (c *Client) throttle(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	until := c.clock.Now().Add(d)
+	c.throttleMu.Lock()
+	defer c.throttleMu.Unlock()
+	if until.After(c.throttledUntil) {
+		c.throttledUntil = until
+	}
+}
+
+// awaitThrottle waits until the client is no longer throttled.
+func (c *Client) awaitThrottle(ctx context.Context) error {
+	c.throttleMu.Lock()
+	until := c.throttledUntil
+	c.throttleMu.Unlock()
+	return c.sleep(ctx, until.Sub(c.clock.Now()))
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	h := http.Header{}
+	assert.Nil(t, parseRateLimit(h))
+
+	h.Set("RateLimit-Limit", "100")
+	h.Set("RateLimit-Remaining", "0")
+	h.Set("RateLimit-Reset", "30")
+	assert.Equal(t, &RateLimit{Limit: 100, Remaining: 0, Reset: 30 * time.Second}, parseRateLimit(h))
+
+	h = http.Header{}
+	h.Set("RateLimit", "limit=10, remaining=4, reset=2")
+	assert.Equal(t, &RateLimit{Limit: 10, Remaining: 4, Reset: 2 * time.Second}, parseRateLimit(h))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := http.Header{}
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h, now))
+	h.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, parseRetryAfter(h, now))
+	h.Set("Retry-After", now.Add(time.Minute).Format(http.TimeFormat))
+	assert.Equal(t, time.Minute, parseRetryAfter(h, now))
+	h.Set("Retry-After", now.Add(-time.Minute).Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h, now))
+}
+
+func mockRateLimitedServer(header http.Header) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		if requests == 1 {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, `{"error": {"code": 429, "message": "Quota exceeded", "status": "RESOURCE_EXHAUSTED"}}`)
+			return
+		}
+		fmt.Fprintln(w, `{"result": {}, "responseId": "id"}`)
+	}))
+	return server, &requests
+}
+
+func TestRetryAfterError(t *testing.T) {
+	server, requests := mockRateLimitedServer(http.Header{
+		"Ratelimit-Limit":     {"100"},
+		"Ratelimit-Remaining": {"0"},
+		"Ratelimit-Reset":     {"30"},
+	})
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock), WithEndpointTimeout(EndpointAddressValidation, time.Minute))
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1600 Amphitheatre Pkwy"}}}
+
+	_, err := c.ValidateAddress(context.Background(), r)
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr), "unexpected error %v", err)
+	assert.Equal(t, "RESOURCE_EXHAUSTED", statusErr.Status())
+	assert.Equal(t, 30*time.Second, statusErr.RetryAfter())
+	assert.Equal(t, &RateLimit{Limit: 100, Remaining: 0, Reset: 30 * time.Second}, statusErr.RateLimit())
+
+	// Later requests wait until the window resets.
+	_, err = c.ValidateAddress(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *requests)
+	assert.Equal(t, []time.Duration{30 * time.Second}, clock.Waits())
+}
+
+func TestRetryAfterRetry(t *testing.T) {
+	server, requests := mockRateLimitedServer(http.Header{"Retry-After": {"5"}})
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock), WithRetry(RetryPolicy{}))
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1600 Amphitheatre Pkwy"}}}
+
+	resp, err := c.ValidateAddress(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, "id", resp.ResponseID)
+	assert.Equal(t, 2, *requests)
+	assert.Equal(t, []time.Duration{5 * time.Second}, clock.Waits())
+}
+
+func TestRetryAfterDateUsesClock(t *testing.T) {
+	clock := newFakeClock()
+	server, _ := mockRateLimitedServer(http.Header{"Retry-After": {clock.Now().Add(20 * time.Second).Format(http.TimeFormat)}})
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock))
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1600 Amphitheatre Pkwy"}}}
+
+	_, err := c.ValidateAddress(context.Background(), r)
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr), "unexpected error %v", err)
+	assert.Equal(t, 20*time.Second, statusErr.RetryAfter())
+}
+
+func TestRetryAfterLegacyAPI(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "12")
+			fmt.Fprintln(w, `{"status": "OVER_QUERY_LIMIT", "results": []}`)
+			return
+		}
+		fmt.Fprintln(w, `{"status": "OK", "results": []}`)
+	}))
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock), WithEndpointTimeout(EndpointGeocoding, time.Minute))
+	r := &GeocodingRequest{Address: "Sydney"}
+
+	_, err := c.Geocode(context.Background(), r)
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr), "unexpected error %v", err)
+	assert.Equal(t, 12*time.Second, statusErr.RetryAfter())
+
+	_, err = c.Geocode(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{12 * time.Second}, clock.Waits())
+}
+
+func TestRetryAfterImageAPI(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, "Too many requests")
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock))
+	r := &StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "100x100"}
+
+	_, err := c.StaticMap(context.Background(), r)
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr), "unexpected error %v", err)
+	assert.Equal(t, "OVER_QUERY_LIMIT", statusErr.Status())
+	assert.Equal(t, 3*time.Second, statusErr.RetryAfter())
+
+	c.StaticMap(context.Background(), r)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []time.Duration{3 * time.Second}, clock.Waits())
+}
+
+func TestQuotaBackoff(t *testing.T) {
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1600 Amphitheatre Pkwy"}}}
+	for _, tc := range []struct {
+		name    string
+		header  http.Header
+		backoff QuotaBackoff
+		waits   []time.Duration
+	}{
+		{"disabled", http.Header{"Retry-After": {"5"}}, QuotaBackoff{Disabled: true}, nil},
+		{"retry after", http.Header{"Retry-After": {"5"}}, QuotaBackoff{Pause: time.Second}, []time.Duration{5 * time.Second}},
+		{"max pause", http.Header{"Retry-After": {"5"}}, QuotaBackoff{MaxPause: 2 * time.Second}, []time.Duration{2 * time.Second}},
+		{"pause", http.Header{}, QuotaBackoff{Pause: time.Second}, []time.Duration{time.Second}},
+		{"no pause", http.Header{}, QuotaBackoff{}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server, requests := mockRateLimitedServer(tc.header)
+			defer server.Close()
+			clock := newFakeClock()
+			c, err := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock), WithQuotaBackoff(tc.backoff))
+			require.NoError(t, err)
+
+			_, err = c.ValidateAddress(context.Background(), r)
+			assert.True(t, IsQuotaError(err), "unexpected error %v", err)
+			_, err = c.ValidateAddress(context.Background(), r)
+			require.NoError(t, err)
+			assert.Equal(t, 2, *requests)
+			assert.Equal(t, tc.waits, clock.Waits())
+		})
+	}
+
+	_, err := NewClient(WithAPIKey(apiKey), WithQuotaBackoff(QuotaBackoff{Pause: -time.Second}))
+	assert.Error(t, err)
+}
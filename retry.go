@@ -0,0 +1,150 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// RetryPolicy configures the automatic retry of requests that fail with
+// transient errors. The zero value retries up to 3 attempts in total on the
+// default statuses and HTTP status codes.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	// Default is 3.
+	MaxAttempts int
+	// MaxElapsedTime is the maximum time from the first attempt after which no
+	// further attempts are made. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// BaseDelay is the delay before the first retry, doubled for each further
+	// retry, with full jitter. Default is 100ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts. Default is 10s.
+	MaxDelay time.Duration
+	// Statuses are the API statuses to retry, such as OVER_QUERY_LIMIT. A status
+	// mapped to false is not retried. Default is DefaultRetryStatuses.
+	Statuses map[string]bool
+	// HTTPStatusCodes are the HTTP status codes to retry. A code mapped to false
+	// is not retried. Default is all 5xx codes and 429.
+	HTTPStatusCodes map[int]bool
+}
+
+// DefaultRetryStatuses are the API statuses retried by a RetryPolicy without
+// Statuses.
+var DefaultRetryStatuses = map[string]bool{
+	"OVER_QUERY_LIMIT":   true,
+	"RESOURCE_EXHAUSTED": true,
+	"UNKNOWN_ERROR":      true,
+}
+
+// WithRetry configures a Maps API client to retry requests that fail with
+// transient errors according to policy: API statuses and HTTP status codes
+// selected by the policy, and network timeouts. Retries wait with exponential
+// backoff and jitter on the client's Clock, or as long as the API asks with a
+// Retry-After or RateLimit header if that is longer, and stop when the
+// request's context is done. Default is not to retry.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 0 || policy.MaxElapsedTime < 0 || policy.BaseDelay < 0 || policy.MaxDelay < 0 {
+			return errors.New("maps: negative value in RetryPolicy")
+		}
+		if policy.MaxAttempts == 0 {
+			policy.MaxAttempts = 3
+		}
+		if policy.BaseDelay == 0 {
+			policy.BaseDelay = 100 * time.Millisecond
+		}
+		if policy.MaxDelay == 0 {
+			policy.MaxDelay = 10 * time.Second
+		}
+		if policy.Statuses == nil {
+			policy.Statuses = DefaultRetryStatuses
+		}
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// retryable reports whether an attempt that returned the HTTP status code and
+// err, decoding resp, should be retried.
+func (p *RetryPolicy) retryable(code int, err error, resp interface{}) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if retry, ok := p.HTTPStatusCodes[code]; ok {
+		return retry
+	}
+	if code >= 500 || code == http.StatusTooManyRequests {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	if r, ok := resp.(interface{ StatusError() error }); ok {
+		var statusErr *StatusError
+		if errors.As(r.StatusError(), &statusErr) {
+			return p.Statuses[statusErr.status]
+		}
+	}
+	return false
+}
+
+// withRetry calls attempt until it succeeds or should not be retried according
+// to the client's retry policy. attempt decodes into resp, which is reset
+// before each retry, and returns the HTTP status code of the response, or 0.
+func (c *Client) withRetry(ctx context.Context, resp interface{}, attempt func() (int, error)) error {
+	p := c.retryPolicy
+	if p == nil {
+		code, err := attempt()
+		c.backoffQuota(code, resp)
+		return err
+	}
+
+	start := c.clock.Now()
+	code, err := attempt()
+	c.backoffQuota(code, resp)
+	var r *rand.Rand
+	for n := 1; n < p.MaxAttempts && p.retryable(code, err, resp); n++ {
+		if ctx.Err() != nil {
+			break
+		}
+		if r == nil {
+			r = rand.New(rand.NewSource(start.UnixNano()))
+		}
+		delay := backoff(p.BaseDelay, p.MaxDelay, n-1, r)
+		if d := retryAfter(resp); d > delay {
+			delay = d
+		}
+		if p.MaxElapsedTime > 0 && c.clock.Now().Add(delay).Sub(start) > p.MaxElapsedTime {
+			break
+		}
+		if sleepErr := c.sleep(ctx, delay); sleepErr != nil {
+			break
+		}
+		if v := reflect.ValueOf(resp); v.Kind() == reflect.Ptr && !v.IsNil() {
+			v.Elem().Set(reflect.Zero(v.Elem().Type()))
+		}
+		code, err = attempt()
+		c.backoffQuota(code, resp)
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type retryResponse struct {
+	code int
+	body string
+}
+
+// mockRetryServer returns a server that responds with each of responses in
+// turn, repeating the last, and a pointer to the number of requests made.
+func mockRetryServer(responses ...retryResponse) (*httptest.Server, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := responses[len(responses)-1]
+		if requests < len(responses) {
+			resp = responses[requests]
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(resp.code)
+		fmt.Fprintln(w, resp.body)
+	}))
+	return server, &requests
+}
+
+func TestClientWithRetry(t *testing.T) {
+	overQueryLimit := retryResponse{200, `{"results": [], "status": "OVER_QUERY_LIMIT", "error_message": "slow down"}`}
+	serverError := retryResponse{503, `Service Unavailable`}
+	ok := retryResponse{200, `{"results": [{"elevation": 1608.6}], "status": "OK"}`}
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	server, requests := mockRetryServer(overQueryLimit, serverError, ok)
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock), WithRetry(RetryPolicy{}))
+	resp, err := c.Elevation(context.Background(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *requests)
+	assert.Len(t, resp, 1)
+	waits := clock.Waits()
+	assert.Len(t, waits, 2)
+	assert.True(t, waits[0] <= 100*time.Millisecond && waits[1] <= 200*time.Millisecond, "unexpected waits %v", waits)
+
+	// The last response is returned once MaxAttempts is reached.
+	server, requests = mockRetryServer(overQueryLimit)
+	defer server.Close()
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(newFakeClock()), WithRetry(RetryPolicy{MaxAttempts: 2}))
+	_, err = c.Elevation(context.Background(), r)
+	assert.Equal(t, &StatusError{status: "OVER_QUERY_LIMIT", message: "slow down", httpStatus: 200}, err)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestClientWithRetryPolicy(t *testing.T) {
+	invalid := retryResponse{200, `{"results": [], "status": "INVALID_REQUEST"}`}
+	unknown := retryResponse{200, `{"results": [], "status": "UNKNOWN_ERROR"}`}
+	serverError := retryResponse{500, `{"results": [], "status": "UNKNOWN_ERROR"}`}
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		response retryResponse
+		requests int
+	}{
+		{"not retryable", RetryPolicy{}, invalid, 1},
+		{"status enabled", RetryPolicy{Statuses: map[string]bool{"INVALID_REQUEST": true}}, invalid, 3},
+		{"status disabled", RetryPolicy{Statuses: map[string]bool{"UNKNOWN_ERROR": false}}, unknown, 1},
+		{"HTTP code disabled", RetryPolicy{Statuses: map[string]bool{}, HTTPStatusCodes: map[int]bool{500: false}}, serverError, 1},
+		{"max elapsed time", RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: time.Second, MaxElapsedTime: time.Nanosecond}, unknown, 1},
+	}
+	for _, test := range tests {
+		server, requests := mockRetryServer(test.response)
+		c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(newFakeClock()), WithRetry(test.policy))
+		_, err := c.Elevation(context.Background(), r)
+		assert.Error(t, err, test.name)
+		assert.Equal(t, test.requests, *requests, test.name)
+		server.Close()
+	}
+
+	_, err := NewClient(WithAPIKey(apiKey), WithRetry(RetryPolicy{MaxAttempts: -1}))
+	assert.Error(t, err)
+}
+
+func TestClientWithRetryCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel()
+		fmt.Fprintln(w, `{"results": [], "status": "UNKNOWN_ERROR"}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(newFakeClock()), WithRetry(RetryPolicy{}))
+
+	_, err := c.Elevation(ctx, &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}})
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
@@ -20,6 +20,7 @@ package maps
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"strings"
 )
@@ -47,7 +48,9 @@ var speedLimitsAPI = &apiConfig{
 
 // SnapToRoad makes a Snap to Road API request
 func (c *Client) SnapToRoad(ctx context.Context, r *SnapToRoadRequest) (*SnapToRoadResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if len(r.Path) == 0 {
 		return nil, errors.New("maps: Path empty")
 	}
@@ -106,7 +109,9 @@ type SnappedPoint struct {
 
 // NearestRoads makes a Nearest Roads API request
 func (c *Client) NearestRoads(ctx context.Context, r *NearestRoadsRequest) (*NearestRoadsResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if len(r.Points) == 0 {
 		return nil, errors.New("maps: Points empty")
 	}
@@ -145,11 +150,17 @@ type NearestRoadsResponse struct {
 
 // SpeedLimits makes a Speed Limits API request
 func (c *Client) SpeedLimits(ctx context.Context, r *SpeedLimitsRequest) (*SpeedLimitsResponse, error) {
-
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if len(r.Path) == 0 && len(r.PlaceID) == 0 {
 		return nil, errors.New("maps: Path and PlaceID both empty")
 	}
 
+	if r.Units != "" && r.Units != SpeedLimitMPH && r.Units != SpeedLimitKPH {
+		return nil, fmt.Errorf("maps: Units %q invalid, use SpeedLimitKPH or SpeedLimitMPH", r.Units)
+	}
+
 	response := &SpeedLimitsResponse{}
 
 	if err := c.getJSON(ctx, speedLimitsAPI, r, response); err != nil {
@@ -180,15 +191,28 @@ func (r *SpeedLimitsRequest) params() url.Values {
 	return q
 }
 
-type speedLimitUnit string
+// SpeedLimitUnit is the unit of speed limits.
+type SpeedLimitUnit string
 
 const (
 	// SpeedLimitMPH is for requesting speed limits in Miles Per Hour.
-	SpeedLimitMPH = "MPH"
+	SpeedLimitMPH = SpeedLimitUnit("MPH")
 	// SpeedLimitKPH is for requesting speed limits in Kilometers Per Hour.
-	SpeedLimitKPH = "KPH"
+	SpeedLimitKPH = SpeedLimitUnit("KPH")
 )
 
+// ParseSpeedLimitUnit will parse a string representation of a SpeedLimitUnit,
+// ignoring case.
+func ParseSpeedLimitUnit(unit string) (SpeedLimitUnit, error) {
+	switch strings.ToUpper(unit) {
+	case "MPH":
+		return SpeedLimitMPH, nil
+	case "KPH":
+		return SpeedLimitKPH, nil
+	}
+	return SpeedLimitUnit(""), fmt.Errorf("maps: unknown SpeedLimitUnit %q, want KPH or MPH", unit)
+}
+
 // SpeedLimitsRequest is the request structure for the Roads Speed Limits API.
 type SpeedLimitsRequest struct {
 	// Path is the path to be snapped and speed limits requested.
@@ -199,7 +223,7 @@ type SpeedLimitsRequest struct {
 
 	// Units is whether to return speed limits in `SpeedLimitKPH` or `SpeedLimitMPH`.
 	// Optional, default behavior is to return results in KPH.
-	Units speedLimitUnit
+	Units SpeedLimitUnit
 }
 
 // SpeedLimitsResponse is an array of snapped points and an array of speed limits.
@@ -215,5 +239,5 @@ type SpeedLimit struct {
 	// SpeedLimit is the speed limit for that road segment.
 	SpeedLimit float64 `json:"speedLimit"`
 	// Units is either KPH or MPH.
-	Units speedLimitUnit `json:"units"`
+	Units SpeedLimitUnit `json:"units"`
 }
@@ -723,3 +723,27 @@ func TestSpeedLimitsRequestURL(t *testing.T) {
 		t.Errorf("Got URL(s) %v, want %s", server.failed, expectedQuery)
 	}
 }
+
+func TestSpeedLimitsInvalidUnits(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &SpeedLimitsRequest{
+		PlaceID: []string{"ChIJ1Wi6I2pNFmsRQL9GbW7qABM"},
+		Units:   SpeedLimitUnit("mph"),
+	}
+
+	_, err := c.SpeedLimits(context.Background(), r)
+	if err == nil || err.Error() != `maps: Units "mph" invalid, use SpeedLimitKPH or SpeedLimitMPH` {
+		t.Errorf("Unexpected error for invalid Units: %v", err)
+	}
+}
+
+func TestParseSpeedLimitUnit(t *testing.T) {
+	for s, want := range map[string]SpeedLimitUnit{"MPH": SpeedLimitMPH, "kph": SpeedLimitKPH} {
+		if got, err := ParseSpeedLimitUnit(s); err != nil || got != want {
+			t.Errorf("ParseSpeedLimitUnit(%q) = %q, %v, want %q", s, got, err, want)
+		}
+	}
+	if _, err := ParseSpeedLimitUnit("knots"); err == nil {
+		t.Errorf("Expected an error parsing knots")
+	}
+}
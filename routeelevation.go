@@ -0,0 +1,66 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+)
+
+// RouteElevationProfile is a Route annotated with the elevation profile along
+// its overview polyline.
+type RouteElevationProfile struct {
+	Route
+	// Elevations are the elevations sampled at equal distances along the route.
+	Elevations []ElevationResult
+	// Ascent is the total elevation gained along the route, in meters.
+	Ascent float64
+	// Descent is the total elevation lost along the route, in meters.
+	Descent float64
+}
+
+// RouteWithElevationProfile makes a Directions API request, then an Elevation
+// API request for each returned route sampling its overview polyline at samples
+// points. Set Alternatives on r to rank several routes, for example when
+// bicycling or walking, by their ascent and descent.
+func (c *Client) RouteWithElevationProfile(ctx context.Context, r *DirectionsRequest, samples int) ([]RouteElevationProfile, error) {
+	if samples < 2 {
+		return nil, errors.New("maps: samples must be at least 2")
+	}
+
+	routes, _, err := c.Directions(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]RouteElevationProfile, len(routes))
+	for i, route := range routes {
+		path, err := DecodePolylineErr(route.OverviewPolyline.Points)
+		if err != nil {
+			return nil, err
+		}
+		profiles[i].Route = route
+		if len(path) == 0 {
+			continue
+		}
+		elevations, err := c.Elevation(ctx, &ElevationRequest{Path: path, Samples: samples})
+		if err != nil {
+			return nil, err
+		}
+		profiles[i].Elevations = elevations
+		profiles[i].Ascent, profiles[i].Descent = ElevationAscentDescent(elevations)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouteWithElevationProfile(t *testing.T) {
+	directions := `{
+		"status": "OK",
+		"routes": [
+			{"summary": "Hilly", "overview_polyline": {"points": "_p~iF~ps|U_ulLnnqC"}},
+			{"summary": "Flat", "overview_polyline": {"points": "_ulLnnqC_mqNvxq@"}}
+		]
+	}`
+	elevations := map[string]string{
+		"enc:_p~iF~ps|U_ulLnnqC": `{"status": "OK", "results": [{"elevation": 10}, {"elevation": 40}, {"elevation": 25}, {"elevation": 30}]}`,
+		"enc:_ulLnnqC_mqNvxq@":   `{"status": "OK", "results": [{"elevation": 10}, {"elevation": 10}, {"elevation": 12}, {"elevation": 11}]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case directionsAPI.path:
+			if r.URL.Query().Get("alternatives") != "true" {
+				t.Errorf("expected alternatives, was %q", r.URL.RawQuery)
+			}
+			fmt.Fprintln(w, directions)
+		case elevationAPI.path:
+			if samples := r.URL.Query().Get("samples"); samples != "4" {
+				t.Errorf("expected 4 samples, was %s", samples)
+			}
+			fmt.Fprintln(w, elevations[r.URL.Query().Get("path")])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &DirectionsRequest{
+		Origin:       "Sydney",
+		Destination:  "Parramatta",
+		Mode:         TravelModeBicycling,
+		Alternatives: true,
+	}
+
+	profiles, err := c.RouteWithElevationProfile(context.Background(), r, 4)
+	if err != nil {
+		t.Fatalf("RouteWithElevationProfile returned error: %v", err)
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("expected 2 profiles, was %d", len(profiles))
+	}
+
+	tests := []struct {
+		summary         string
+		ascent, descent float64
+	}{
+		{"Hilly", 35, 15},
+		{"Flat", 2, 1},
+	}
+	for i, want := range tests {
+		p := profiles[i]
+		if p.Summary != want.summary || p.Ascent != want.ascent || p.Descent != want.descent || len(p.Elevations) != 4 {
+			t.Errorf("expected %s ascent %v descent %v, was %s ascent %v descent %v", want.summary, want.ascent, want.descent, p.Summary, p.Ascent, p.Descent)
+		}
+	}
+}
+
+func TestRouteWithElevationProfileMissingSamples(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	r := &DirectionsRequest{Origin: "Sydney", Destination: "Parramatta"}
+
+	if _, err := c.RouteWithElevationProfile(context.Background(), r, 1); err == nil {
+		t.Errorf("expected error for a single sample")
+	}
+}
@@ -0,0 +1,562 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// More information about Google Routes API is available on
+// https://developers.google.com/maps/documentation/routes
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var computeRoutesAPI = &apiConfig{
+	host:             "https://routes.googleapis.com",
+	path:             "/directions/v2:computeRoutes",
+	acceptsClientID:  false,
+	acceptsSignature: false,
+}
+
+// ComputeRoutes makes a Routes API Compute Routes request. It is the
+// replacement for the Directions API.
+func (c *Client) ComputeRoutes(ctx context.Context, r *ComputeRoutesRequest) (*ComputeRoutesResponse, error) {
+	if err := r.Origin.validate(); err != nil {
+		return nil, fmt.Errorf("maps: Origin: %v", err)
+	}
+	if err := r.Destination.validate(); err != nil {
+		return nil, fmt.Errorf("maps: Destination: %v", err)
+	}
+	for i := range r.Intermediates {
+		if err := r.Intermediates[i].validate(); err != nil {
+			return nil, fmt.Errorf("maps: Intermediates[%d]: %v", i, err)
+		}
+	}
+	if o := callOptionsFromContext(ctx); len(r.FieldMask) == 0 && (o == nil || len(o.fieldMask) == 0) {
+		return nil, errors.New("maps: FieldMask missing")
+	}
+	if r.DepartureTime != nil && r.ArrivalTime != nil {
+		return nil, errors.New("maps: DepartureTime and ArrivalTime both specified")
+	}
+
+	var response struct {
+		ComputeRoutesResponse
+		googleAPIResponse
+	}
+	if err := c.postJSON(ctx, computeRoutesAPI, r, &response); err != nil {
+		return nil, err
+	}
+	if err := response.StatusError(); err != nil {
+		return nil, err
+	}
+	return &response.ComputeRoutesResponse, nil
+}
+
+// RoutesTravelMode is the mode of travel of a Routes API request.
+type RoutesTravelMode string
+
+// Travel modes of the Routes API.
+const (
+	RoutesTravelModeDrive      = RoutesTravelMode("DRIVE")
+	RoutesTravelModeBicycle    = RoutesTravelMode("BICYCLE")
+	RoutesTravelModeWalk       = RoutesTravelMode("WALK")
+	RoutesTravelModeTwoWheeler = RoutesTravelMode("TWO_WHEELER")
+	RoutesTravelModeTransit    = RoutesTravelMode("TRANSIT")
+)
+
+// RoutingPreference specifies how traffic is considered when computing routes.
+type RoutingPreference string
+
+// Routing preferences of the Routes API.
+const (
+	RoutingPreferenceTrafficUnaware      = RoutingPreference("TRAFFIC_UNAWARE")
+	RoutingPreferenceTrafficAware        = RoutingPreference("TRAFFIC_AWARE")
+	RoutingPreferenceTrafficAwareOptimal = RoutingPreference("TRAFFIC_AWARE_OPTIMAL")
+)
+
+// PolylineQuality specifies the quality of the returned polylines.
+type PolylineQuality string
+
+// Polyline qualities of the Routes API.
+const (
+	PolylineQualityHighQuality = PolylineQuality("HIGH_QUALITY")
+	PolylineQualityOverview    = PolylineQuality("OVERVIEW")
+)
+
+// PolylineEncoding specifies the encoding of the returned polylines.
+type PolylineEncoding string
+
+// Polyline encodings of the Routes API.
+const (
+	PolylineEncodingEncodedPolyline   = PolylineEncoding("ENCODED_POLYLINE")
+	PolylineEncodingGeoJSONLinestring = PolylineEncoding("GEO_JSON_LINESTRING")
+)
+
+// ReferenceRoute is a route computed in addition to the default route.
+type ReferenceRoute string
+
+// Reference routes of the Routes API.
+const (
+	ReferenceRouteFuelEfficient   = ReferenceRoute("FUEL_EFFICIENT")
+	ReferenceRouteShorterDistance = ReferenceRoute("SHORTER_DISTANCE")
+)
+
+// ExtraComputation is an additional computation to perform for a request.
+type ExtraComputation string
+
+// Extra computations of the Routes API.
+const (
+	ExtraComputationTolls                               = ExtraComputation("TOLLS")
+	ExtraComputationFuelConsumption                     = ExtraComputation("FUEL_CONSUMPTION")
+	ExtraComputationTrafficOnPolyline                   = ExtraComputation("TRAFFIC_ON_POLYLINE")
+	ExtraComputationHTMLFormattedNavigationInstructions = ExtraComputation("HTML_FORMATTED_NAVIGATION_INSTRUCTIONS")
+)
+
+// RouteLabel identifies the kind of a returned route.
+type RouteLabel string
+
+// Route labels of the Routes API.
+const (
+	RouteLabelDefaultRoute          = RouteLabel("DEFAULT_ROUTE")
+	RouteLabelDefaultRouteAlternate = RouteLabel("DEFAULT_ROUTE_ALTERNATE")
+	RouteLabelFuelEfficient         = RouteLabel("FUEL_EFFICIENT")
+	RouteLabelShorterDistance       = RouteLabel("SHORTER_DISTANCE")
+)
+
+// VehicleEmissionType is the emission type of a vehicle, used for fuel
+// efficient routing.
+type VehicleEmissionType string
+
+// Vehicle emission types of the Routes API.
+const (
+	VehicleEmissionTypeGasoline = VehicleEmissionType("GASOLINE")
+	VehicleEmissionTypeElectric = VehicleEmissionType("ELECTRIC")
+	VehicleEmissionTypeHybrid   = VehicleEmissionType("HYBRID")
+	VehicleEmissionTypeDiesel   = VehicleEmissionType("DIESEL")
+)
+
+// ComputeRoutesRequest is the request structure for the Routes API Compute
+// Routes method.
+type ComputeRoutesRequest struct {
+	// Origin is the origin of the route. Required.
+	Origin RoutesWaypoint `json:"origin"`
+	// Destination is the destination of the route. Required.
+	Destination RoutesWaypoint `json:"destination"`
+	// Intermediates are the waypoints along the route, for stopping at or
+	// passing through. Optional.
+	Intermediates []RoutesWaypoint `json:"intermediates,omitempty"`
+	// TravelMode is the mode of transportation. Optional.
+	TravelMode RoutesTravelMode `json:"travelMode,omitempty"`
+	// RoutingPreference specifies how to consider traffic. Optional.
+	RoutingPreference RoutingPreference `json:"routingPreference,omitempty"`
+	// PolylineQuality specifies the quality of the polylines. Optional.
+	PolylineQuality PolylineQuality `json:"polylineQuality,omitempty"`
+	// PolylineEncoding specifies the encoding of the polylines. Optional.
+	PolylineEncoding PolylineEncoding `json:"polylineEncoding,omitempty"`
+	// DepartureTime is the time of departure. Optional.
+	DepartureTime *time.Time `json:"departureTime,omitempty"`
+	// ArrivalTime is the time of arrival, for transit routes. Optional.
+	ArrivalTime *time.Time `json:"arrivalTime,omitempty"`
+	// ComputeAlternativeRoutes requests alternative routes in addition to the
+	// default route. Optional.
+	ComputeAlternativeRoutes bool `json:"computeAlternativeRoutes,omitempty"`
+	// RouteModifiers are conditions that affect the computed routes. Optional.
+	RouteModifiers *RouteModifiers `json:"routeModifiers,omitempty"`
+	// LanguageCode is the BCP-47 language code of the returned text. Optional.
+	LanguageCode string `json:"languageCode,omitempty"`
+	// RegionCode is the ccTLD region code used to interpret addresses.
+	// Optional.
+	RegionCode string `json:"regionCode,omitempty"`
+	// Units is the unit system of the returned text. Optional.
+	Units Units `json:"units,omitempty"`
+	// OptimizeWaypointOrder reorders the Intermediates to minimize the cost of
+	// the route. Optional.
+	OptimizeWaypointOrder bool `json:"optimizeWaypointOrder,omitempty"`
+	// RequestedReferenceRoutes are routes to compute in addition to the
+	// default route, such as a fuel efficient route. Optional.
+	RequestedReferenceRoutes []ReferenceRoute `json:"requestedReferenceRoutes,omitempty"`
+	// ExtraComputations are additional computations, such as tolls. Optional.
+	ExtraComputations []ExtraComputation `json:"extraComputations,omitempty"`
+	// TrafficModel specifies the assumptions used to calculate durations in
+	// traffic. Optional.
+	TrafficModel TrafficModel `json:"trafficModel,omitempty"`
+
+	// FieldMask lists the response fields to return, for example
+	// "routes.duration" and "routes.polyline.encodedPolyline", or "*" for all
+	// fields. It is sent as the X-Goog-FieldMask header. Required, unless set
+	// with CallFieldMask.
+	FieldMask []string `json:"-"`
+}
+
+// header returns the X-Goog-FieldMask header of the request.
+func (r *ComputeRoutesRequest) header() http.Header {
+	h := http.Header{}
+	h.Set("X-Goog-FieldMask", strings.Join(r.FieldMask, ","))
+	return h
+}
+
+// MarshalJSON implements json.Marshaler for ComputeRoutesRequest. Units and
+// TrafficModel are encoded as the upper case values of the Routes API.
+func (r *ComputeRoutesRequest) MarshalJSON() ([]byte, error) {
+	type safeComputeRoutesRequest ComputeRoutesRequest
+	x := safeComputeRoutesRequest(*r)
+	x.Units = Units(strings.ToUpper(string(r.Units)))
+	x.TrafficModel = TrafficModel(strings.ToUpper(string(r.TrafficModel)))
+	return json.Marshal(x)
+}
+
+// RoutesWaypoint is an origin, destination or intermediate waypoint of a
+// Routes API request. Exactly one of Location, PlaceID and Address must be set.
+type RoutesWaypoint struct {
+	// Location is the location of the waypoint.
+	Location *RoutesLocation `json:"location,omitempty"`
+	// PlaceID is the place ID of the waypoint.
+	PlaceID string `json:"placeId,omitempty"`
+	// Address is the address of the waypoint.
+	Address string `json:"address,omitempty"`
+	// Via marks an intermediate waypoint to pass through rather than stop at.
+	Via bool `json:"via,omitempty"`
+	// VehicleStopover indicates that vehicles stop at the waypoint to pick up
+	// or drop off.
+	VehicleStopover bool `json:"vehicleStopover,omitempty"`
+	// SideOfRoad indicates that vehicles should stop on the waypoint's side of
+	// the road.
+	SideOfRoad bool `json:"sideOfRoad,omitempty"`
+}
+
+func (w *RoutesWaypoint) validate() error {
+	set := 0
+	for _, ok := range []bool{w.Location != nil, w.PlaceID != "", w.Address != ""} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of Location, PlaceID and Address required")
+	}
+	return nil
+}
+
+// RoutesLocation is a location with an optional heading.
+type RoutesLocation struct {
+	// LatLng is the coordinates of the location.
+	LatLng LatLng `json:"latLng"`
+	// Heading is the compass heading, in degrees clockwise from north, of the
+	// direction of traffic. Optional.
+	Heading *int `json:"heading,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler for RoutesLocation, encoding LatLng
+// with the latitude and longitude key names of the Routes API.
+func (l *RoutesLocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		LatLng  AddressValidationLatLng `json:"latLng"`
+		Heading *int                    `json:"heading,omitempty"`
+	}{l.LatLng.AddressValidationLatLng(), l.Heading})
+}
+
+// RouteModifiers are conditions that affect the computed routes.
+type RouteModifiers struct {
+	// AvoidTolls avoids toll roads where reasonable.
+	AvoidTolls bool `json:"avoidTolls,omitempty"`
+	// AvoidHighways avoids highways where reasonable.
+	AvoidHighways bool `json:"avoidHighways,omitempty"`
+	// AvoidFerries avoids ferries where reasonable.
+	AvoidFerries bool `json:"avoidFerries,omitempty"`
+	// AvoidIndoor avoids navigating indoors where reasonable.
+	AvoidIndoor bool `json:"avoidIndoor,omitempty"`
+	// VehicleInfo describes the vehicle, for fuel efficient routing.
+	VehicleInfo *VehicleInfo `json:"vehicleInfo,omitempty"`
+	// TollPasses are the toll passes held by the driver, such as "US_CA_FASTRAK".
+	TollPasses []string `json:"tollPasses,omitempty"`
+}
+
+// VehicleInfo describes a vehicle.
+type VehicleInfo struct {
+	// EmissionType is the emission type of the vehicle.
+	EmissionType VehicleEmissionType `json:"emissionType,omitempty"`
+}
+
+// ComputeRoutesResponse is the response to a Compute Routes request. Only the
+// fields selected by the request's FieldMask are set.
+type ComputeRoutesResponse struct {
+	// Routes are the computed routes.
+	Routes []ComputedRoute `json:"routes"`
+	// FallbackInfo is set when the routes could not be computed with the
+	// requested routing preference.
+	FallbackInfo *FallbackInfo `json:"fallbackInfo,omitempty"`
+}
+
+// FallbackInfo describes how and why a fallback result was used.
+type FallbackInfo struct {
+	// RoutingMode is the routing mode used for the response.
+	RoutingMode string `json:"routingMode"`
+	// Reason is the reason a fallback was used.
+	Reason string `json:"reason"`
+}
+
+// ComputedRoute is a route computed by the Routes API.
+type ComputedRoute struct {
+	// RouteLabels identify the kind of the route, such as the default or the
+	// fuel efficient route.
+	RouteLabels []RouteLabel `json:"routeLabels,omitempty"`
+	// Legs are the legs of the route between waypoints.
+	Legs []RouteLeg `json:"legs,omitempty"`
+	// DistanceMeters is the length of the route in meters.
+	DistanceMeters int `json:"distanceMeters,omitempty"`
+	// Duration is the time needed to travel the route, considering traffic
+	// according to the routing preference.
+	Duration time.Duration `json:"-"`
+	// StaticDuration is the time needed to travel the route without
+	// considering traffic.
+	StaticDuration time.Duration `json:"-"`
+	// Polyline is the overview polyline of the route.
+	Polyline RoutesPolyline `json:"polyline"`
+	// Description is a description of the route.
+	Description string `json:"description,omitempty"`
+	// Warnings are warnings to be displayed with the route.
+	Warnings []string `json:"warnings,omitempty"`
+	// Viewport is the viewport bounding box of the polyline.
+	Viewport *RoutesViewport `json:"viewport,omitempty"`
+	// TravelAdvisory contains additional information about the route, such as
+	// tolls.
+	TravelAdvisory *RouteTravelAdvisory `json:"travelAdvisory,omitempty"`
+	// OptimizedIntermediateWaypointIndex is the order of the intermediate
+	// waypoints when OptimizeWaypointOrder was requested.
+	OptimizedIntermediateWaypointIndex []int `json:"optimizedIntermediateWaypointIndex,omitempty"`
+	// RouteToken is an opaque token identifying the route for Navigation SDK.
+	RouteToken string `json:"routeToken,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ComputedRoute, decoding its
+// durations.
+func (r *ComputedRoute) UnmarshalJSON(data []byte) error {
+	type safeComputedRoute ComputedRoute
+	var x struct {
+		safeComputedRoute
+		protoDurations
+	}
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	*r = ComputedRoute(x.safeComputedRoute)
+	var err error
+	r.Duration, r.StaticDuration, err = x.durations()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler for ComputedRoute, encoding its
+// durations.
+func (r *ComputedRoute) MarshalJSON() ([]byte, error) {
+	type safeComputedRoute ComputedRoute
+	return json.Marshal(struct {
+		*safeComputedRoute
+		protoDurations
+	}{(*safeComputedRoute)(r), newProtoDurations(r.Duration, r.StaticDuration)})
+}
+
+// RouteLeg is the part of a ComputedRoute between two waypoints.
+type RouteLeg struct {
+	// DistanceMeters is the length of the leg in meters.
+	DistanceMeters int `json:"distanceMeters,omitempty"`
+	// Duration is the time needed to travel the leg, considering traffic
+	// according to the routing preference.
+	Duration time.Duration `json:"-"`
+	// StaticDuration is the time needed to travel the leg without considering
+	// traffic.
+	StaticDuration time.Duration `json:"-"`
+	// Polyline is the overview polyline of the leg.
+	Polyline RoutesPolyline `json:"polyline"`
+	// StartLocation is the start of the leg.
+	StartLocation *RoutesLocation `json:"startLocation,omitempty"`
+	// EndLocation is the end of the leg.
+	EndLocation *RoutesLocation `json:"endLocation,omitempty"`
+	// Steps are the steps of the leg.
+	Steps []RouteLegStep `json:"steps,omitempty"`
+	// TravelAdvisory contains additional information about the leg, such as
+	// tolls.
+	TravelAdvisory *RouteTravelAdvisory `json:"travelAdvisory,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for RouteLeg, decoding its
+// durations.
+func (l *RouteLeg) UnmarshalJSON(data []byte) error {
+	type safeRouteLeg RouteLeg
+	var x struct {
+		safeRouteLeg
+		protoDurations
+	}
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	*l = RouteLeg(x.safeRouteLeg)
+	var err error
+	l.Duration, l.StaticDuration, err = x.durations()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler for RouteLeg, encoding its durations.
+func (l *RouteLeg) MarshalJSON() ([]byte, error) {
+	type safeRouteLeg RouteLeg
+	return json.Marshal(struct {
+		*safeRouteLeg
+		protoDurations
+	}{(*safeRouteLeg)(l), newProtoDurations(l.Duration, l.StaticDuration)})
+}
+
+// RouteLegStep is a single step of a RouteLeg.
+type RouteLegStep struct {
+	// DistanceMeters is the length of the step in meters.
+	DistanceMeters int `json:"distanceMeters,omitempty"`
+	// StaticDuration is the time needed to travel the step without
+	// considering traffic.
+	StaticDuration time.Duration `json:"-"`
+	// Polyline is the polyline of the step.
+	Polyline RoutesPolyline `json:"polyline"`
+	// StartLocation is the start of the step.
+	StartLocation *RoutesLocation `json:"startLocation,omitempty"`
+	// EndLocation is the end of the step.
+	EndLocation *RoutesLocation `json:"endLocation,omitempty"`
+	// NavigationInstruction is the instruction for the step.
+	NavigationInstruction *NavigationInstruction `json:"navigationInstruction,omitempty"`
+	// TravelMode is the mode of transportation of the step.
+	TravelMode RoutesTravelMode `json:"travelMode,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for RouteLegStep, decoding its
+// duration.
+func (s *RouteLegStep) UnmarshalJSON(data []byte) error {
+	type safeRouteLegStep RouteLegStep
+	var x struct {
+		safeRouteLegStep
+		protoDurations
+	}
+	if err := unmarshalJSON(data, &x); err != nil {
+		return err
+	}
+	*s = RouteLegStep(x.safeRouteLegStep)
+	var err error
+	_, s.StaticDuration, err = x.durations()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler for RouteLegStep, encoding its
+// duration.
+func (s *RouteLegStep) MarshalJSON() ([]byte, error) {
+	type safeRouteLegStep RouteLegStep
+	d := newProtoDurations(0, s.StaticDuration)
+	d.Duration = ""
+	return json.Marshal(struct {
+		*safeRouteLegStep
+		protoDurations
+	}{(*safeRouteLegStep)(s), d})
+}
+
+// NavigationInstruction is the instruction for a RouteLegStep.
+type NavigationInstruction struct {
+	// Maneuver is the maneuver of the step, such as "TURN_LEFT".
+	Maneuver string `json:"maneuver,omitempty"`
+	// Instructions are the instructions for the step.
+	Instructions string `json:"instructions,omitempty"`
+}
+
+// RoutesPolyline is a polyline of the Routes API, in the requested encoding.
+type RoutesPolyline struct {
+	// EncodedPolyline is the polyline in the encoded polyline format, which
+	// can be decoded with DecodePolyline.
+	EncodedPolyline string `json:"encodedPolyline,omitempty"`
+	// GeoJSONLinestring is the polyline as a GeoJSON LineString.
+	GeoJSONLinestring json.RawMessage `json:"geoJsonLinestring,omitempty"`
+}
+
+// RoutesViewport is the viewport of a route.
+type RoutesViewport = Viewport
+
+// RouteTravelAdvisory contains additional information about a route or leg.
+type RouteTravelAdvisory struct {
+	// TollInfo is the toll information, when tolls were requested with
+	// ExtraComputationTolls. It is nil if the route has no tolls.
+	TollInfo *TollInfo `json:"tollInfo,omitempty"`
+	// SpeedReadingIntervals describe the traffic density along the polyline,
+	// when requested with ExtraComputationTrafficOnPolyline.
+	SpeedReadingIntervals []SpeedReadingInterval `json:"speedReadingIntervals,omitempty"`
+	// FuelConsumptionMicroliters is the predicted fuel consumption, when
+	// requested with ExtraComputationFuelConsumption.
+	FuelConsumptionMicroliters int64 `json:"fuelConsumptionMicroliters,string,omitempty"`
+}
+
+// TollInfo is the toll information of a route or leg.
+type TollInfo struct {
+	// EstimatedPrice is the estimated price of the tolls, in each currency
+	// charged. It is empty if the price is unknown.
+	EstimatedPrice []Money `json:"estimatedPrice,omitempty"`
+}
+
+// SpeedReadingInterval describes the traffic density of part of a polyline.
+type SpeedReadingInterval struct {
+	// StartPolylinePointIndex is the index of the first point of the interval.
+	StartPolylinePointIndex int `json:"startPolylinePointIndex"`
+	// EndPolylinePointIndex is the index of the last point of the interval.
+	EndPolylinePointIndex int `json:"endPolylinePointIndex"`
+	// Speed is the traffic speed, such as "NORMAL", "SLOW" or "TRAFFIC_JAM".
+	Speed string `json:"speed,omitempty"`
+}
+
+// protoDurations are the durations of the Routes API, encoded as strings of
+// seconds with an "s" suffix, such as "3.5s".
+type protoDurations struct {
+	Duration       string `json:"duration,omitempty"`
+	StaticDuration string `json:"staticDuration,omitempty"`
+}
+
+func newProtoDurations(duration, staticDuration time.Duration) protoDurations {
+	return protoDurations{formatProtoDuration(duration), formatProtoDuration(staticDuration)}
+}
+
+func (d *protoDurations) durations() (duration, staticDuration time.Duration, err error) {
+	if duration, err = parseProtoDuration(d.Duration); err != nil {
+		return 0, 0, err
+	}
+	if staticDuration, err = parseProtoDuration(d.StaticDuration); err != nil {
+		return 0, 0, err
+	}
+	return duration, staticDuration, nil
+}
+
+func parseProtoDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if !strings.HasSuffix(s, "s") {
+		return 0, fmt.Errorf("maps: invalid duration %q", s)
+	}
+	seconds, err := strconv.ParseFloat(strings.TrimSuffix(s, "s"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("maps: invalid duration %q", s)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func formatProtoDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeRoutes(t *testing.T) {
+	response := `{
+  "routes": [
+    {
+      "routeLabels": ["DEFAULT_ROUTE"],
+      "legs": [
+        {
+          "distanceMeters": 772,
+          "duration": "165s",
+          "staticDuration": "160s",
+          "polyline": {"encodedPolyline": "ipkcFfichV"},
+          "startLocation": {"latLng": {"latitude": 37.419734, "longitude": -122.0827784}},
+          "endLocation": {"latLng": {"latitude": 37.41767, "longitude": -122.079595}},
+          "steps": [
+            {
+              "distanceMeters": 772,
+              "staticDuration": "160.5s",
+              "navigationInstruction": {"maneuver": "DEPART", "instructions": "Head north"},
+              "travelMode": "DRIVE"
+            }
+          ]
+        }
+      ],
+      "distanceMeters": 772,
+      "duration": "165s",
+      "staticDuration": "160s",
+      "polyline": {"encodedPolyline": "ipkcFfichV"},
+      "viewport": {
+        "low": {"latitude": 37.41767, "longitude": -122.0827784},
+        "high": {"latitude": 37.419734, "longitude": -122.079595}
+      },
+      "travelAdvisory": {
+        "tollInfo": {"estimatedPrice": [{"currencyCode": "USD", "units": "4", "nanos": 500000000}]},
+        "fuelConsumptionMicroliters": "120000"
+      }
+    },
+    {
+      "routeLabels": ["FUEL_EFFICIENT"],
+      "duration": "170s"
+    }
+  ]
+}`
+
+	var header http.Header
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != computeRoutesAPI.path || r.URL.Query().Get("key") != apiKey {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		header = r.Header
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, response)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	heading := 90
+	departure := time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)
+	r := &ComputeRoutesRequest{
+		Origin:                   RoutesWaypoint{Location: &RoutesLocation{LatLng: LatLng{Lat: 37.419734, Lng: -122.0827784}, Heading: &heading}},
+		Destination:              RoutesWaypoint{PlaceID: "ChIJj61dQgK6j4AR4GeTYWZsKWw"},
+		Intermediates:            []RoutesWaypoint{{Address: "Mountain View, CA", Via: true}},
+		TravelMode:               RoutesTravelModeDrive,
+		RoutingPreference:        RoutingPreferenceTrafficAware,
+		PolylineQuality:          PolylineQualityHighQuality,
+		DepartureTime:            &departure,
+		RouteModifiers:           &RouteModifiers{AvoidTolls: true, VehicleInfo: &VehicleInfo{EmissionType: VehicleEmissionTypeGasoline}},
+		Units:                    UnitsMetric,
+		RequestedReferenceRoutes: []ReferenceRoute{ReferenceRouteFuelEfficient},
+		ExtraComputations:        []ExtraComputation{ExtraComputationTolls, ExtraComputationFuelConsumption},
+		FieldMask:                []string{"routes.duration", "routes.legs", "routes.travelAdvisory"},
+	}
+	resp, err := c.ComputeRoutes(context.Background(), r)
+	require.NoError(t, err)
+
+	assert.Equal(t, "routes.duration,routes.legs,routes.travelAdvisory", header.Get("X-Goog-FieldMask"))
+	assert.Equal(t, map[string]interface{}{
+		"origin": map[string]interface{}{
+			"location": map[string]interface{}{
+				"latLng":  map[string]interface{}{"latitude": 37.419734, "longitude": -122.0827784},
+				"heading": float64(90),
+			},
+		},
+		"destination":       map[string]interface{}{"placeId": "ChIJj61dQgK6j4AR4GeTYWZsKWw"},
+		"intermediates":     []interface{}{map[string]interface{}{"address": "Mountain View, CA", "via": true}},
+		"travelMode":        "DRIVE",
+		"routingPreference": "TRAFFIC_AWARE",
+		"polylineQuality":   "HIGH_QUALITY",
+		"departureTime":     "2026-10-16T08:00:00Z",
+		"routeModifiers": map[string]interface{}{
+			"avoidTolls":  true,
+			"vehicleInfo": map[string]interface{}{"emissionType": "GASOLINE"},
+		},
+		"units":                    "METRIC",
+		"requestedReferenceRoutes": []interface{}{"FUEL_EFFICIENT"},
+		"extraComputations":        []interface{}{"TOLLS", "FUEL_CONSUMPTION"},
+	}, body)
+
+	require.Len(t, resp.Routes, 2)
+	route := resp.Routes[0]
+	assert.Equal(t, []RouteLabel{RouteLabelDefaultRoute}, route.RouteLabels)
+	assert.Equal(t, 165*time.Second, route.Duration)
+	assert.Equal(t, 160*time.Second, route.StaticDuration)
+	assert.Equal(t, "ipkcFfichV", route.Polyline.EncodedPolyline)
+	assert.Equal(t, LatLng{Lat: 37.41767, Lng: -122.0827784}, route.Viewport.Low)
+	assert.Equal(t, []Money{{CurrencyCode: "USD", Units: 4, Nanos: 500000000}}, route.TravelAdvisory.TollInfo.EstimatedPrice)
+	assert.Equal(t, int64(120000), route.TravelAdvisory.FuelConsumptionMicroliters)
+	require.Len(t, route.Legs, 1)
+	assert.Equal(t, 165*time.Second, route.Legs[0].Duration)
+	assert.Equal(t, LatLng{Lat: 37.419734, Lng: -122.0827784}, route.Legs[0].StartLocation.LatLng)
+	assert.Equal(t, 160500*time.Millisecond, route.Legs[0].Steps[0].StaticDuration)
+	assert.Equal(t, "DEPART", route.Legs[0].Steps[0].NavigationInstruction.Maneuver)
+	assert.Equal(t, []RouteLabel{RouteLabelFuelEfficient}, resp.Routes[1].RouteLabels)
+	assert.Equal(t, 170*time.Second, resp.Routes[1].Duration)
+}
+
+func TestComputeRoutesError(t *testing.T) {
+	server := mockServer(400, `{"error": {"code": 400, "message": "FieldMask is a required parameter.", "status": "INVALID_ARGUMENT"}}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+	r := &ComputeRoutesRequest{
+		Origin:      RoutesWaypoint{Address: "Sydney"},
+		Destination: RoutesWaypoint{Address: "Parramatta"},
+		FieldMask:   []string{"*"},
+	}
+	_, err := c.ComputeRoutes(context.Background(), r)
+	assert.Equal(t, &StatusError{status: "INVALID_ARGUMENT", message: "FieldMask is a required parameter.", httpStatus: 400}, err)
+}
+
+func TestComputeRoutesMissingFields(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	now := time.Now()
+	tests := []*ComputeRoutesRequest{
+		{Destination: RoutesWaypoint{Address: "Parramatta"}, FieldMask: []string{"*"}},
+		{Origin: RoutesWaypoint{Address: "Sydney", PlaceID: "ChIJ"}, Destination: RoutesWaypoint{Address: "Parramatta"}, FieldMask: []string{"*"}},
+		{Origin: RoutesWaypoint{Address: "Sydney"}, Destination: RoutesWaypoint{Address: "Parramatta"}},
+		{Origin: RoutesWaypoint{Address: "Sydney"}, Destination: RoutesWaypoint{Address: "Parramatta"}, FieldMask: []string{"*"}, DepartureTime: &now, ArrivalTime: &now},
+	}
+	for _, r := range tests {
+		_, err := c.ComputeRoutes(context.Background(), r)
+		assert.Error(t, err)
+	}
+}
+
+func TestComputedRouteJSONRoundTrip(t *testing.T) {
+	route := ComputedRoute{
+		Duration:       90 * time.Second,
+		StaticDuration: 1500 * time.Millisecond,
+		Legs:           []RouteLeg{{Duration: time.Minute, Steps: []RouteLegStep{{StaticDuration: time.Second}}}},
+	}
+	data, err := json.Marshal(&route)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"duration":"90s"`)
+	assert.Contains(t, string(data), `"staticDuration":"1.5s"`)
+
+	var got ComputedRoute
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, route, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"duration": "90"}`), &got))
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"image"
+)
+
+// Service is the API surface of Client: a method for each request to the
+// Google Maps APIs. Code which depends on Service rather than *Client can be
+// tested with a mock or fake, for example one which embeds Service and
+// overrides the methods under test.
+type Service interface {
+	AirQualityHeatmapTile(ctx context.Context, r *AirQualityHeatmapTileRequest) (*AirQualityHeatmapTile, error)
+	ComputeRoutes(ctx context.Context, r *ComputeRoutesRequest) (*ComputeRoutesResponse, error)
+	CreateMapTilesSession(ctx context.Context, r *MapTilesSessionRequest) (*MapTilesSession, error)
+	Directions(ctx context.Context, r *DirectionsRequest) ([]Route, []GeocodedWaypoint, error)
+	DistanceMatrix(ctx context.Context, r *DistanceMatrixRequest) (*DistanceMatrixResponse, error)
+	Elevation(ctx context.Context, r *ElevationRequest) ([]ElevationResult, error)
+	FindPlaceFromText(ctx context.Context, r *FindPlaceFromTextRequest) (FindPlaceFromTextResponse, error)
+	Geocode(ctx context.Context, r *GeocodingRequest) (GeocodingResponse, error)
+	Geolocate(ctx context.Context, r *GeolocationRequest) (*GeolocationResult, error)
+	GetTile(ctx context.Context, r *MapTileRequest) (MapTileResponse, error)
+	MapTilesViewport(ctx context.Context, r *MapTilesViewportRequest) (*MapTilesViewport, error)
+	NearbySearch(ctx context.Context, r *NearbySearchRequest) (PlacesSearchResponse, error)
+	NearestRoads(ctx context.Context, r *NearestRoadsRequest) (*NearestRoadsResponse, error)
+	PlaceAutocomplete(ctx context.Context, r *PlaceAutocompleteRequest) (AutocompleteResponse, error)
+	PlaceDetails(ctx context.Context, r *PlaceDetailsRequest) (PlaceDetailsResult, error)
+	PlaceDetailsNew(ctx context.Context, r *PlaceDetailsNewRequest) (*Place, error)
+	PlacePhoto(ctx context.Context, r *PlacePhotoRequest) (PlacePhotoResponse, error)
+	PlacePhotoNew(ctx context.Context, r *PlacePhotoNewRequest) (*PlacePhotoNewResponse, error)
+	ProvideValidationFeedback(ctx context.Context, r *ValidationFeedbackRequest) error
+	QueryAutocomplete(ctx context.Context, r *QueryAutocompleteRequest) (AutocompleteResponse, error)
+	ReverseGeocode(ctx context.Context, r *GeocodingRequest) (GeocodingResponse, error)
+	SnapToRoad(ctx context.Context, r *SnapToRoadRequest) (*SnapToRoadResponse, error)
+	SpeedLimits(ctx context.Context, r *SpeedLimitsRequest) (*SpeedLimitsResponse, error)
+	StaticMap(ctx context.Context, r *StaticMapRequest) (image.Image, error)
+	StreetViewMetadata(ctx context.Context, r *StreetViewRequest) (StreetViewMetadata, error)
+	StreetViewStatic(ctx context.Context, r *StreetViewRequest) (StreetViewResponse, error)
+	TextSearch(ctx context.Context, r *TextSearchRequest) (PlacesSearchResponse, error)
+	Timezone(ctx context.Context, r *TimezoneRequest) (*TimezoneResult, error)
+	ValidateAddress(ctx context.Context, r *AddressValidationRequest) (*AddressValidationResponse, error)
+}
+
+var _ Service = (*Client)(nil)
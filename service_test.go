@@ -0,0 +1,45 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGeocoder is a Service which geocodes every address to the same place.
+type fakeGeocoder struct {
+	Service
+}
+
+func (fakeGeocoder) Geocode(ctx context.Context, r *GeocodingRequest) (GeocodingResponse, error) {
+	return GeocodingResponse{Results: []GeocodingResult{{FormattedAddress: r.Address, PlaceID: "fake"}}}, nil
+}
+
+func formattedAddress(ctx context.Context, s Service, address string) (string, error) {
+	resp, err := s.Geocode(ctx, &GeocodingRequest{Address: address})
+	if err != nil {
+		return "", err
+	}
+	return resp.Results[0].FormattedAddress, nil
+}
+
+func TestServiceFake(t *testing.T) {
+	address, err := formattedAddress(context.Background(), fakeGeocoder{}, "Sydney")
+	assert.NoError(t, err)
+	assert.Equal(t, "Sydney", address)
+}
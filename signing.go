@@ -0,0 +1,212 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"googlemaps.github.io/maps/internal"
+)
+
+// ErrInvalidSignature is returned when verifying a URL which is unsigned, or
+// not signed by any of the unexpired secrets of a URLSigner.
+var ErrInvalidSignature = errors.New("maps: invalid URL signature")
+
+// URLSigningSecret is a URL signing secret of a Google Maps Platform project,
+// as used with WithClientIDAndSignature and WithAPIKeyAndSignature.
+type URLSigningSecret struct {
+	// ID identifies the secret, for example by the date it was created.
+	ID string
+	// Secret is the URL modified Base64 encoded secret.
+	Secret string
+	// Expires is when the secret stops being valid, or zero if it does not
+	// expire. URLs signed by an expired secret fail verification.
+	Expires time.Time
+}
+
+type signingSecret struct {
+	URLSigningSecret
+	key []byte
+}
+
+// URLSigner signs URLs, such as Maps Static API URLs which are cached or
+// embedded in pages, with the current of several secrets, and verifies URLs
+// signed by any of them. This allows URLs signed before a secret is rotated to
+// be recognised and signed again. A URLSigner may be used concurrently.
+type URLSigner struct {
+	now     func() time.Time
+	mu      sync.Mutex
+	secrets []signingSecret
+}
+
+// NewURLSigner returns a URLSigner which signs with the first of secrets.
+func NewURLSigner(secrets ...URLSigningSecret) (*URLSigner, error) {
+	if len(secrets) == 0 {
+		return nil, errors.New("maps: no URL signing secrets")
+	}
+	s := &URLSigner{now: time.Now}
+	for i := len(secrets) - 1; i >= 0; i-- {
+		if err := s.Rotate(secrets[i]); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Rotate makes secret the current secret, used to sign URLs from now on. The
+// previous secrets are still used to verify URLs until they are retired or
+// expire.
+func (s *URLSigner) Rotate(secret URLSigningSecret) error {
+	key, err := base64.URLEncoding.DecodeString(secret.Secret)
+	if err != nil {
+		return fmt.Errorf("maps: URL signing secret %q: %v", secret.ID, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.secrets {
+		if existing.ID == secret.ID {
+			return fmt.Errorf("maps: duplicate URL signing secret %q", secret.ID)
+		}
+	}
+	s.secrets = append([]signingSecret{{secret, key}}, s.secrets...)
+	return nil
+}
+
+// Retire removes the secret identified by id, so that URLs signed by it fail
+// verification. The current secret cannot be retired.
+func (s *URLSigner) Retire(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, secret := range s.secrets {
+		if secret.ID != id {
+			continue
+		}
+		if i == 0 {
+			return fmt.Errorf("maps: URL signing secret %q is current", id)
+		}
+		// Verify iterates over s.secrets without the lock, so it is replaced
+		// rather than modified in place.
+		secrets := make([]signingSecret, 0, len(s.secrets)-1)
+		secrets = append(secrets, s.secrets[:i]...)
+		s.secrets = append(secrets, s.secrets[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("maps: unknown URL signing secret %q", id)
+}
+
+// CurrentID returns the ID of the secret which URLs are signed with.
+func (s *URLSigner) CurrentID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.secrets[0].ID
+}
+
+// Sign returns rawURL signed with the current secret, replacing any existing
+// signature.
+func (s *URLSigner) Sign(rawURL string) (string, error) {
+	u, query, _, err := splitSignedURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	key := s.secrets[0].key
+	s.mu.Unlock()
+	signature, err := internal.Signature(u.EscapedPath(), key, query)
+	if err != nil {
+		return "", err
+	}
+	if query != "" {
+		query += "&"
+	}
+	u.RawQuery = query + "signature=" + signature
+	return u.String(), nil
+}
+
+// Verify returns the ID of the secret which signed rawURL, or
+// ErrInvalidSignature if it is not signed by any unexpired secret.
+func (s *URLSigner) Verify(rawURL string) (string, error) {
+	u, query, signature, err := splitSignedURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+	got, err := base64.URLEncoding.DecodeString(signature)
+	if signature == "" || err != nil {
+		return "", ErrInvalidSignature
+	}
+	s.mu.Lock()
+	secrets := s.secrets
+	s.mu.Unlock()
+	now := s.now()
+	for _, secret := range secrets {
+		if !secret.Expires.IsZero() && !now.Before(secret.Expires) {
+			continue
+		}
+		want, err := internal.Signature(u.EscapedPath(), secret.key, query)
+		if err != nil {
+			return "", err
+		}
+		if wantBytes, err := base64.URLEncoding.DecodeString(want); err == nil && hmac.Equal(got, wantBytes) {
+			return secret.ID, nil
+		}
+	}
+	return "", ErrInvalidSignature
+}
+
+// Resign returns rawURL signed with the current secret if it is signed by an
+// older unexpired secret, and whether it was signed again. URLs already signed
+// by the current secret are returned unchanged, and URLs which fail
+// verification return ErrInvalidSignature.
+func (s *URLSigner) Resign(rawURL string) (string, bool, error) {
+	id, err := s.Verify(rawURL)
+	if err != nil {
+		return "", false, err
+	}
+	if id == s.CurrentID() {
+		return rawURL, false, nil
+	}
+	signed, err := s.Sign(rawURL)
+	if err != nil {
+		return "", false, err
+	}
+	return signed, true, nil
+}
+
+// splitSignedURL parses rawURL, returning its encoded query without the
+// signature parameter, and the signature.
+func splitSignedURL(rawURL string) (*url.URL, string, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", "", err
+	}
+	var params []string
+	signature := ""
+	for _, p := range strings.Split(u.RawQuery, "&") {
+		switch {
+		case p == "":
+		case strings.HasPrefix(p, "signature="):
+			signature = strings.TrimPrefix(p, "signature=")
+		default:
+			params = append(params, p)
+		}
+	}
+	return u, strings.Join(params, "&"), signature, nil
+}
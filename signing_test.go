@@ -0,0 +1,121 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"googlemaps.github.io/maps/internal"
+)
+
+const staticMapURL = "https://maps.googleapis.com/maps/api/staticmap?center=Sydney&client=clientID&size=100x100"
+
+func TestURLSignerSign(t *testing.T) {
+	s, err := NewURLSigner(URLSigningSecret{ID: "2026-01", Secret: "Zm9vYmFy"})
+	require.NoError(t, err)
+	signed, err := s.Sign(staticMapURL)
+	require.NoError(t, err)
+
+	// URLs are signed as the client signs its requests.
+	q, _ := url.ParseQuery("center=Sydney&client=clientID&size=100x100")
+	want, err := internal.SignURL("/maps/api/staticmap", []byte("foobar"), q)
+	require.NoError(t, err)
+	assert.Equal(t, "https://maps.googleapis.com/maps/api/staticmap?"+want, signed)
+
+	// Signing again replaces the signature.
+	again, err := s.Sign(signed)
+	require.NoError(t, err)
+	assert.Equal(t, signed, again)
+}
+
+func TestURLSignerRotation(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	old, err := NewURLSigner(URLSigningSecret{ID: "old", Secret: "b2xkc2VjcmV0"})
+	require.NoError(t, err)
+	oldURL, err := old.Sign(staticMapURL)
+	require.NoError(t, err)
+
+	s, err := NewURLSigner(
+		URLSigningSecret{ID: "new", Secret: "bmV3c2VjcmV0"},
+		URLSigningSecret{ID: "old", Secret: "b2xkc2VjcmV0", Expires: now.Add(time.Hour)},
+	)
+	require.NoError(t, err)
+	s.now = func() time.Time { return now }
+	assert.Equal(t, "new", s.CurrentID())
+
+	id, err := s.Verify(oldURL)
+	require.NoError(t, err)
+	assert.Equal(t, "old", id)
+
+	newURL, resigned, err := s.Resign(oldURL)
+	require.NoError(t, err)
+	assert.True(t, resigned)
+	id, err = s.Verify(newURL)
+	require.NoError(t, err)
+	assert.Equal(t, "new", id)
+	same, resigned, err := s.Resign(newURL)
+	require.NoError(t, err)
+	assert.False(t, resigned)
+	assert.Equal(t, newURL, same)
+
+	_, err = s.Verify(staticMapURL)
+	assert.Equal(t, ErrInvalidSignature, err)
+	_, err = s.Verify(staticMapURL + "&signature=AAAA")
+	assert.Equal(t, ErrInvalidSignature, err)
+
+	now = now.Add(time.Hour)
+	_, err = s.Verify(oldURL)
+	assert.Equal(t, ErrInvalidSignature, err, "expired secrets should not verify")
+
+	assert.Error(t, s.Retire("new"))
+	assert.NoError(t, s.Retire("old"))
+	assert.Error(t, s.Retire("old"))
+	assert.Error(t, s.Rotate(URLSigningSecret{ID: "new", Secret: "bmV3c2VjcmV0"}))
+	assert.Error(t, s.Rotate(URLSigningSecret{ID: "bad", Secret: "!"}))
+}
+
+func TestURLSignerRetireConcurrentVerify(t *testing.T) {
+	s, err := NewURLSigner(URLSigningSecret{ID: "first", Secret: "Zm9vYmFy"})
+	require.NoError(t, err)
+	signed, err := s.Sign(staticMapURL)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, s.Rotate(URLSigningSecret{ID: fmt.Sprint(i), Secret: "b2xkc2VjcmV0"}))
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 19; i++ {
+			assert.NoError(t, s.Retire(fmt.Sprint(i)))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			id, err := s.Verify(signed)
+			assert.NoError(t, err)
+			assert.Equal(t, "first", id)
+		}
+	}()
+	wg.Wait()
+}
@@ -34,6 +34,8 @@ var staticMapAPI = &apiConfig{
 	path:             "/maps/api/staticmap",
 	acceptsClientID:  true,
 	acceptsSignature: true,
+	acceptsLanguage:  true,
+	acceptsRegion:    true,
 }
 
 // MapType (optional) defines the type of map to construct. There are several possible
@@ -327,6 +329,9 @@ func (r *StaticMapRequest) params() url.Values {
 
 // StaticMap makes a StaticMap API request.
 func (c *Client) StaticMap(ctx context.Context, r *StaticMapRequest) (image.Image, error) {
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if len(r.Markers) == 0 && r.Center == "" && r.Zoom == 0 {
 		return nil, errors.New("maps: Center & Zoom required if Markers empty")
 	}
@@ -345,9 +350,12 @@ func (c *Client) StaticMap(ctx context.Context, r *StaticMapRequest) (image.Imag
 		if err != nil {
 			return nil, err
 		}
-		return nil, fmt.Errorf("Maps Static API: %d - %s", resp.statusCode, b)
+		return nil, httpStatusError("Maps Static API", resp, b)
 	}
 
 	img, _, err := image.Decode(resp.data)
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		return nil, ctxErr
+	}
 	return img, err
 }
@@ -0,0 +1,257 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// StaticMapProjection maps locations to pixels of a static map image.
+type StaticMapProjection struct {
+	center      LatLng
+	zoom, scale int
+	size        image.Point
+}
+
+// NewStaticMapProjection returns the projection of the static map requested by
+// r. The Center of r must be a latitude and longitude, and Zoom and Size must
+// be set, as the projection of maps fitted to their markers is not known.
+func NewStaticMapProjection(r *StaticMapRequest) (*StaticMapProjection, error) {
+	center, err := ParseLatLng(r.Center)
+	if err != nil {
+		return nil, fmt.Errorf("maps: Center must be a latitude and longitude: %v", err)
+	}
+	if r.Zoom <= 0 {
+		return nil, errors.New("maps: Zoom missing")
+	}
+	var width, height int
+	if _, err := fmt.Sscanf(r.Size, "%dx%d", &width, &height); err != nil || width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("maps: invalid Size %q", r.Size)
+	}
+	scale := r.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+	return &StaticMapProjection{center: center, zoom: r.Zoom, scale: scale, size: image.Pt(width*scale, height*scale)}, nil
+}
+
+// Point returns the pixel of the map image at l.
+func (p *StaticMapProjection) Point(l LatLng) image.Point {
+	x, y := p.world(l)
+	cx, cy := p.world(p.center)
+	return image.Pt(int(math.Round(x-cx))+p.size.X/2, int(math.Round(y-cy))+p.size.Y/2)
+}
+
+// world returns the Web Mercator pixel coordinates of l at the zoom and scale
+// of the map.
+func (p *StaticMapProjection) world(l LatLng) (x, y float64) {
+	size := 256 * math.Exp2(float64(p.zoom)) * float64(p.scale)
+	sin := math.Sin(l.Lat * math.Pi / 180)
+	sin = math.Min(math.Max(sin, -0.9999), 0.9999)
+	x = size * (0.5 + l.Lng/360)
+	y = size * (0.5 - math.Log((1+sin)/(1-sin))/(4*math.Pi))
+	return x, y
+}
+
+// StaticMapLayer is drawn over a static map by CompositeStaticMap.
+type StaticMapLayer interface {
+	Draw(dst draw.Image, p *StaticMapProjection)
+}
+
+// StaticMapComposite makes a Maps Static API request and draws layers over the
+// returned map, for markers beyond the limits of the API's custom icons. See
+// NewStaticMapProjection for the requirements on r.
+func (c *Client) StaticMapComposite(ctx context.Context, r *StaticMapRequest, layers ...StaticMapLayer) (*image.RGBA, error) {
+	p, err := NewStaticMapProjection(r)
+	if err != nil {
+		return nil, err
+	}
+	img, err := c.StaticMap(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return CompositeStaticMap(img, p, layers...), nil
+}
+
+// CompositeStaticMap returns a copy of the static map img, with projection p,
+// with layers drawn over it in order.
+func CompositeStaticMap(img image.Image, p *StaticMapProjection, layers ...StaticMapLayer) *image.RGBA {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	for _, layer := range layers {
+		layer.Draw(dst, p)
+	}
+	return dst
+}
+
+// EncodeImage encodes img to w in format, which is one of PNG8, PNG32, GIF,
+// JPG and JPGBaseline. The empty format encodes PNG.
+func EncodeImage(w io.Writer, img image.Image, format Format) error {
+	switch format {
+	case "", PNG8, PNG32:
+		return png.Encode(w, img)
+	case GIF:
+		return gif.Encode(w, img, nil)
+	case JPG, JPGBaseline:
+		return jpeg.Encode(w, img, nil)
+	}
+	return fmt.Errorf("maps: unsupported format %q", format)
+}
+
+// ImageMarker is a StaticMapLayer drawing an image at a location.
+type ImageMarker struct {
+	// Location is the location of the marker.
+	Location LatLng
+	// Image is the image of the marker.
+	Image image.Image
+	// Anchor is the point of Image placed at Location, for example the tip of
+	// a pin. The zero value places the top left corner of Image at Location.
+	Anchor image.Point
+}
+
+// Draw implements StaticMapLayer.
+func (m *ImageMarker) Draw(dst draw.Image, p *StaticMapProjection) {
+	at := p.Point(m.Location).Sub(m.Anchor)
+	bounds := m.Image.Bounds()
+	draw.Draw(dst, bounds.Sub(bounds.Min).Add(at), m.Image, bounds.Min, draw.Over)
+}
+
+// TextLabel is a StaticMapLayer drawing text centered on a location, in a
+// built-in 5x7 pixel font of digits, letters and common punctuation. Letters
+// are drawn in upper case.
+type TextLabel struct {
+	// Location is the location of the center of the label.
+	Location LatLng
+	// Text is the text of the label.
+	Text string
+	// Color is the color of the text. Default is black.
+	Color color.Color
+	// Background is the color of the box behind the text. Default is none.
+	Background color.Color
+	// Scale multiplies the size of the font. Default is 1.
+	Scale int
+}
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+)
+
+// Draw implements StaticMapLayer.
+func (l *TextLabel) Draw(dst draw.Image, p *StaticMapProjection) {
+	scale := l.Scale
+	if scale <= 0 {
+		scale = 1
+	}
+	fg := l.Color
+	if fg == nil {
+		fg = color.Black
+	}
+	text := []rune(strings.ToUpper(l.Text))
+	width := (len(text)*(glyphWidth+1) - 1) * scale
+	height := glyphHeight * scale
+	center := p.Point(l.Location)
+	origin := center.Sub(image.Pt(width/2, height/2))
+
+	if l.Background != nil {
+		box := image.Rect(0, 0, width, height).Add(origin).Inset(-scale)
+		draw.Draw(dst, box, image.NewUniform(l.Background), image.Point{}, draw.Over)
+	}
+	src := image.NewUniform(fg)
+	for i, r := range text {
+		glyph, ok := glyphs[r]
+		if !ok {
+			glyph = glyphs[unicode.ReplacementChar]
+		}
+		x0 := origin.X + i*(glyphWidth+1)*scale
+		for row, bits := range glyph {
+			for col, bit := range bits {
+				if bit != '#' {
+					continue
+				}
+				pixel := image.Rect(0, 0, scale, scale).Add(image.Pt(x0+col*scale, origin.Y+row*scale))
+				draw.Draw(dst, pixel, src, image.Point{}, draw.Over)
+			}
+		}
+	}
+}
+
+// glyphs is a 5x7 pixel font. Characters without a glyph are drawn as a box.
+var glyphs = map[rune][glyphHeight]string{
+	' ':  {".....", ".....", ".....", ".....", ".....", ".....", "....."},
+	'0':  {".###.", "#...#", "#..##", "#.#.#", "##..#", "#...#", ".###."},
+	'1':  {"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'2':  {".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	'3':  {"#####", "...#.", "..#..", "...#.", "....#", "#...#", ".###."},
+	'4':  {"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	'5':  {"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	'6':  {"..##.", ".#...", "#....", "####.", "#...#", "#...#", ".###."},
+	'7':  {"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	'8':  {".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+	'9':  {".###.", "#...#", "#...#", ".####", "....#", "...#.", ".##.."},
+	'A':  {".###.", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'B':  {"####.", "#...#", "#...#", "####.", "#...#", "#...#", "####."},
+	'C':  {".###.", "#...#", "#....", "#....", "#....", "#...#", ".###."},
+	'D':  {"###..", "#..#.", "#...#", "#...#", "#...#", "#..#.", "###.."},
+	'E':  {"#####", "#....", "#....", "####.", "#....", "#....", "#####"},
+	'F':  {"#####", "#....", "#....", "####.", "#....", "#....", "#...."},
+	'G':  {".###.", "#...#", "#....", "#.###", "#...#", "#...#", ".####"},
+	'H':  {"#...#", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'I':  {".###.", "..#..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'J':  {"..###", "...#.", "...#.", "...#.", "...#.", "#..#.", ".##.."},
+	'K':  {"#...#", "#..#.", "#.#..", "##...", "#.#..", "#..#.", "#...#"},
+	'L':  {"#....", "#....", "#....", "#....", "#....", "#....", "#####"},
+	'M':  {"#...#", "##.##", "#.#.#", "#.#.#", "#...#", "#...#", "#...#"},
+	'N':  {"#...#", "#...#", "##..#", "#.#.#", "#..##", "#...#", "#...#"},
+	'O':  {".###.", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'P':  {"####.", "#...#", "#...#", "####.", "#....", "#....", "#...."},
+	'Q':  {".###.", "#...#", "#...#", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R':  {"####.", "#...#", "#...#", "####.", "#.#..", "#..#.", "#...#"},
+	'S':  {".####", "#....", "#....", ".###.", "....#", "....#", "####."},
+	'T':  {"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "..#.."},
+	'U':  {"#...#", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'V':  {"#...#", "#...#", "#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W':  {"#...#", "#...#", "#...#", "#.#.#", "#.#.#", "#.#.#", ".#.#."},
+	'X':  {"#...#", "#...#", ".#.#.", "..#..", ".#.#.", "#...#", "#...#"},
+	'Y':  {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#..", "..#.."},
+	'Z':  {"#####", "....#", "...#.", "..#..", ".#...", "#....", "#####"},
+	'.':  {".....", ".....", ".....", ".....", ".....", ".##..", ".##.."},
+	',':  {".....", ".....", ".....", ".....", ".##..", "..#..", ".#..."},
+	'-':  {".....", ".....", ".....", "#####", ".....", ".....", "....."},
+	':':  {".....", ".##..", ".##..", ".....", ".##..", ".##..", "....."},
+	'!':  {"..#..", "..#..", "..#..", "..#..", "..#..", ".....", "..#.."},
+	'?':  {".###.", "#...#", "....#", "...#.", "..#..", ".....", "..#.."},
+	'\'': {"..#..", "..#..", ".....", ".....", ".....", ".....", "....."},
+	'/':  {".....", "....#", "...#.", "..#..", ".#...", "#....", "....."},
+	'(':  {"...#.", "..#..", ".#...", ".#...", ".#...", "..#..", "...#."},
+	')':  {".#...", "..#..", "...#.", "...#.", "...#.", "..#..", ".#..."},
+	'+':  {".....", "..#..", "..#..", "#####", "..#..", "..#..", "....."},
+	'#':  {".#.#.", ".#.#.", "#####", ".#.#.", "#####", ".#.#.", ".#.#."},
+
+	unicode.ReplacementChar: {"#####", "#...#", "#...#", "#...#", "#...#", "#...#", "#####"},
+}
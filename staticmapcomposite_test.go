@@ -0,0 +1,79 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticMapProjection(t *testing.T) {
+	p, err := NewStaticMapProjection(&StaticMapRequest{Center: "0,0", Zoom: 1, Size: "256x256", Scale: 2})
+	require.NoError(t, err)
+	assert.Equal(t, image.Pt(256, 256), p.Point(LatLng{}))
+	assert.Equal(t, image.Pt(512, 256), p.Point(LatLng{Lat: 0, Lng: 90}))
+	assert.Equal(t, image.Pt(256, 0), p.Point(LatLng{Lat: 66.51326044311186, Lng: 0}))
+
+	for _, r := range []*StaticMapRequest{
+		{Center: "Brooklyn Bridge", Zoom: 13, Size: "600x300"},
+		{Center: "0,0", Size: "600x300"},
+		{Center: "0,0", Zoom: 13, Size: "600"},
+	} {
+		_, err := NewStaticMapProjection(r)
+		assert.Error(t, err, r)
+	}
+}
+
+func TestStaticMapComposite(t *testing.T) {
+	white := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	draw.Draw(white, white.Bounds(), image.White, image.Point{}, draw.Src)
+	server := mockServerForQueryWithImage("", 200, white)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	red := color.RGBA{255, 0, 0, 255}
+	pin := image.NewRGBA(image.Rect(0, 0, 3, 3))
+	draw.Draw(pin, pin.Bounds(), image.NewUniform(red), image.Point{}, draw.Src)
+	r := &StaticMapRequest{Center: "10,10", Zoom: 10, Size: "100x100"}
+	img, err := c.StaticMapComposite(context.Background(), r,
+		&ImageMarker{Location: LatLng{Lat: 10, Lng: 10}, Image: pin, Anchor: image.Pt(1, 1)},
+		&TextLabel{Location: LatLng{Lat: 10.05, Lng: 10}, Text: "i"},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, red, img.RGBAAt(50, 50))
+	assert.Equal(t, red, img.RGBAAt(49, 49))
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, img.RGBAAt(47, 47))
+
+	// The label is drawn as the glyph of I, centered above the marker.
+	p, _ := NewStaticMapProjection(r)
+	at := p.Point(LatLng{Lat: 10.05, Lng: 10}).Sub(image.Pt(2, 3))
+	assert.Equal(t, color.RGBA{0, 0, 0, 255}, img.RGBAAt(at.X+2, at.Y+3))
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, img.RGBAAt(at.X, at.Y+3))
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeImage(&buf, img, PNG32))
+	decoded, _, err := image.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, img.Bounds(), decoded.Bounds())
+	assert.Error(t, EncodeImage(&buf, img, Format("webp")))
+}
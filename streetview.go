@@ -0,0 +1,214 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"image"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var streetViewStaticAPI = &apiConfig{
+	host:             "https://maps.googleapis.com",
+	path:             "/maps/api/streetview",
+	acceptsClientID:  true,
+	acceptsSignature: true,
+}
+
+var streetViewMetadataAPI = &apiConfig{
+	host:             "https://maps.googleapis.com",
+	path:             "/maps/api/streetview/metadata",
+	acceptsClientID:  true,
+	acceptsSignature: true,
+}
+
+// StreetViewSource limits Street View searches to selected sources.
+type StreetViewSource string
+
+const (
+	// StreetViewSourceDefault searches all sources of panoramas.
+	StreetViewSourceDefault = StreetViewSource("default")
+	// StreetViewSourceOutdoor searches only outdoor panoramas, excluding indoor
+	// collections.
+	StreetViewSourceOutdoor = StreetViewSource("outdoor")
+)
+
+// StreetViewRequest is the request structure for the Street View Static API
+// image and metadata requests.
+type StreetViewRequest struct {
+	// Size is the output size of the image in pixels, in the form 600x400.
+	// Required for images, ignored for metadata.
+	Size string
+	// Location is a text string, such as Chagrin Falls, OH, or a latitude and
+	// longitude, such as 40.457375,-80.009353. The nearest panorama within
+	// Radius is used. Exactly one of Location and Pano is required.
+	Location string
+	// Pano is a specific panorama ID. Exactly one of Location and Pano is
+	// required.
+	Pano string
+	// Heading is the compass heading of the camera, from 0 to 360. If nil the
+	// camera faces Location from the panorama.
+	Heading *float64
+	// Pitch is the up or down angle of the camera relative to the Street View
+	// vehicle, from -90 to 90. Default is 0.
+	Pitch float64
+	// FOV is the horizontal field of view of the image in degrees, with a
+	// maximum of 120. Default is 90.
+	FOV float64
+	// Radius is the radius in meters in which to search for a panorama near
+	// Location. Default is 50.
+	Radius int
+	// Source limits the search to selected sources. Default is
+	// StreetViewSourceDefault.
+	Source StreetViewSource
+	// ReturnErrorCode makes image requests for which no image is found return
+	// an error rather than a generic gray image.
+	ReturnErrorCode bool
+}
+
+func (r *StreetViewRequest) validate() error {
+	if (r.Location == "") == (r.Pano == "") {
+		return errors.New("maps: exactly one of Location and Pano required")
+	}
+	return nil
+}
+
+func (r *StreetViewRequest) params() url.Values {
+	q := make(url.Values)
+	if r.Size != "" {
+		q.Set("size", r.Size)
+	}
+	if r.Location != "" {
+		q.Set("location", r.Location)
+	}
+	if r.Pano != "" {
+		q.Set("pano", r.Pano)
+	}
+	if r.Heading != nil {
+		q.Set("heading", strconv.FormatFloat(*r.Heading, 'f', -1, 64))
+	}
+	if r.Pitch != 0 {
+		q.Set("pitch", strconv.FormatFloat(r.Pitch, 'f', -1, 64))
+	}
+	if r.FOV != 0 {
+		q.Set("fov", strconv.FormatFloat(r.FOV, 'f', -1, 64))
+	}
+	if r.Radius != 0 {
+		q.Set("radius", strconv.Itoa(r.Radius))
+	}
+	if r.Source != "" {
+		q.Set("source", string(r.Source))
+	}
+	if r.ReturnErrorCode {
+		q.Set("return_error_code", "true")
+	}
+	return q
+}
+
+// StreetViewResponse is a response to the Street View Static API image
+// request.
+type StreetViewResponse struct {
+	// ContentType is the server reported type of the Image.
+	ContentType string
+	// Data is the server returned image data. You must close this after you are
+	// finished.
+	Data io.ReadCloser
+}
+
+// Image will read and close response.Data and return it as an image.
+func (resp *StreetViewResponse) Image() (image.Image, error) {
+	defer resp.Data.Close()
+	if !strings.HasPrefix(resp.ContentType, "image/") {
+		return nil, errors.New("Image of unknown format: " + resp.ContentType)
+	}
+	img, _, err := image.Decode(resp.Data)
+	return img, err
+}
+
+// StreetViewStatic makes a Street View Static API image request.
+func (c *Client) StreetViewStatic(ctx context.Context, r *StreetViewRequest) (StreetViewResponse, error) {
+	if err := c.validateRequest(r); err != nil {
+		return StreetViewResponse{}, err
+	}
+	if r.Size == "" {
+		return StreetViewResponse{}, errors.New("maps: Size empty")
+	}
+	if err := r.validate(); err != nil {
+		return StreetViewResponse{}, err
+	}
+
+	resp, err := c.getBinary(ctx, streetViewStaticAPI, r)
+	if err != nil {
+		return StreetViewResponse{}, err
+	}
+
+	if resp.statusCode != http.StatusOK {
+		defer resp.data.Close()
+		b, err := ioutil.ReadAll(resp.data)
+		if err != nil {
+			return StreetViewResponse{}, err
+		}
+		return StreetViewResponse{}, httpStatusError("Street View Static API", resp, b)
+	}
+
+	return StreetViewResponse{resp.contentType, resp.data}, nil
+}
+
+// StreetViewMetadata describes the panorama a Street View Static API request
+// would show.
+type StreetViewMetadata struct {
+	// PanoID is the ID of the panorama. It is empty if no panorama was found.
+	PanoID string `json:"pano_id"`
+	// Location is the location of the panorama.
+	Location LatLng `json:"location"`
+	// Date is the year and month the panorama was captured, such as 2016-03.
+	Date string `json:"date"`
+	// Copyright is the copyright notice of the panorama, which must be displayed
+	// with it.
+	Copyright string `json:"copyright"`
+}
+
+// StreetViewMetadata makes a Street View Static API metadata request. Metadata
+// requests are not billed, so they can be used to check that a panorama
+// exists before requesting its image.
+func (c *Client) StreetViewMetadata(ctx context.Context, r *StreetViewRequest) (StreetViewMetadata, error) {
+	if err := c.validateRequest(r); err != nil {
+		return StreetViewMetadata{}, err
+	}
+	if err := r.validate(); err != nil {
+		return StreetViewMetadata{}, err
+	}
+
+	var response struct {
+		commonResponse
+		StreetViewMetadata
+	}
+
+	if err := c.getJSON(ctx, streetViewMetadataAPI, r, &response); err != nil {
+		return StreetViewMetadata{}, err
+	}
+
+	if err := response.StatusError(); err != nil {
+		return StreetViewMetadata{}, err
+	}
+
+	return response.StreetViewMetadata, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreetViewStatic(t *testing.T) {
+	server := mockServerForQueryWithImage("fov=80&heading=0&key=AIzaNotReallyAnAPIKey&location=46.414382%2C10.013988&pitch=-0.76&return_error_code=true&size=600x300&source=outdoor", 200, image.NewRGBA(image.Rect(0, 0, 600, 300)))
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	heading := 0.0
+	r := &StreetViewRequest{
+		Size:            "600x300",
+		Location:        "46.414382,10.013988",
+		Heading:         &heading,
+		Pitch:           -0.76,
+		FOV:             80,
+		Source:          StreetViewSourceOutdoor,
+		ReturnErrorCode: true,
+	}
+
+	resp, err := c.StreetViewStatic(context.Background(), r)
+	require.NoError(t, err)
+	img, err := resp.Image()
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 600, 300), img.Bounds())
+	assert.Equal(t, 1, server.successful)
+}
+
+func TestStreetViewStaticErrors(t *testing.T) {
+	server := mockServerForQuery("", 404, "")
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	_, err := c.StreetViewStatic(context.Background(), &StreetViewRequest{Size: "600x300", Pano: "abc", ReturnErrorCode: true})
+	assert.Error(t, err)
+
+	for _, r := range []*StreetViewRequest{
+		{Location: "Sydney"},
+		{Size: "600x300"},
+		{Size: "600x300", Location: "Sydney", Pano: "abc"},
+	} {
+		_, err := c.StreetViewStatic(context.Background(), r)
+		assert.Error(t, err, r)
+	}
+	assert.Equal(t, 1, server.successful)
+}
+
+func TestStreetViewMetadata(t *testing.T) {
+	response := `{
+		"copyright": "© Google",
+		"date": "2016-03",
+		"location": {"lat": 46.41438, "lng": 10.01399},
+		"pano_id": "tu510ie_z4ptBZYo2BGEJg",
+		"status": "OK"
+	}`
+	server := mockServerForQuery("key=AIzaNotReallyAnAPIKey&pano=tu510ie_z4ptBZYo2BGEJg&radius=100", 200, response)
+	defer server.s.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+
+	metadata, err := c.StreetViewMetadata(context.Background(), &StreetViewRequest{Pano: "tu510ie_z4ptBZYo2BGEJg", Radius: 100})
+	require.NoError(t, err)
+	assert.Equal(t, StreetViewMetadata{
+		PanoID:    "tu510ie_z4ptBZYo2BGEJg",
+		Location:  LatLng{Lat: 46.41438, Lng: 10.01399},
+		Date:      "2016-03",
+		Copyright: "© Google",
+	}, metadata)
+
+	server = mockServerForQuery("", 200, `{"status": "ZERO_RESULTS"}`)
+	defer server.s.Close()
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	metadata, err = c.StreetViewMetadata(context.Background(), &StreetViewRequest{Location: "Null Island"})
+	require.NoError(t, err)
+	assert.Empty(t, metadata.PanoID)
+
+	_, err = c.StreetViewMetadata(context.Background(), &StreetViewRequest{})
+	assert.Error(t, err)
+}
+
+func TestStreetViewSigned(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"status": "OK"}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKeyAndSignature(apiKey, "Zm9vYmFy"), WithBaseURL(server.URL))
+
+	_, err := c.StreetViewMetadata(context.Background(), &StreetViewRequest{Pano: "abc"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, query.Get("signature"))
+}
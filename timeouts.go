@@ -0,0 +1,209 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Endpoint identifies a Maps API endpoint. Its value is the request path,
+// which is also the name metrics are reported under.
+type Endpoint string
+
+// The endpoints used by Client.
+const (
+	EndpointAddressValidation  = Endpoint("/v1:validateAddress")
+	EndpointAirQualityHeatmap  = Endpoint("/v1/mapTypes/")
+	EndpointComputeRoutes      = Endpoint("/directions/v2:computeRoutes")
+	EndpointDirections         = Endpoint("/maps/api/directions/json")
+	EndpointDistanceMatrix     = Endpoint("/maps/api/distancematrix/json")
+	EndpointElevation          = Endpoint("/maps/api/elevation/json")
+	EndpointFindPlaceFromText  = Endpoint("/maps/api/place/findplacefromtext/json")
+	EndpointGeocoding          = Endpoint("/maps/api/geocode/json")
+	EndpointGeolocation        = Endpoint("/geolocation/v1/geolocate")
+	EndpointMapTile            = Endpoint("/v1/2dtiles/")
+	EndpointMapTilesSession    = Endpoint("/v1/createSession")
+	EndpointMapTilesViewport   = Endpoint("/tile/v1/viewport")
+	EndpointNearbySearch       = Endpoint("/maps/api/place/nearbysearch/json")
+	EndpointNearestRoads       = Endpoint("/v1/nearestRoads")
+	EndpointPlaceAutocomplete  = Endpoint("/maps/api/place/autocomplete/json")
+	EndpointPlaceDetails       = Endpoint("/maps/api/place/details/json")
+	EndpointPlaceDetailsNew    = Endpoint("/v1/places/")
+	EndpointPlacePhoto         = Endpoint("/maps/api/place/photo")
+	EndpointPlacePhotoNew      = Endpoint("/v1/")
+	EndpointProvideFeedback    = Endpoint("/v1:provideValidationFeedback")
+	EndpointQueryAutocomplete  = Endpoint("/maps/api/place/queryautocomplete/json")
+	EndpointSnapToRoads        = Endpoint("/v1/snapToRoads")
+	EndpointSpeedLimits        = Endpoint("/v1/speedLimits")
+	EndpointStaticMap          = Endpoint("/maps/api/staticmap")
+	EndpointStreetView         = Endpoint("/maps/api/streetview")
+	EndpointStreetViewMetadata = Endpoint("/maps/api/streetview/metadata")
+	EndpointTextSearch         = Endpoint("/maps/api/place/textsearch/json")
+	EndpointTimezone           = Endpoint("/maps/api/timezone/json")
+)
+
+// DefaultEndpointTimeout is the timeout of requests to endpoints without a
+// more specific default.
+const DefaultEndpointTimeout = 10 * time.Second
+
+// defaultEndpointTimeouts are the timeouts of endpoints whose requests are
+// expected to be much slower or faster than most.
+var defaultEndpointTimeouts = map[Endpoint]time.Duration{
+	EndpointDistanceMatrix:    30 * time.Second,
+	EndpointDirections:        20 * time.Second,
+	EndpointComputeRoutes:     20 * time.Second,
+	EndpointPlaceAutocomplete: 3 * time.Second,
+	EndpointQueryAutocomplete: 3 * time.Second,
+}
+
+// WithEndpointTimeout configures a Maps API client to time out requests to
+// endpoint after d, including any retries. Timeouts only apply when the
+// caller's context has no deadline. A d of zero disables the timeout for
+// endpoint.
+//
+// The timeout is measured on the client's Clock. For the image APIs, such as
+// static maps and place photos, it bounds the round trip until the response
+// headers arrive; reading the returned image is bounded only by the caller's
+// context.
+//
+// By default Distance Matrix requests time out after 30s, Directions and
+// Routes requests after 20s, autocomplete requests after 3s and all others
+// after DefaultEndpointTimeout.
+func WithEndpointTimeout(endpoint Endpoint, d time.Duration) ClientOption {
+	return func(c *Client) error {
+		if c.endpointTimeouts == nil {
+			c.endpointTimeouts = make(map[Endpoint]time.Duration)
+		}
+		c.endpointTimeouts[endpoint] = d
+		return nil
+	}
+}
+
+// endpointTimeout returns the timeout of requests to endpoint.
+func (c *Client) endpointTimeout(endpoint Endpoint) time.Duration {
+	if d, ok := c.endpointTimeouts[endpoint]; ok {
+		return d
+	}
+	if d, ok := defaultEndpointTimeouts[endpoint]; ok {
+		return d
+	}
+	return DefaultEndpointTimeout
+}
+
+// withEndpointTimeout returns ctx with the timeout of config's endpoint, unless
+// ctx already has a deadline.
+func (c *Client) withEndpointTimeout(ctx context.Context, config *apiConfig) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	d := c.endpointTimeout(Endpoint(config.path))
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	inner, cancel := context.WithCancel(ctx)
+	t := &timeoutContext{Context: inner, clock: c.clock, deadline: c.clock.Now().Add(d), cancel: cancel}
+	if _, ok := c.clock.(realClock); ok {
+		t.timer = time.AfterFunc(d, func() {
+			if t.expire() {
+				cancel()
+			}
+		})
+	}
+	return t, func() {
+		t.stop()
+		cancel()
+	}
+}
+
+// stopTimeout stops the endpoint timeout of ctx, if any, so that it no longer
+// bounds the rest of the request.
+func stopTimeout(ctx context.Context) {
+	if t, ok := ctx.(*timeoutContext); ok {
+		t.stop()
+	}
+}
+
+// timeoutContext is a context that expires at a deadline on a Clock. With the
+// system clock it is done as soon as the deadline passes; with other clocks the
+// deadline is checked whenever Err is called, such as before each attempt and
+// wait of a request.
+type timeoutContext struct {
+	context.Context
+	clock    Clock
+	deadline time.Time
+	cancel   context.CancelFunc
+	timer    *time.Timer
+
+	mu      sync.Mutex
+	expired bool
+	stopped bool
+}
+
+func (t *timeoutContext) Deadline() (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped {
+		return t.Context.Deadline()
+	}
+	return t.deadline, true
+}
+
+func (t *timeoutContext) Err() error {
+	if t.Context.Err() == nil && !t.clock.Now().Before(t.deadline) && t.expire() {
+		t.cancel()
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.expired {
+		return context.DeadlineExceeded
+	}
+	return t.Context.Err()
+}
+
+// expire marks t as past its deadline, reporting whether it was still running.
+func (t *timeoutContext) expire() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stopped || t.expired {
+		return false
+	}
+	t.expired = true
+	return true
+}
+
+// stop prevents t from expiring.
+func (t *timeoutContext) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped = true
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+}
+
+// cancelOnClose is a response body that releases the resources of its request's
+// context when it is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
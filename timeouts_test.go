@@ -0,0 +1,129 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointConstants(t *testing.T) {
+	endpoints := map[Endpoint]bool{
+		EndpointAddressValidation: true, EndpointComputeRoutes: true, EndpointDirections: true,
+		EndpointDistanceMatrix: true, EndpointElevation: true, EndpointFindPlaceFromText: true,
+		EndpointGeocoding: true, EndpointGeolocation: true, EndpointNearbySearch: true,
+		EndpointNearestRoads: true, EndpointPlaceAutocomplete: true, EndpointPlaceDetails: true,
+		EndpointPlaceDetailsNew: true, EndpointPlacePhoto: true, EndpointQueryAutocomplete: true,
+		EndpointSnapToRoads: true, EndpointSpeedLimits: true, EndpointStaticMap: true,
+		EndpointStreetView: true, EndpointStreetViewMetadata: true, EndpointTextSearch: true,
+		EndpointTimezone: true, EndpointMapTile: true, EndpointMapTilesSession: true,
+		EndpointMapTilesViewport: true, EndpointProvideFeedback: true, EndpointAirQualityHeatmap: true,
+		EndpointPlacePhotoNew: true,
+	}
+	for _, config := range []*apiConfig{
+		addressValidationAPI, computeRoutesAPI, directionsAPI, distanceMatrixAPI,
+		elevationAPI, findPlaceFromTextAPI, geocodingAPI, geolocationAPI,
+		placesNearbySearchAPI, nearestRoadsAPI, placesPlaceAutocompleteAPI, placeDetailsAPI,
+		placeDetailsNewAPI, placesPhotoAPI, placesQueryAutocompleteAPI, snapToRoadsAPI,
+		speedLimitsAPI, staticMapAPI, streetViewStaticAPI, streetViewMetadataAPI,
+		placesTextSearchAPI, timezoneAPI, mapTiles2DAPI, mapTilesCreateSessionAPI,
+		mapTilesViewportAPI, provideValidationFeedbackAPI, airQualityHeatmapAPI, placePhotoNewAPI,
+	} {
+		assert.True(t, endpoints[Endpoint(config.path)], config.path)
+	}
+}
+
+func TestEndpointTimeoutDefaults(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey), WithEndpointTimeout(EndpointElevation, time.Minute), WithEndpointTimeout(EndpointGeocoding, 0))
+	assert.Equal(t, 30*time.Second, c.endpointTimeout(EndpointDistanceMatrix))
+	assert.Equal(t, 3*time.Second, c.endpointTimeout(EndpointPlaceAutocomplete))
+	assert.Equal(t, DefaultEndpointTimeout, c.endpointTimeout(EndpointTimezone))
+	assert.Equal(t, time.Minute, c.endpointTimeout(EndpointElevation))
+
+	ctx, cancel := c.withEndpointTimeout(context.Background(), geocodingAPI)
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+}
+
+func TestEndpointTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"status": "OK", "timeZoneId": "UTC"}`)
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithEndpointTimeout(EndpointTimezone, 20*time.Millisecond))
+	r := &TimezoneRequest{Location: &LatLng{}}
+
+	_, err := c.Timezone(context.Background(), r)
+	assert.Error(t, err)
+
+	// The caller's deadline takes precedence over the endpoint timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = c.Timezone(ctx, r)
+	assert.NoError(t, err)
+}
+
+func TestEndpointTimeoutUsesClock(t *testing.T) {
+	server, requests := mockRateLimitedServer(http.Header{"Retry-After": {"5"}})
+	defer server.Close()
+	clock := newFakeClock()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithClock(clock),
+		WithRetry(RetryPolicy{}), WithEndpointTimeout(EndpointAddressValidation, 3*time.Second))
+	r := &AddressValidationRequest{Address: PostalAddress{AddressLines: []string{"1600 Amphitheatre Pkwy"}}}
+
+	// The wait before the retry passes the deadline on the client's clock.
+	_, err := c.ValidateAddress(context.Background(), r)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestEndpointTimeoutBinaryBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("head"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+		w.Write([]byte("tail"))
+	}))
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL), WithEndpointTimeout(EndpointStaticMap, 20*time.Millisecond))
+	r := &StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "100x100"}
+
+	// Only the round trip is bounded by the endpoint timeout, not reading the image.
+	resp, err := c.getBinary(context.Background(), staticMapAPI, r)
+	assert.NoError(t, err)
+	defer resp.data.Close()
+	data, err := ioutil.ReadAll(resp.data)
+	assert.NoError(t, err)
+	assert.Equal(t, "headtail", string(data))
+}
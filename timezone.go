@@ -30,10 +30,15 @@ var timezoneAPI = &apiConfig{
 	path:             "/maps/api/timezone/json",
 	acceptsClientID:  true,
 	acceptsSignature: false,
+	acceptsLanguage:  true,
+	cacheable:        true,
 }
 
 // Timezone makes a Timezone API request
 func (c *Client) Timezone(ctx context.Context, r *TimezoneRequest) (*TimezoneResult, error) {
+	if err := c.validateRequest(r); err != nil {
+		return nil, err
+	}
 	if r.Location == nil {
 		return nil, errors.New("maps: Location missing")
 	}
@@ -41,16 +46,24 @@ func (c *Client) Timezone(ctx context.Context, r *TimezoneRequest) (*TimezoneRes
 	var response struct {
 		TimezoneResult
 		commonResponse
+		// TimezoneErrorMessage is the Time Zone API's camel cased variant of
+		// commonResponse.ErrorMessage.
+		TimezoneErrorMessage string `json:"errorMessage"`
 	}
 
 	if err := c.getJSON(ctx, timezoneAPI, r, &response); err != nil {
 		return nil, err
 	}
 
+	if response.ErrorMessage == "" {
+		response.ErrorMessage = response.TimezoneErrorMessage
+	}
+
 	if err := response.StatusError(); err != nil {
 		return nil, err
 	}
 
+	response.TimezoneResult.Status = TimezoneStatus(response.commonResponse.Status)
 	return &response.TimezoneResult, nil
 }
 
@@ -71,18 +84,32 @@ type TimezoneRequest struct {
 	// Timestamp specifies the desired time. Time Zone API uses the timestamp to
 	// determine whether or not Daylight Savings should be applied.
 	Timestamp time.Time
-	// Language in which to return results.
+	// Language in which to return results. See
+	// https://developers.google.com/maps/faq#languagesupport for the list of
+	// supported languages.
 	Language string
 }
 
-// TimezoneResult is a single timezone result.
-type TimezoneResult struct {
-	// DstOffset is the offset for daylight-savings time in seconds.
-	DstOffset int `json:"dstOffset"`
-	// RawOffset is the offset from UTC for the given location.
-	RawOffset int `json:"rawOffset"`
-	// TimeZoneID is a string containing the "tz" ID of the time zone.
-	TimeZoneID string `json:"timeZoneId"`
-	// TimeZoneName is a string containing the long form name of the time zone.
-	TimeZoneName string `json:"timeZoneName"`
-}
+// TimezoneStatus is the status returned by the Time Zone API.
+type TimezoneStatus string
+
+// Statuses returned by the Time Zone API.
+const (
+	// TimezoneStatusOK indicates that the request was successful.
+	TimezoneStatusOK = TimezoneStatus("OK")
+	// TimezoneStatusInvalidRequest indicates that the request was malformed.
+	TimezoneStatusInvalidRequest = TimezoneStatus("INVALID_REQUEST")
+	// TimezoneStatusOverDailyLimit indicates a problem with the API key or
+	// billing account.
+	TimezoneStatusOverDailyLimit = TimezoneStatus("OVER_DAILY_LIMIT")
+	// TimezoneStatusOverQueryLimit indicates the requestor has exceeded quota.
+	TimezoneStatusOverQueryLimit = TimezoneStatus("OVER_QUERY_LIMIT")
+	// TimezoneStatusRequestDenied indicates that the API did not complete the
+	// request.
+	TimezoneStatusRequestDenied = TimezoneStatus("REQUEST_DENIED")
+	// TimezoneStatusUnknownError indicates an unknown error.
+	TimezoneStatusUnknownError = TimezoneStatus("UNKNOWN_ERROR")
+	// TimezoneStatusZeroResults indicates that no time zone data could be found
+	// for the specified position or time, for example a location in the ocean.
+	TimezoneStatusZeroResults = TimezoneStatus("ZERO_RESULTS")
+)
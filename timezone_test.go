@@ -53,6 +53,7 @@ func TestTimezoneNevada(t *testing.T) {
 		RawOffset:    -28800,
 		TimeZoneID:   "America/Los_Angeles",
 		TimeZoneName: "Pacific Standard Time",
+		Status:       TimezoneStatusOK,
 	}
 
 	if !reflect.DeepEqual(resp, correctResponse) {
@@ -141,8 +142,29 @@ func TestTimezoneZeroResults(t *testing.T) {
 		t.Errorf("Unexpected error for ZERO_RESULTS status")
 	}
 
-	var empty TimezoneResult
+	empty := TimezoneResult{Status: TimezoneStatusZeroResults}
 	if *result != empty {
 		t.Errorf("Unexpected result for ZERO_RESULTS status")
 	}
 }
+
+func TestTimezoneErrorMessage(t *testing.T) {
+	server := mockServer(200, `{"status" : "INVALID_REQUEST", "errorMessage" : "Invalid request. Invalid 'location' parameter."}`)
+	defer server.Close()
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.URL))
+
+	r := &TimezoneRequest{
+		Location:  &LatLng{91.0, 140.0},
+		Timestamp: time.Time{},
+	}
+
+	_, err := c.Timezone(context.Background(), r)
+	if err == nil {
+		t.Fatalf("Expected error for INVALID_REQUEST status")
+	}
+
+	expected := "maps: INVALID_REQUEST - Invalid request. Invalid 'location' parameter."
+	if err.Error() != expected {
+		t.Errorf("expected error %q, was %q", expected, err.Error())
+	}
+}
@@ -15,14 +15,18 @@
 package maps
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 )
 
 const userAgent = "GoogleGeoApiClientGo/0.1"
 
 // transport is an http.RoundTripper that replaces or appends userAgent the request's
-// User-Agent header.
+// User-Agent header, and sets the headers configured with WithHeader and
+// CallHeader.
 type transport struct {
 	Base http.RoundTripper
 }
@@ -38,9 +42,25 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		ua = fmt.Sprintf("%s;%s", ua, userAgent)
 	}
 	req.Header.Set("User-Agent", ua)
+	setHeaders(req.Context(), req)
 	return t.Base.RoundTrip(req)
 }
 
+// setHeaders sets the client headers and then the call option headers in ctx
+// on req.
+func setHeaders(ctx context.Context, req *http.Request) {
+	if h, ok := ctx.Value(contextHeader).(http.Header); ok {
+		for k, v := range h {
+			req.Header[k] = v
+		}
+	}
+	if o := callOptionsFromContext(ctx); o != nil {
+		for k, v := range o.header {
+			req.Header[k] = v
+		}
+	}
+}
+
 // cloneRequest returns a clone of the provided *http.Request.
 // The clone is a shallow copy of the struct and its Header map.
 func cloneRequest(r *http.Request) *http.Request {
@@ -54,3 +74,48 @@ func cloneRequest(r *http.Request) *http.Request {
 	}
 	return r2
 }
+
+// contextBody is a response body whose reads fail with the error of its
+// request's context once that is done. The underlying body is closed when the
+// context is done, so that a read blocked on a slow server, or on a
+// RoundTripper which ignores cancellation, returns promptly.
+type contextBody struct {
+	ctx    context.Context
+	body   io.ReadCloser
+	once   sync.Once
+	closed chan struct{}
+}
+
+// newContextBody returns body, made to abort reads once ctx is done.
+func newContextBody(ctx context.Context, body io.ReadCloser) io.ReadCloser {
+	if ctx.Done() == nil {
+		return body
+	}
+	b := &contextBody{ctx: ctx, body: body, closed: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+			body.Close()
+		case <-b.closed:
+		}
+	}()
+	return b
+}
+
+func (b *contextBody) Read(p []byte) (int, error) {
+	if err := b.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := b.body.Read(p)
+	if err != nil {
+		if ctxErr := b.ctx.Err(); ctxErr != nil {
+			return n, ctxErr
+		}
+	}
+	return n, err
+}
+
+func (b *contextBody) Close() error {
+	b.once.Do(func() { close(b.closed) })
+	return b.body.Close()
+}
@@ -15,8 +15,15 @@
 package maps
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestClientTransportMutate(t *testing.T) {
@@ -41,3 +48,108 @@ func TestDefaultClientTransportMutate(t *testing.T) {
 		t.Errorf("Transport's Base shouldn't have been a maps.transport, found to be a %T", tr.Base)
 	}
 }
+
+func TestClientWithHeader(t *testing.T) {
+	var header http.Header
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, query = r.Header, r.URL.Query()
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		fmt.Fprintln(w, `{"results": [], "status": "OK"}`)
+	}))
+	defer server.Close()
+	r := &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}}
+
+	c, _ := NewClient(WithClientIDAndSignature("clientID", "Zm9vYmFy"), WithBaseURL(server.URL))
+	if _, err := c.Elevation(context.Background(), r); err != nil {
+		t.Fatalf("Elevation: %v", err)
+	}
+	signature := query.Get("signature")
+
+	c, _ = NewClient(WithClientIDAndSignature("clientID", "Zm9vYmFy"), WithBaseURL(server.URL),
+		WithHeader("Proxy-Authorization", "Bearer token"), WithHeader("X-Tenant", "a"))
+	if _, err := c.Elevation(context.Background(), r); err != nil {
+		t.Fatalf("Elevation: %v", err)
+	}
+	if got := header.Get("Proxy-Authorization"); got != "Bearer token" {
+		t.Errorf("Proxy-Authorization header: got %q, want %q", got, "Bearer token")
+	}
+	if got := query.Get("signature"); got == "" || got != signature {
+		t.Errorf("signature: got %q, want %q", got, signature)
+	}
+
+	ctx := WithCallOptions(context.Background(), CallHeader("X-Tenant", "b"))
+	if _, err := c.Elevation(ctx, r); err != nil {
+		t.Fatalf("Elevation: %v", err)
+	}
+	if got := header["X-Tenant"]; len(got) != 1 || got[0] != "b" {
+		t.Errorf("X-Tenant header: got %q, want [b]", got)
+	}
+	if got := query.Get("signature"); got != signature {
+		t.Errorf("signature: got %q, want %q", got, signature)
+	}
+}
+
+// stalledRoundTripper returns responses which send prefix and then block
+// until they are closed, ignoring the request's context.
+type stalledRoundTripper struct {
+	contentType string
+	prefix      string
+}
+
+func (rt stalledRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r, w := io.Pipe()
+	go func() {
+		w.Write([]byte(rt.prefix))
+	}()
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {rt.contentType}},
+		Body:       r,
+		Request:    req,
+	}, nil
+}
+
+func TestCancelDuringResponseBody(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	for name, call := range map[string]func(context.Context) error{
+		"Elevation": func(ctx context.Context) error {
+			ctx = WithRoundTripper(ctx, stalledRoundTripper{"application/json", `{"results": [`})
+			_, err := c.Elevation(ctx, &ElevationRequest{Locations: []LatLng{{Lat: 1, Lng: 2}}})
+			return err
+		},
+		"StaticMap": func(ctx context.Context) error {
+			ctx = WithRoundTripper(ctx, stalledRoundTripper{"image/png", "\x89PNG\r\n\x1a\n"})
+			_, err := c.StaticMap(ctx, &StaticMapRequest{Center: "Sydney", Zoom: 10, Size: "100x100"})
+			return err
+		},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		done := make(chan error, 1)
+		go func() { done <- call(ctx) }()
+		select {
+		case err := <-done:
+			if err != context.Canceled {
+				t.Errorf("%s: expected context.Canceled, got %v", name, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: did not return after its context was cancelled", name)
+		}
+	}
+}
+
+func TestCancelAfterResponse(t *testing.T) {
+	c, _ := NewClient(WithAPIKey(apiKey))
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = WithRoundTripper(ctx, stalledRoundTripper{"image/jpeg", "\xff\xd8"})
+	resp, err := c.PlacePhoto(ctx, &PlacePhotoRequest{PhotoReference: "ref", MaxWidth: 100})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Data.Close()
+	cancel()
+	if _, err := ioutil.ReadAll(resp.Data); err != context.Canceled {
+		t.Errorf("Expected context.Canceled reading the photo, got %v", err)
+	}
+}
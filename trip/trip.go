@@ -0,0 +1,305 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trip plans a trip between stops with the Geocoding, Directions,
+// Time Zone and Maps Static APIs: it resolves the stops, finds a route through
+// them in an optimized order, estimates the local arrival time at each stop,
+// and renders a thumbnail map of the route.
+package trip // import "googlemaps.github.io/maps/trip"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"time"
+
+	"googlemaps.github.io/maps"
+)
+
+// The steps of planning a trip which may fail.
+const (
+	StepGeocoding  = "geocoding"
+	StepDirections = "directions"
+	StepTimezone   = "timezone"
+	StepStaticMap  = "staticmap"
+)
+
+// StepError is the failure of a step of planning a trip.
+type StepError struct {
+	// Step is the step which failed, such as StepGeocoding.
+	Step string
+	// Stop is the index of the stop the step was for, or -1 if it was for the
+	// whole trip.
+	Stop int
+	// Err is the error of the step.
+	Err error
+}
+
+func (e *StepError) Error() string {
+	if e.Stop < 0 {
+		return fmt.Sprintf("trip: %s failed: %v", e.Step, e.Err)
+	}
+	return fmt.Sprintf("trip: %s of stop %d failed: %v", e.Step, e.Stop, e.Err)
+}
+
+// Unwrap returns the error of the step.
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// Options configures how a trip is planned.
+type Options struct {
+	// Mode is the mode of transport. Optional, default is driving.
+	Mode maps.Mode
+	// Optimize visits the stops between the first and the last in the order
+	// which makes the shortest trip, instead of the order given. Optional.
+	Optimize bool
+	// DepartAt is when the trip starts. Optional, default is now.
+	DepartAt time.Time
+	// Language is the language of addresses and the route. Optional.
+	Language string
+	// Units is the unit system of distances. Optional.
+	Units maps.Units
+	// ThumbnailSize is the size of a map of the route to render, such as
+	// "400x300". Optional; no map is rendered if empty.
+	ThumbnailSize string
+}
+
+// Stop is a stop of a trip.
+type Stop struct {
+	// Query is the address or Lat,Lng of the stop, as given.
+	Query string
+	// Resolved is whether the stop was found. Stops which were not found are
+	// left out of the trip.
+	Resolved bool
+	// Location is the location of the stop.
+	Location maps.LatLng
+	// PlaceID is the place ID of the stop, if it was geocoded.
+	PlaceID string
+	// FormattedAddress is the address of the stop, if it was geocoded.
+	FormattedAddress string
+}
+
+// Leg is the part of a trip between two stops.
+type Leg struct {
+	// From and To are the indexes in Plan.Stops of the stops the leg is
+	// between.
+	From, To int
+	// Distance is the distance covered by the leg.
+	Distance maps.Distance
+	// Duration is how long the leg takes.
+	Duration time.Duration
+	// Arrival is the estimated time of arrival at the end of the leg, in the
+	// time zone of the stop, or UTC if the time zone is unknown.
+	Arrival time.Time
+	// TimeZoneID is the "tz" ID of the time zone of the stop, if known.
+	TimeZoneID string
+}
+
+// Plan is a planned trip.
+type Plan struct {
+	// Stops are the stops of the trip, in the order given.
+	Stops []Stop
+	// Order is the order in which the resolved stops are visited, as indexes
+	// in Stops.
+	Order []int
+	// Route is the route of the trip from the Directions API.
+	Route *maps.Route
+	// Legs are the legs of the trip, in the order they are travelled.
+	Legs []Leg
+	// Thumbnail is the map of the route, if Options.ThumbnailSize was set and
+	// it could be rendered.
+	Thumbnail image.Image
+	// Errors are the steps which failed without preventing the trip being
+	// planned, such as stops which could not be found or time zones which could
+	// not be looked up.
+	Errors []*StepError
+}
+
+// Planner plans trips.
+type Planner struct {
+	// Client makes the API requests. Required.
+	Client *maps.Client
+}
+
+// PlanTrip plans a trip from the first of stops to the last, visiting the
+// others. Each stop is an address or a Lat,Lng pair. Intermediate stops which
+// cannot be found are left out and reported in Plan.Errors, along with the
+// failures of later steps. PlanTrip fails with a *StepError if the first or
+// last stop cannot be found, or there is no route between the stops, in which
+// case the partial Plan is also returned.
+func (p *Planner) PlanTrip(ctx context.Context, stops []string, opts *Options) (*Plan, error) {
+	if p.Client == nil {
+		return nil, errors.New("trip: Client is required")
+	}
+	if len(stops) < 2 {
+		return nil, errors.New("trip: at least two stops are required")
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	plan := &Plan{Stops: make([]Stop, len(stops))}
+	var waypoints []int
+	for i, query := range stops {
+		stop, err := p.resolve(ctx, query, opts)
+		plan.Stops[i] = stop
+		if err != nil {
+			stepErr := &StepError{Step: StepGeocoding, Stop: i, Err: err}
+			if i == 0 || i == len(stops)-1 {
+				return plan, stepErr
+			}
+			plan.Errors = append(plan.Errors, stepErr)
+			continue
+		}
+		if i > 0 && i < len(stops)-1 {
+			waypoints = append(waypoints, i)
+		}
+	}
+
+	if err := p.route(ctx, plan, waypoints, opts); err != nil {
+		return plan, &StepError{Step: StepDirections, Stop: -1, Err: err}
+	}
+	p.arrivals(ctx, plan, opts)
+	if opts.ThumbnailSize != "" {
+		if err := p.thumbnail(ctx, plan, opts); err != nil {
+			plan.Errors = append(plan.Errors, &StepError{Step: StepStaticMap, Stop: -1, Err: err})
+		}
+	}
+	return plan, nil
+}
+
+// resolve finds the location of a stop, geocoding it unless it is a Lat,Lng
+// pair.
+func (p *Planner) resolve(ctx context.Context, query string, opts *Options) (Stop, error) {
+	stop := Stop{Query: query}
+	if ll, err := maps.ParseLatLng(query); err == nil {
+		stop.Resolved, stop.Location = true, ll
+		return stop, nil
+	}
+	resp, err := p.Client.Geocode(ctx, &maps.GeocodingRequest{Address: query, Language: opts.Language})
+	if err != nil {
+		return stop, err
+	}
+	results := resp.Results
+	if len(results) == 0 {
+		return stop, fmt.Errorf("no results for %q", query)
+	}
+	stop.Resolved = true
+	stop.Location = results[0].Geometry.Location
+	stop.PlaceID = results[0].PlaceID
+	stop.FormattedAddress = results[0].FormattedAddress
+	return stop, nil
+}
+
+// route finds the route through the resolved stops, setting the Route, Order
+// and Legs of plan.
+func (p *Planner) route(ctx context.Context, plan *Plan, waypoints []int, opts *Options) error {
+	last := len(plan.Stops) - 1
+	r := &maps.DirectionsRequest{
+		Origin:      plan.Stops[0].Location.String(),
+		Destination: plan.Stops[last].Location.String(),
+		Mode:        opts.Mode,
+		Optimize:    opts.Optimize && len(waypoints) > 1,
+		DepartAt:    opts.DepartAt,
+		Language:    opts.Language,
+		Units:       opts.Units,
+	}
+	for _, i := range waypoints {
+		r.Waypoints = append(r.Waypoints, plan.Stops[i].Location.String())
+	}
+	routes, _, err := p.Client.Directions(ctx, r)
+	if err != nil {
+		return err
+	}
+	if len(routes) == 0 {
+		return errors.New("no route found")
+	}
+	plan.Route = &routes[0]
+
+	plan.Order = []int{0}
+	for k := range waypoints {
+		if k < len(plan.Route.WaypointOrder) {
+			plan.Order = append(plan.Order, waypoints[plan.Route.WaypointOrder[k]])
+		} else {
+			plan.Order = append(plan.Order, waypoints[k])
+		}
+	}
+	plan.Order = append(plan.Order, last)
+
+	for i, leg := range plan.Route.Legs {
+		if i+1 >= len(plan.Order) {
+			break
+		}
+		plan.Legs = append(plan.Legs, Leg{
+			From:     plan.Order[i],
+			To:       plan.Order[i+1],
+			Distance: leg.Distance,
+			Duration: leg.Duration,
+		})
+	}
+	return nil
+}
+
+// arrivals estimates the local arrival time at the end of each leg of plan.
+func (p *Planner) arrivals(ctx context.Context, plan *Plan, opts *Options) {
+	t := opts.DepartAt
+	if t.IsZero() {
+		t = time.Now()
+	}
+	for i := range plan.Legs {
+		leg := &plan.Legs[i]
+		if routeLeg := plan.Route.Legs[i]; !routeLeg.ArrivalTime.IsZero() {
+			t = routeLeg.ArrivalTime
+		} else {
+			t = t.Add(leg.Duration)
+		}
+		leg.Arrival = t.UTC()
+
+		location := plan.Stops[leg.To].Location
+		tz, err := p.Client.Timezone(ctx, &maps.TimezoneRequest{Location: &location, Timestamp: t, Language: opts.Language})
+		if err == nil && tz.Status != maps.TimezoneStatusOK {
+			err = fmt.Errorf("no time zone at %v", location)
+		}
+		if err != nil {
+			plan.Errors = append(plan.Errors, &StepError{Step: StepTimezone, Stop: leg.To, Err: err})
+			continue
+		}
+		leg.TimeZoneID = tz.TimeZoneID
+		leg.Arrival = t.In(time.FixedZone(tz.TimeZoneID, tz.RawOffset+tz.DstOffset))
+	}
+}
+
+// thumbnail renders a map of the route and stops of plan.
+func (p *Planner) thumbnail(ctx context.Context, plan *Plan, opts *Options) error {
+	path, err := maps.DecodePolylineErr(plan.Route.OverviewPolyline.Points)
+	if err != nil {
+		return err
+	}
+	r := &maps.StaticMapRequest{
+		Size:     opts.ThumbnailSize,
+		Language: opts.Language,
+		Paths:    []maps.Path{{Weight: 4, Color: "0x4285F4", Location: path}},
+	}
+	for n, i := range plan.Order {
+		label := ""
+		if n < 26 {
+			label = string(rune('A' + n))
+		}
+		r.Markers = append(r.Markers, maps.Marker{Label: label, Location: []maps.LatLng{plan.Stops[i].Location}})
+	}
+	plan.Thumbnail, err = p.Client.StaticMap(ctx, r)
+	return err
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"googlemaps.github.io/maps"
+)
+
+var geocodes = map[string]string{
+	"Sydney":   `{"lat": -33.87, "lng": 151.21}`,
+	"Canberra": `{"lat": -35.28, "lng": 149.13}`,
+	"Albury":   `{"lat": -36.08, "lng": 146.92}`,
+}
+
+func newTripServer(t *testing.T, staticMapStatus int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/maps/api/geocode/json":
+			location, ok := geocodes[q.Get("address")]
+			if !ok {
+				fmt.Fprintln(w, `{"status": "ZERO_RESULTS", "results": []}`)
+				return
+			}
+			fmt.Fprintf(w, `{"status": "OK", "results": [{"place_id": "%s-id", "formatted_address": "%s NSW", "geometry": {"location": %s}}]}`,
+				q.Get("address"), q.Get("address"), location)
+		case "/maps/api/directions/json":
+			if !strings.HasPrefix(q.Get("waypoints"), "optimize:true|") {
+				t.Errorf("expected optimized waypoints, was %q", q.Get("waypoints"))
+			}
+			fmt.Fprintln(w, `{"status": "OK", "routes": [{
+				"waypoint_order": [1, 0],
+				"overview_polyline": {"points": "_p~iF~ps|U_ulLnnqC"},
+				"legs": [
+					{"distance": {"text": "300 km", "value": 300000}, "duration": {"text": "3 hours", "value": 10800}},
+					{"distance": {"text": "200 km", "value": 200000}, "duration": {"text": "2 hours", "value": 7200}},
+					{"distance": {"text": "300 km", "value": 300000}, "duration": {"text": "3 hours", "value": 10800}}
+				]
+			}]}`)
+		case "/maps/api/timezone/json":
+			if strings.HasPrefix(q.Get("location"), "-37.") {
+				fmt.Fprintln(w, `{"status": "OK", "rawOffset": 36000, "dstOffset": 3600, "timeZoneId": "Australia/Melbourne"}`)
+				return
+			}
+			fmt.Fprintln(w, `{"status": "OK", "rawOffset": 36000, "dstOffset": 0, "timeZoneId": "Australia/Sydney"}`)
+		case "/maps/api/staticmap":
+			if staticMapStatus != http.StatusOK {
+				http.Error(w, "bad request", staticMapStatus)
+				return
+			}
+			w.Header().Set("Content-Type", "image/png")
+			png.Encode(w, image.NewRGBA(image.Rect(0, 0, 400, 300)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestPlanTrip(t *testing.T) {
+	server := newTripServer(t, http.StatusOK)
+	defer server.Close()
+	c, err := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	departAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	p := &Planner{Client: c}
+
+	plan, err := p.PlanTrip(context.Background(), []string{"Sydney", "Canberra", "Nowhere", "Albury", "-37.81,144.96"},
+		&Options{Optimize: true, DepartAt: departAt, ThumbnailSize: "400x300"})
+	if err != nil {
+		t.Fatalf("PlanTrip failed: %v", err)
+	}
+
+	if plan.Stops[1].PlaceID != "Canberra-id" || plan.Stops[1].FormattedAddress != "Canberra NSW" {
+		t.Errorf("unexpected stop %+v", plan.Stops[1])
+	}
+	if plan.Stops[2].Resolved || !plan.Stops[4].Resolved || plan.Stops[4].PlaceID != "" {
+		t.Errorf("unexpected stops %+v", plan.Stops)
+	}
+	if expected := []int{0, 3, 1, 4}; !reflect.DeepEqual(plan.Order, expected) {
+		t.Errorf("expected order %v, was %v", expected, plan.Order)
+	}
+	if len(plan.Legs) != 3 {
+		t.Fatalf("expected 3 legs, was %+v", plan.Legs)
+	}
+	if leg := plan.Legs[1]; leg.From != 3 || leg.To != 1 || leg.Duration != 2*time.Hour || leg.Distance.Meters != 200000 {
+		t.Errorf("unexpected leg %+v", leg)
+	}
+	if leg := plan.Legs[2]; leg.TimeZoneID != "Australia/Melbourne" || !leg.Arrival.Equal(departAt.Add(8*time.Hour)) {
+		t.Errorf("unexpected leg %+v", leg)
+	}
+	if _, offset := plan.Legs[2].Arrival.Zone(); offset != 39600 {
+		t.Errorf("expected arrival at UTC+11, was %v", plan.Legs[2].Arrival)
+	}
+	if plan.Thumbnail == nil || plan.Thumbnail.Bounds() != image.Rect(0, 0, 400, 300) {
+		t.Errorf("unexpected thumbnail %v", plan.Thumbnail)
+	}
+
+	if len(plan.Errors) != 1 || plan.Errors[0].Step != StepGeocoding || plan.Errors[0].Stop != 2 {
+		t.Errorf("expected Nowhere not to be found, errors were %v", plan.Errors)
+	}
+}
+
+func TestPlanTripPartialFailure(t *testing.T) {
+	server := newTripServer(t, http.StatusBadRequest)
+	defer server.Close()
+	c, err := maps.NewClient(maps.WithAPIKey("AIzaNotReallyAnAPIKey"), maps.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Planner{Client: c}
+
+	plan, err := p.PlanTrip(context.Background(), []string{"Sydney", "Canberra", "Albury", "Melbourne"}, &Options{Optimize: true})
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Step != StepGeocoding || stepErr.Stop != 3 {
+		t.Fatalf("expected the destination not to be found, was %v", err)
+	}
+	if plan == nil || !plan.Stops[0].Resolved {
+		t.Errorf("expected the partial plan, was %+v", plan)
+	}
+
+	plan, err = p.PlanTrip(context.Background(), []string{"Sydney", "Canberra", "Albury", "-37.81,144.96"},
+		&Options{Optimize: true, ThumbnailSize: "400x300"})
+	if err != nil {
+		t.Fatalf("PlanTrip failed: %v", err)
+	}
+	if len(plan.Errors) != 1 || plan.Errors[0].Step != StepStaticMap || maps.ErrorStatus(plan.Errors[0]) != "INVALID_REQUEST" {
+		t.Errorf("expected the thumbnail to fail, errors were %v", plan.Errors)
+	}
+	if plan.Thumbnail != nil || len(plan.Legs) != 3 {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+
+	if _, err := p.PlanTrip(context.Background(), []string{"Sydney"}, nil); err == nil {
+		t.Errorf("expected a single stop to fail")
+	}
+}
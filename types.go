@@ -19,6 +19,7 @@ package maps
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -74,6 +75,32 @@ const (
 	TransitRoutingPreferenceFewerTransfers = TransitRoutingPreference("fewer_transfers")
 )
 
+// VehicleType is the type of vehicle used on a transit line.
+type VehicleType string
+
+// Vehicle types of transit lines.
+const (
+	VehicleTypeRail           = VehicleType("RAIL")
+	VehicleTypeMetroRail      = VehicleType("METRO_RAIL")
+	VehicleTypeSubway         = VehicleType("SUBWAY")
+	VehicleTypeTram           = VehicleType("TRAM")
+	VehicleTypeMonorail       = VehicleType("MONORAIL")
+	VehicleTypeHeavyRail      = VehicleType("HEAVY_RAIL")
+	VehicleTypeCommuterTrain  = VehicleType("COMMUTER_TRAIN")
+	VehicleTypeHighSpeedTrain = VehicleType("HIGH_SPEED_TRAIN")
+	VehicleTypeBus            = VehicleType("BUS")
+	VehicleTypeIntercityBus   = VehicleType("INTERCITY_BUS")
+	VehicleTypeTrolleybus     = VehicleType("TROLLEYBUS")
+	VehicleTypeShareTaxi      = VehicleType("SHARE_TAXI")
+	VehicleTypeFerry          = VehicleType("FERRY")
+	VehicleTypeCableCar       = VehicleType("CABLE_CAR")
+	VehicleTypeGondolaLift    = VehicleType("GONDOLA_LIFT")
+	VehicleTypeFunicular      = VehicleType("FUNICULAR")
+	// VehicleTypeOther is any other vehicle type, including types unknown to
+	// this package.
+	VehicleTypeOther = VehicleType("OTHER")
+)
+
 // Distance is the API representation for a distance between two points.
 type Distance struct {
 	// HumanReadable is the human friendly distance. This is rounded and in an
@@ -96,6 +123,68 @@ const (
 	TrafficModelPessimistic = TrafficModel("pessimistic")
 )
 
+// Maneuver is the action to take for a step of directions, such as
+// turning left.
+type Maneuver string
+
+// Maneuvers of the steps of directions.
+const (
+	ManeuverTurnSlightLeft  = Maneuver("turn-slight-left")
+	ManeuverTurnSharpLeft   = Maneuver("turn-sharp-left")
+	ManeuverTurnLeft        = Maneuver("turn-left")
+	ManeuverTurnSlightRight = Maneuver("turn-slight-right")
+	ManeuverTurnSharpRight  = Maneuver("turn-sharp-right")
+	ManeuverTurnRight       = Maneuver("turn-right")
+	ManeuverKeepLeft        = Maneuver("keep-left")
+	ManeuverKeepRight       = Maneuver("keep-right")
+	ManeuverUturnLeft       = Maneuver("uturn-left")
+	ManeuverUturnRight      = Maneuver("uturn-right")
+	ManeuverStraight        = Maneuver("straight")
+	ManeuverRampLeft        = Maneuver("ramp-left")
+	ManeuverRampRight       = Maneuver("ramp-right")
+	ManeuverMerge           = Maneuver("merge")
+	ManeuverForkLeft        = Maneuver("fork-left")
+	ManeuverForkRight       = Maneuver("fork-right")
+	ManeuverFerry           = Maneuver("ferry")
+	ManeuverFerryTrain      = Maneuver("ferry-train")
+	ManeuverRoundaboutLeft  = Maneuver("roundabout-left")
+	ManeuverRoundaboutRight = Maneuver("roundabout-right")
+)
+
+// maneuvers are the known maneuvers.
+var maneuvers = []Maneuver{
+	ManeuverTurnSlightLeft,
+	ManeuverTurnSharpLeft,
+	ManeuverTurnLeft,
+	ManeuverTurnSlightRight,
+	ManeuverTurnSharpRight,
+	ManeuverTurnRight,
+	ManeuverKeepLeft,
+	ManeuverKeepRight,
+	ManeuverUturnLeft,
+	ManeuverUturnRight,
+	ManeuverStraight,
+	ManeuverRampLeft,
+	ManeuverRampRight,
+	ManeuverMerge,
+	ManeuverForkLeft,
+	ManeuverForkRight,
+	ManeuverFerry,
+	ManeuverFerryTrain,
+	ManeuverRoundaboutLeft,
+	ManeuverRoundaboutRight,
+}
+
+// ParseManeuver will parse a string representation of a Maneuver, ignoring case.
+func ParseManeuver(maneuver string) (Maneuver, error) {
+	for _, m := range maneuvers {
+		if strings.EqualFold(string(m), maneuver) {
+			return m, nil
+		}
+	}
+	return Maneuver(""), fmt.Errorf("maps: unknown Maneuver %q", maneuver)
+}
+
 // PriceLevel is the Price Levels for Places API
 type PriceLevel string
 
@@ -108,6 +197,89 @@ const (
 	PriceLevelVeryExpensive = PriceLevel("4")
 )
 
+var priceLevels = []PriceLevel{
+	PriceLevelFree,
+	PriceLevelInexpensive,
+	PriceLevelModerate,
+	PriceLevelExpensive,
+	PriceLevelVeryExpensive,
+}
+
+// priceLevelNames are the names of priceLevels, as used by the Places API
+// (New) without their PRICE_LEVEL_ prefix.
+var priceLevelNames = []string{"FREE", "INEXPENSIVE", "MODERATE", "EXPENSIVE", "VERY_EXPENSIVE"}
+
+// ParsePriceLevel will parse a string representation of a PriceLevel, either
+// a number from 0 to 4 or a name such as "moderate" or "PRICE_LEVEL_MODERATE",
+// ignoring case.
+func ParsePriceLevel(priceLevel string) (PriceLevel, error) {
+	name := strings.TrimPrefix(strings.ToUpper(priceLevel), "PRICE_LEVEL_")
+	for i, p := range priceLevels {
+		if priceLevel == string(p) || name == priceLevelNames[i] {
+			return p, nil
+		}
+	}
+	return PriceLevel(""), fmt.Errorf("maps: unknown PriceLevel %q", priceLevel)
+}
+
+// String returns the price level as used by the API, "0" to "4", or "" if it
+// is not known.
+func (p PriceLevel) String() string {
+	return string(p)
+}
+
+// Int returns the price level from 0 to 4, or -1 if it is not known.
+func (p PriceLevel) Int() int {
+	for i, level := range priceLevels {
+		if p == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// MarshalJSON implements json.Marshaler for PriceLevel, encoding it as a
+// number like the API does, or null if it is not known.
+func (p PriceLevel) MarshalJSON() ([]byte, error) {
+	if i := p.Int(); i >= 0 {
+		return []byte(strconv.Itoa(i)), nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler for PriceLevel, accepting a
+// number, as returned by the Places API, or a string accepted by
+// ParsePriceLevel. Levels which are not known are kept as they are, so that
+// their Int is -1.
+func (p *PriceLevel) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := unmarshalJSON(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+		*p = ""
+	case float64:
+		*p = PriceLevel(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		level, err := ParsePriceLevel(v)
+		if err != nil {
+			level = PriceLevel(v)
+		}
+		*p = level
+	default:
+		return fmt.Errorf("maps: invalid PriceLevel %s", data)
+	}
+	return nil
+}
+
+// Business statuses of a place returned by the Places API.
+const (
+	BusinessStatusOperational       = "OPERATIONAL"
+	BusinessStatusClosedTemporarily = "CLOSED_TEMPORARILY"
+	BusinessStatusClosedPermanently = "CLOSED_PERMANENTLY"
+)
+
 // OpeningHours describes the opening hours for a Place Details result.
 type OpeningHours struct {
 	// OpenNow is a boolean value indicating if the place is open at the current time.
@@ -122,6 +294,60 @@ type OpeningHours struct {
 	// PermanentlyClosed indicates that the place has permanently shut down. Please
 	// note, this field will be null if it isn't present in the response.
 	PermanentlyClosed *bool `json:"permanently_closed,omitempty"`
+	// SpecialDays are the dates with exceptional hours, such as public
+	// holidays, within the current and secondary opening hours.
+	SpecialDays []SpecialDay `json:"special_days,omitempty"`
+}
+
+// SpecialDay is a date within the current or secondary opening hours of a
+// place.
+type SpecialDay struct {
+	// Date is the date, in the format YYYY-MM-DD.
+	Date string `json:"date"`
+	// ExceptionalHours indicates that the place has exceptional hours on Date.
+	ExceptionalHours bool `json:"exceptional_hours,omitempty"`
+}
+
+// IsOpenAt reports whether the place is open at t according to Periods. The
+// weekday and time of day of t are compared with the periods, so t should be in
+// the place's time zone. IsOpenAt returns false if there are no periods.
+func (o *OpeningHours) IsOpenAt(t time.Time) bool {
+	const week = 7 * 24 * 60
+	now := int(t.Weekday())*24*60 + t.Hour()*60 + t.Minute()
+	for _, p := range o.Periods {
+		// A place which is always open has a single period which opens on
+		// Sunday at 0000 and has no close.
+		if p.Close.Time == "" {
+			return true
+		}
+		opens, ok := p.Open.minuteOfWeek()
+		if !ok {
+			continue
+		}
+		closes, ok := p.Close.minuteOfWeek()
+		if !ok {
+			continue
+		}
+		if closes <= opens {
+			closes += week
+		}
+		if (opens <= now && now < closes) || (opens <= now+week && now+week < closes) {
+			return true
+		}
+	}
+	return false
+}
+
+// minuteOfWeek returns the number of minutes from the start of Sunday to oc.
+func (oc *OpeningHoursOpenClose) minuteOfWeek() (int, bool) {
+	if len(oc.Time) != 4 {
+		return 0, false
+	}
+	hhmm, err := strconv.Atoi(oc.Time)
+	if err != nil {
+		return 0, false
+	}
+	return int(oc.Day)*24*60 + hhmm/100*60 + hhmm%100, true
 }
 
 // OpeningHoursPeriod is a single OpeningHours day describing when the place opens
@@ -184,6 +410,47 @@ const (
 	ComponentCountry = Component("country")
 )
 
+// AddressComponentType is a type of an AddressComponent. See
+// https://developers.google.com/maps/documentation/geocoding/requests-geocoding#Types
+// for more detail.
+type AddressComponentType string
+
+// AddressComponentType values.
+const (
+	AddressComponentStreetAddress            = AddressComponentType("street_address")
+	AddressComponentStreetNumber             = AddressComponentType("street_number")
+	AddressComponentRoute                    = AddressComponentType("route")
+	AddressComponentIntersection             = AddressComponentType("intersection")
+	AddressComponentPolitical                = AddressComponentType("political")
+	AddressComponentCountry                  = AddressComponentType("country")
+	AddressComponentAdministrativeAreaLevel1 = AddressComponentType("administrative_area_level_1")
+	AddressComponentAdministrativeAreaLevel2 = AddressComponentType("administrative_area_level_2")
+	AddressComponentAdministrativeAreaLevel3 = AddressComponentType("administrative_area_level_3")
+	AddressComponentAdministrativeAreaLevel4 = AddressComponentType("administrative_area_level_4")
+	AddressComponentAdministrativeAreaLevel5 = AddressComponentType("administrative_area_level_5")
+	AddressComponentColloquialArea           = AddressComponentType("colloquial_area")
+	AddressComponentLocality                 = AddressComponentType("locality")
+	AddressComponentSublocality              = AddressComponentType("sublocality")
+	AddressComponentSublocalityLevel1        = AddressComponentType("sublocality_level_1")
+	AddressComponentSublocalityLevel2        = AddressComponentType("sublocality_level_2")
+	AddressComponentNeighborhood             = AddressComponentType("neighborhood")
+	AddressComponentPremise                  = AddressComponentType("premise")
+	AddressComponentSubpremise               = AddressComponentType("subpremise")
+	AddressComponentPlusCode                 = AddressComponentType("plus_code")
+	AddressComponentPostalCode               = AddressComponentType("postal_code")
+	AddressComponentPostalCodePrefix         = AddressComponentType("postal_code_prefix")
+	AddressComponentPostalCodeSuffix         = AddressComponentType("postal_code_suffix")
+	AddressComponentPostalTown               = AddressComponentType("postal_town")
+	AddressComponentNaturalFeature           = AddressComponentType("natural_feature")
+	AddressComponentAirport                  = AddressComponentType("airport")
+	AddressComponentPark                     = AddressComponentType("park")
+	AddressComponentPointOfInterest          = AddressComponentType("point_of_interest")
+	AddressComponentEstablishment            = AddressComponentType("establishment")
+	AddressComponentFloor                    = AddressComponentType("floor")
+	AddressComponentRoom                     = AddressComponentType("room")
+	AddressComponentPostBox                  = AddressComponentType("post_box")
+)
+
 // RankBy specifies the order in which results are listed.
 type RankBy string
 
@@ -193,6 +460,17 @@ const (
 	RankByDistance   = RankBy("distance")
 )
 
+// ParseRankBy will parse a string representation of a RankBy, ignoring case.
+func ParseRankBy(rankBy string) (RankBy, error) {
+	switch strings.ToLower(rankBy) {
+	case "prominence":
+		return RankByProminence, nil
+	case "distance":
+		return RankByDistance, nil
+	}
+	return RankBy(""), fmt.Errorf("maps: unknown RankBy %q, want prominence or distance", rankBy)
+}
+
 // PlaceType restricts Place API search to the results to places matching the
 // specified type.
 type PlaceType string
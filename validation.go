@@ -0,0 +1,361 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxRadius is the largest radius, in meters, accepted by the Places API.
+const maxRadius = 50000
+
+// Violation is a problem with a field of a request.
+type Violation struct {
+	// Field is the name of the field, such as Radius.
+	Field string
+	// Reason describes the problem.
+	Reason string
+}
+
+func (v Violation) String() string {
+	return v.Field + ": " + v.Reason
+}
+
+// ValidationError is returned by the Validate methods of requests, and lists
+// every problem found with the request.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	s := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		s[i] = v.String()
+	}
+	return "maps: invalid request: " + strings.Join(s, "; ")
+}
+
+// Validator is implemented by requests which can check all their fields
+// before they are sent.
+type Validator interface {
+	// Validate returns a *ValidationError listing every problem with the
+	// request, or nil.
+	Validate() error
+}
+
+// WithStrictValidation makes the client call the Validate method of each
+// request before any other check, and fail with the *ValidationError it
+// returns instead of making the request. Without it, requests are only checked
+// for the most common mistakes, one at a time.
+func WithStrictValidation() ClientOption {
+	return func(c *Client) error {
+		c.strictValidation = true
+		return nil
+	}
+}
+
+// validateRequest returns the error of Validate for apiReq when the client
+// validates strictly. Request methods call it before their own checks.
+func (c *Client) validateRequest(apiReq interface{}) error {
+	if !c.strictValidation {
+		return nil
+	}
+	if v, ok := apiReq.(Validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validator collects the violations of a request.
+type validator struct {
+	violations []Violation
+}
+
+// check records a violation of field if ok is false.
+func (v *validator) check(ok bool, field, format string, args ...interface{}) {
+	if !ok {
+		v.violations = append(v.violations, Violation{Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
+}
+
+// latLng checks that ll, if set, is a valid coordinate.
+func (v *validator) latLng(field string, ll *LatLng) {
+	if ll == nil {
+		return
+	}
+	v.check(ll.Lat >= -90 && ll.Lat <= 90, field, "latitude %v out of range [-90, 90]", ll.Lat)
+	v.check(ll.Lng >= -180 && ll.Lng <= 180, field, "longitude %v out of range [-180, 180]", ll.Lng)
+}
+
+// latLngs checks that each of lls is a valid coordinate.
+func (v *validator) latLngs(field string, lls []LatLng) {
+	for i := range lls {
+		v.latLng(fmt.Sprintf("%s[%d]", field, i), &lls[i])
+	}
+}
+
+// radius checks that a Places API radius is in range.
+func (v *validator) radius(radius uint) {
+	v.check(radius <= maxRadius, "Radius", "must be at most %d meters", maxRadius)
+}
+
+// travelTimes checks the departure and arrival times of a request.
+func (v *validator) travelTimes(departure string, departNow bool, departAt time.Time, arrival string, arriveAt time.Time) {
+	v.violations = append(v.violations, travelTimeViolations(departure, departNow, departAt, arrival, arriveAt)...)
+}
+
+// err returns the violations as a *ValidationError, or nil.
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v.violations}
+}
+
+// Validate checks every field of the request.
+func (r *DirectionsRequest) Validate() error {
+	var v validator
+	v.check(r.Origin != "", "Origin", "required")
+	v.check(r.Destination != "", "Destination", "required")
+	v.check(r.Mode == "" || r.Mode == TravelModeDriving || r.Mode == TravelModeWalking || r.Mode == TravelModeBicycling || r.Mode == TravelModeTransit,
+		"Mode", "unknown mode %q", r.Mode)
+	v.travelTimes(r.DepartureTime, r.DepartNow, r.DepartAt, r.ArrivalTime, r.ArriveAt)
+	v.check(len(r.Waypoints) == 0 || len(r.TypedWaypoints) == 0, "TypedWaypoints", "cannot be specified with Waypoints")
+	v.check(len(r.Waypoints)+len(r.TypedWaypoints) <= 25, "Waypoints", "at most 25 allowed, got %d", len(r.Waypoints)+len(r.TypedWaypoints))
+	v.check(!r.Optimize || len(r.Waypoints)+len(r.TypedWaypoints) >= 2, "Optimize", "requires at least 2 waypoints")
+	for i := range r.TypedWaypoints {
+		if err := r.TypedWaypoints[i].validate(); err != nil {
+			v.check(false, fmt.Sprintf("TypedWaypoints[%d]", i), "%v", err)
+		}
+	}
+	v.check(len(r.TransitMode) == 0 || r.Mode == TravelModeTransit, "TransitMode", "requires Mode TravelModeTransit")
+	v.check(r.TransitRoutingPreference == "" || r.Mode == TravelModeTransit, "TransitRoutingPreference", "requires Mode TravelModeTransit")
+	v.check(r.TrafficModel == "" || r.Mode != TravelModeTransit, "TrafficModel", "cannot be specified with Mode TravelModeTransit")
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *DistanceMatrixRequest) Validate() error {
+	var v validator
+	origins, destinations := len(r.origins()), len(r.destinations())
+	v.check(origins > 0, "Origins", "required")
+	v.check(destinations > 0, "Destinations", "required")
+	v.check(len(r.Origins) == 0 || len(r.OriginLocations) == 0, "OriginLocations", "cannot be specified with Origins")
+	v.check(len(r.Destinations) == 0 || len(r.DestinationLocations) == 0, "DestinationLocations", "cannot be specified with Destinations")
+	v.check(origins <= 25, "Origins", "at most 25 allowed, got %d", origins)
+	v.check(destinations <= 25, "Destinations", "at most 25 allowed, got %d", destinations)
+	v.check(origins*destinations <= 100, "Destinations", "at most 100 elements allowed, got %d", origins*destinations)
+	for i := range r.OriginLocations {
+		if err := r.OriginLocations[i].validate(); err != nil {
+			v.check(false, fmt.Sprintf("OriginLocations[%d]", i), "%v", err)
+		}
+	}
+	for i := range r.DestinationLocations {
+		if err := r.DestinationLocations[i].validate(); err != nil {
+			v.check(false, fmt.Sprintf("DestinationLocations[%d]", i), "%v", err)
+		}
+	}
+	v.travelTimes(r.DepartureTime, r.DepartNow, r.DepartAt, r.ArrivalTime, r.ArriveAt)
+	v.check(len(r.TransitMode) == 0 || r.Mode == TravelModeTransit, "TransitMode", "requires Mode TravelModeTransit")
+	v.check(r.TransitRoutingPreference == "" || r.Mode == TravelModeTransit, "TransitRoutingPreference", "requires Mode TravelModeTransit")
+	v.check(r.TrafficModel == "" || r.Mode != TravelModeTransit, "TrafficModel", "cannot be specified with Mode TravelModeTransit")
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *ElevationRequest) Validate() error {
+	var v validator
+	v.check(len(r.Locations) > 0 || len(r.Path) > 0, "Locations", "Locations or Path required")
+	v.check(len(r.Locations) == 0 || len(r.Path) == 0, "Path", "cannot be specified with Locations")
+	v.check(len(r.Path) == 0 || len(r.Path) >= 2, "Path", "at least 2 points required")
+	v.check(len(r.Path) == 0 || r.Samples > 0, "Samples", "required with Path")
+	v.check(r.Samples <= 512, "Samples", "must be at most 512")
+	v.latLngs("Locations", r.Locations)
+	v.latLngs("Path", r.Path)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *GeocodingRequest) Validate() error {
+	var v validator
+	v.check(r.Address != "" || len(r.Components) > 0 || r.LatLng != nil || r.PlaceID != "", "Address",
+		"one of Address, Components, LatLng and PlaceID required")
+	v.check(r.LatLng == nil || r.PlaceID == "", "PlaceID", "cannot be specified with LatLng")
+	v.check(r.Address == "" && len(r.Components) == 0 || r.LatLng == nil && r.PlaceID == "", "LatLng",
+		"reverse geocoding cannot be combined with Address or Components")
+	v.check(!r.EnableAddressDescriptor || r.LatLng != nil || r.PlaceID != "", "EnableAddressDescriptor",
+		"only supported for reverse geocoding")
+	v.latLng("LatLng", r.LatLng)
+	if r.Bounds != nil {
+		v.latLng("Bounds.NorthEast", &r.Bounds.NorthEast)
+		v.latLng("Bounds.SouthWest", &r.Bounds.SouthWest)
+	}
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *TimezoneRequest) Validate() error {
+	var v validator
+	v.check(r.Location != nil, "Location", "required")
+	v.latLng("Location", r.Location)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *NearbySearchRequest) Validate() error {
+	var v validator
+	v.check(r.RankBy == "" || r.RankBy == RankByProminence || r.RankBy == RankByDistance, "RankBy", "unknown RankBy %q", r.RankBy)
+	if r.PageToken == "" {
+		v.check(r.Location != nil, "Location", "required without PageToken")
+		v.check(r.Radius > 0 || r.RankBy == RankByDistance, "Radius", "required without PageToken")
+		v.check(r.Radius == 0 || r.RankBy != RankByDistance, "Radius", "cannot be specified with RankByDistance")
+		v.check(r.RankBy != RankByDistance || r.Keyword != "" || r.Name != "" || r.Type != "", "RankBy",
+			"RankByDistance requires Keyword, Name or Type")
+	}
+	v.radius(r.Radius)
+	v.check(r.MinPrice == "" || r.MaxPrice == "" || r.MinPrice <= r.MaxPrice, "MinPrice", "must not be more than MaxPrice")
+	v.latLng("Location", r.Location)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *TextSearchRequest) Validate() error {
+	var v validator
+	v.check(r.Query != "" || r.PageToken != "" || r.Type != "", "Query", "one of Query, PageToken and Type required")
+	v.check(r.Location == nil || r.Radius > 0, "Radius", "required with Location")
+	v.radius(r.Radius)
+	v.check(r.MinPrice == "" || r.MaxPrice == "" || r.MinPrice <= r.MaxPrice, "MinPrice", "must not be more than MaxPrice")
+	v.latLng("Location", r.Location)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *PlaceDetailsRequest) Validate() error {
+	var v validator
+	v.check(r.PlaceID != "", "PlaceID", "required")
+	v.check(validReviewsSort(r.ReviewsSort), "ReviewsSort", "must be most_relevant or newest")
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *PlaceAutocompleteRequest) Validate() error {
+	var v validator
+	v.check(r.Input != "", "Input", "required")
+	v.check(r.Offset <= uint(len(r.Input)), "Offset", "must not be beyond the end of Input")
+	v.radius(r.Radius)
+	v.check(r.LocationBias == nil && r.LocationRestriction == nil || r.Location == nil && r.Radius == 0 && !r.StrictBounds,
+		"LocationBias", "cannot be specified with Location, Radius or StrictBounds")
+	v.check(r.LocationBias == nil || r.LocationRestriction == nil, "LocationRestriction", "cannot be specified with LocationBias")
+	v.latLng("Location", r.Location)
+	v.latLng("Origin", r.Origin)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *QueryAutocompleteRequest) Validate() error {
+	var v validator
+	v.check(r.Input != "", "Input", "required")
+	v.check(r.Offset <= uint(len(r.Input)), "Offset", "must not be beyond the end of Input")
+	v.radius(r.Radius)
+	v.latLng("Location", r.Location)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *FindPlaceFromTextRequest) Validate() error {
+	var v validator
+	v.check(r.Input != "", "Input", "required")
+	v.check(r.InputType != "", "InputType", "required")
+	v.check(r.Bias == nil || r.LocationBias == "", "Bias", "cannot be specified with LocationBias")
+	switch r.LocationBias {
+	case FindPlaceFromTextLocationBiasPoint:
+		v.check(r.LocationBiasPoint != nil, "LocationBiasPoint", "required with FindPlaceFromTextLocationBiasPoint")
+	case FindPlaceFromTextLocationBiasCircular:
+		v.check(r.LocationBiasCenter != nil, "LocationBiasCenter", "required with FindPlaceFromTextLocationBiasCircular")
+		v.check(r.LocationBiasRadius > 0 && r.LocationBiasRadius <= maxRadius, "LocationBiasRadius", "must be between 1 and %d meters", maxRadius)
+	case FindPlaceFromTextLocationBiasRectangular:
+		v.check(r.LocationBiasSouthWest != nil && r.LocationBiasNorthEast != nil, "LocationBiasSouthWest",
+			"LocationBiasSouthWest and LocationBiasNorthEast required with FindPlaceFromTextLocationBiasRectangular")
+	}
+	v.latLng("LocationBiasPoint", r.LocationBiasPoint)
+	v.latLng("LocationBiasCenter", r.LocationBiasCenter)
+	v.latLng("LocationBiasSouthWest", r.LocationBiasSouthWest)
+	v.latLng("LocationBiasNorthEast", r.LocationBiasNorthEast)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *PlacePhotoRequest) Validate() error {
+	var v validator
+	v.check(r.PhotoReference != "", "PhotoReference", "required")
+	v.check(r.MaxHeight > 0 || r.MaxWidth > 0, "MaxWidth", "MaxHeight or MaxWidth required")
+	v.check(r.MaxHeight <= 1600, "MaxHeight", "must be at most 1600")
+	v.check(r.MaxWidth <= 1600, "MaxWidth", "must be at most 1600")
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *SnapToRoadRequest) Validate() error {
+	var v validator
+	v.check(len(r.Path) > 0, "Path", "required")
+	v.check(len(r.Path) <= 100, "Path", "at most 100 points allowed, got %d", len(r.Path))
+	v.latLngs("Path", r.Path)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *NearestRoadsRequest) Validate() error {
+	var v validator
+	v.check(len(r.Points) > 0, "Points", "required")
+	v.check(len(r.Points) <= 100, "Points", "at most 100 points allowed, got %d", len(r.Points))
+	v.latLngs("Points", r.Points)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *SpeedLimitsRequest) Validate() error {
+	var v validator
+	v.check(len(r.Path) > 0 || len(r.PlaceID) > 0, "Path", "Path or PlaceID required")
+	v.check(len(r.Path) <= 100, "Path", "at most 100 points allowed, got %d", len(r.Path))
+	v.check(len(r.PlaceID) <= 100, "PlaceID", "at most 100 place IDs allowed, got %d", len(r.PlaceID))
+	v.check(r.Units == "" || r.Units == SpeedLimitKPH || r.Units == SpeedLimitMPH, "Units", "unknown unit %q", r.Units)
+	v.latLngs("Path", r.Path)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *StaticMapRequest) Validate() error {
+	var v validator
+	v.check(r.Size != "", "Size", "required")
+	v.check(len(r.Markers) > 0 || len(r.Paths) > 0 || len(r.Visible) > 0 || r.Center != "" && r.Zoom != 0, "Center",
+		"Center and Zoom required without Markers, Paths or Visible")
+	v.check(r.Zoom >= 0 && r.Zoom <= 21, "Zoom", "must be between 0 and 21")
+	v.check(r.Scale <= 0 || r.Scale == 1 || r.Scale == 2 || r.Scale == 4, "Scale", "must be 1, 2 or 4")
+	v.latLngs("Visible", r.Visible)
+	return v.err()
+}
+
+// Validate checks every field of the request.
+func (r *StreetViewRequest) Validate() error {
+	var v validator
+	v.check((r.Location == "") != (r.Pano == ""), "Location", "exactly one of Location and Pano required")
+	v.check(r.Heading == nil || *r.Heading >= 0 && *r.Heading <= 360, "Heading", "must be between 0 and 360")
+	v.check(r.Pitch >= -90 && r.Pitch <= 90, "Pitch", "must be between -90 and 90")
+	v.check(r.FOV >= 0 && r.FOV <= 120, "FOV", "must be at most 120")
+	v.check(r.Radius >= 0, "Radius", "must not be negative")
+	return v.err()
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// violatedFields returns the fields of the violations in err.
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr), "want *ValidationError, got %v", err)
+	var fields []string
+	for _, v := range verr.Violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	heading := 400.0
+	for name, test := range map[string]struct {
+		r    Validator
+		want []string
+	}{
+		"valid nearby search": {
+			r: &NearbySearchRequest{Location: &LatLng{Lat: -33.86, Lng: 151.2}, Radius: 500},
+		},
+		"nearby search": {
+			r:    &NearbySearchRequest{Location: &LatLng{Lat: 91, Lng: 181}, Radius: 60000, RankBy: RankByDistance},
+			want: []string{"Radius", "RankBy", "Radius", "Location", "Location"},
+		},
+		"text search": {
+			r:    &TextSearchRequest{Location: &LatLng{Lat: 1, Lng: 2}},
+			want: []string{"Query", "Radius"},
+		},
+		"autocomplete": {
+			r:    &PlaceAutocompleteRequest{Input: "x", Radius: 100, LocationBias: &LocationBias{}, LocationRestriction: &LocationRestriction{}},
+			want: []string{"LocationBias", "LocationRestriction"},
+		},
+		"directions": {
+			r:    &DirectionsRequest{Origin: "a", DepartureTime: "now", DepartNow: true, ArrivalTime: "1", TransitMode: []TransitMode{TransitModeBus}},
+			want: []string{"Destination", "DepartureTime", "ArrivalTime", "TransitMode"},
+		},
+		"elevation": {
+			r:    &ElevationRequest{Path: []LatLng{{Lat: 1, Lng: 1}, {Lat: -100, Lng: 1}}, Samples: 600},
+			want: []string{"Samples", "Path[1]"},
+		},
+		"geocoding": {
+			r:    &GeocodingRequest{Address: "a", LatLng: &LatLng{Lat: 1, Lng: 200}},
+			want: []string{"LatLng", "LatLng"},
+		},
+		"street view": {
+			r:    &StreetViewRequest{Location: "a", Pano: "b", Heading: &heading, Pitch: 100},
+			want: []string{"Location", "Heading", "Pitch"},
+		},
+		"photo": {
+			r:    &PlacePhotoRequest{MaxWidth: 2000},
+			want: []string{"PhotoReference", "MaxWidth"},
+		},
+	} {
+		err := test.r.Validate()
+		if test.want == nil {
+			assert.NoError(t, err, name)
+			continue
+		}
+		assert.Equal(t, test.want, violatedFields(t, err), name)
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := (&TimezoneRequest{Location: &LatLng{Lat: 95, Lng: 0}}).Validate()
+	assert.EqualError(t, err, "maps: invalid request: Location: latitude 95 out of range [-90, 90]")
+}
+
+func TestClientWithStrictValidation(t *testing.T) {
+	server := mockServerForQuery("", 200, `{"status":"OK","results":[]}`)
+	defer server.s.Close()
+	r := &NearbySearchRequest{Location: &LatLng{Lat: 1, Lng: 2}, Radius: 60000}
+
+	c, _ := NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL))
+	_, err := c.NearbySearch(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.successful)
+
+	c, _ = NewClient(WithAPIKey(apiKey), WithBaseURL(server.s.URL), WithStrictValidation())
+	_, err = c.NearbySearch(context.Background(), r)
+	assert.Equal(t, []string{"Radius"}, violatedFields(t, err))
+	assert.Equal(t, 1, server.successful)
+
+	// Every violation is reported, even for requests the legacy checks reject.
+	_, _, err = c.Directions(context.Background(), &DirectionsRequest{Mode: "boat"})
+	assert.Equal(t, []string{"Origin", "Destination", "Mode"}, violatedFields(t, err))
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrNotModified is returned by a conditional request when the result has not
+// changed since the CacheValidators it was made with.
+var ErrNotModified = errors.New("maps: not modified")
+
+// CacheValidators are the HTTP cache validators of a result, which can be sent
+// with a later request to only fetch the result again if it changed.
+type CacheValidators struct {
+	// ETag is the entity tag of the result.
+	ETag string `json:"etag,omitempty"`
+	// LastModified is the time the result was last modified.
+	LastModified time.Time `json:"last_modified,omitempty"`
+}
+
+// header returns the conditional request headers for v.
+func (v *CacheValidators) header() http.Header {
+	h := http.Header{}
+	if v == nil {
+		return h
+	}
+	if v.ETag != "" {
+		h.Set("If-None-Match", v.ETag)
+	}
+	if !v.LastModified.IsZero() {
+		h.Set("If-Modified-Since", v.LastModified.UTC().Format(http.TimeFormat))
+	}
+	return h
+}
+
+// headerResponse is implemented by responses that record HTTP response
+// headers, received at now by the client's clock.
+type headerResponse interface {
+	setHeader(h http.Header, now time.Time)
+}
+
+// validatorsResponse records the cache validators of a response, if the API
+// supplied any.
+type validatorsResponse struct {
+	validators *CacheValidators
+}
+
+func (r *validatorsResponse) setHeader(h http.Header, now time.Time) {
+	v := CacheValidators{ETag: h.Get("ETag")}
+	if t, err := http.ParseTime(h.Get("Last-Modified")); err == nil {
+		v.LastModified = t
+	}
+	if v.ETag != "" || !v.LastModified.IsZero() {
+		r.validators = &v
+	}
+}